@@ -0,0 +1,137 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/schema"
+)
+
+const schemaRegistryUsage = `usage:
+  schema-registry export [-output <file>]
+  schema-registry import -input <file> [-dry-run] [-force]`
+
+// runCommand runs the one-off command that is selected by the given positional arguments.
+func runCommand(cfg *config.Config, logger *zap.Logger, args []string) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	switch args[0] {
+	case "schema-registry":
+		return runSchemaRegistryCommand(ctx, cfg, logger, args[1:])
+	default:
+		return fmt.Errorf("unknown command %q, supported commands are: schema-registry", args[0])
+	}
+}
+
+func runSchemaRegistryCommand(ctx context.Context, cfg *config.Config, logger *zap.Logger, args []string) error {
+	if len(args) == 0 {
+		return errors.New(schemaRegistryUsage)
+	}
+	if !cfg.Kafka.Schema.Enabled {
+		return errors.New("schema registry is not configured")
+	}
+
+	svc, err := schema.NewService(cfg.Kafka.Schema, logger)
+	if err != nil {
+		return fmt.Errorf("failed to create schema registry service: %w", err)
+	}
+
+	switch args[0] {
+	case "export":
+		return runSchemaRegistryExport(ctx, svc, args[1:])
+	case "import":
+		return runSchemaRegistryImport(ctx, svc, args[1:])
+	default:
+		return fmt.Errorf("unknown schema-registry command %q\n%s", args[0], schemaRegistryUsage)
+	}
+}
+
+func runSchemaRegistryExport(ctx context.Context, svc *schema.Service, args []string) error {
+	fs := flag.NewFlagSet("schema-registry export", flag.ContinueOnError)
+	output := fs.String("output", "", "File to write the backup to. The backup is written to stdout if not set.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	backup, err := svc.ExportBackup(ctx)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(backup); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return nil
+}
+
+func runSchemaRegistryImport(ctx context.Context, svc *schema.Service, args []string) error {
+	fs := flag.NewFlagSet("schema-registry import", flag.ContinueOnError)
+	input := fs.String("input", "", "File to read the backup from.")
+	dryRun := fs.Bool("dry-run", false, "Only report the restore steps and conflicts without importing any schemas.")
+	force := fs.Bool("force", false, "Switch into IMPORT mode even if the target registry contains schemas already.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *input == "" {
+		return errors.New("the -input flag is required")
+	}
+
+	f, err := os.Open(*input)
+	if err != nil {
+		return fmt.Errorf("failed to open backup: %w", err)
+	}
+	defer f.Close()
+
+	var backup schema.Backup
+	if err := json.NewDecoder(f).Decode(&backup); err != nil {
+		return fmt.Errorf("failed to decode backup: %w", err)
+	}
+
+	report, err := svc.RestoreBackup(ctx, &backup, schema.RestoreOptions{DryRun: *dryRun, Force: *force})
+	if report != nil {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if encErr := enc.Encode(report); encErr != nil {
+			return fmt.Errorf("failed to write restore report: %w", encErr)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if len(report.Conflicts) > 0 && !report.DryRun {
+		return fmt.Errorf("restore aborted due to %d conflicts", len(report.Conflicts))
+	}
+	return nil
+}
@@ -10,6 +10,8 @@
 package main
 
 import (
+	"flag"
+
 	"go.uber.org/zap"
 
 	"github.com/redpanda-data/console/backend/pkg/api"
@@ -28,6 +30,15 @@ func main() {
 		startupLogger.Fatal("failed to validate config", zap.Error(err))
 	}
 
+	// Positional arguments that remain after parsing the flags select a one-off command
+	// (e.g. "schema-registry export") instead of starting the server.
+	if args := flag.Args(); len(args) > 0 {
+		if err := runCommand(&cfg, startupLogger, args); err != nil {
+			startupLogger.Fatal("failed to run command", zap.String("command", args[0]), zap.Error(err))
+		}
+		return
+	}
+
 	a := api.New(&cfg)
 	a.Start()
 }
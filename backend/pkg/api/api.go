@@ -27,6 +27,10 @@ import (
 
 	"github.com/redpanda-data/console/backend/pkg/config"
 	"github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/connect/gitops"
+	"github.com/redpanda-data/console/backend/pkg/connect/guides"
+	"github.com/redpanda-data/console/backend/pkg/connect/health"
+	"github.com/redpanda-data/console/backend/pkg/connect/status"
 	"github.com/redpanda-data/console/backend/pkg/console"
 	"github.com/redpanda-data/console/backend/pkg/embed"
 	"github.com/redpanda-data/console/backend/pkg/git"
@@ -43,9 +47,24 @@ type API struct {
 	Logger      *zap.Logger
 	ConsoleSvc  console.Servicer
 	ConnectSvc  *connect.Service
-	GitSvc      *git.Service
+	GitSvc      *git.Group
 	RedpandaSvc *redpanda.Service
 
+	// ConnectGitOpsSvc reconciles declared connectors. It is nil if GitOps for
+	// Kafka connect is not enabled.
+	ConnectGitOpsSvc *gitops.Reconciler
+
+	// ConnectHealthMonitor polls the states of all connectors and restarts failed
+	// ones. It is nil if the health monitor is not enabled.
+	ConnectHealthMonitor *health.Monitor
+	// ConnectStatusAggregator caches the connectors of all Kafka connect clusters.
+	// It is nil if the status aggregator is not enabled.
+	ConnectStatusAggregator *status.Aggregator
+
+	// ConnectGuidesLoader loads declarative connector guides. It is nil if
+	// declarative guides are not enabled.
+	ConnectGuidesLoader *guides.Loader
+
 	// FrontendResources is an in-memory Filesystem with all go:embedded frontend resources.
 	// The index.html is expected to be at the root of the filesystem. This prop will only be accessed
 	// if the config property serveFrontend is set to true.
@@ -81,6 +100,35 @@ func New(cfg *config.Config, opts ...Option) *API {
 		logger.Fatal("failed to create Kafka connect service", zap.Error(err))
 	}
 
+	var connectGitOpsSvc *gitops.Reconciler
+	if cfg.Connect.Enabled && cfg.Connect.GitOps.Enabled {
+		connectGitOpsSvc, err = gitops.NewReconciler(cfg.Connect.GitOps, logger, connectSvc)
+		if err != nil {
+			logger.Fatal("failed to create Kafka connect gitops reconciler", zap.Error(err))
+		}
+	}
+
+	var connectHealthMonitor *health.Monitor
+	if cfg.Connect.Enabled && cfg.Connect.HealthMonitor.Enabled {
+		connectHealthMonitor, err = health.NewMonitor(cfg.Connect.HealthMonitor, logger, connectSvc, cfg.MetricsNamespace)
+		if err != nil {
+			logger.Fatal("failed to create Kafka connect health monitor", zap.Error(err))
+		}
+	}
+
+	var connectStatusAggregator *status.Aggregator
+	if cfg.Connect.Enabled && cfg.Connect.StatusAggregator.Enabled {
+		connectStatusAggregator = status.NewAggregator(cfg.Connect.StatusAggregator, logger, connectSvc)
+	}
+
+	var connectGuidesLoader *guides.Loader
+	if cfg.Connect.Enabled && cfg.Connect.Guides.Enabled {
+		connectGuidesLoader, err = guides.NewLoader(cfg.Connect.Guides, logger, connectSvc.Interceptor)
+		if err != nil {
+			logger.Fatal("failed to create Kafka connect guides loader", zap.Error(err))
+		}
+	}
+
 	var consoleSvc console.Servicer
 	if cfg.Console.Enabled {
 		consoleSvc, err = console.NewService(cfg, logger, redpandaSvc, connectSvc)
@@ -97,13 +145,17 @@ func New(cfg *config.Config, opts ...Option) *API {
 	}
 
 	a := &API{
-		Cfg:               cfg,
-		Logger:            logger,
-		ConsoleSvc:        consoleSvc,
-		ConnectSvc:        connectSvc,
-		RedpandaSvc:       redpandaSvc,
-		Hooks:             newDefaultHooks(),
-		FrontendResources: fsys,
+		Cfg:                     cfg,
+		Logger:                  logger,
+		ConsoleSvc:              consoleSvc,
+		ConnectSvc:              connectSvc,
+		ConnectGitOpsSvc:        connectGitOpsSvc,
+		ConnectHealthMonitor:    connectHealthMonitor,
+		ConnectStatusAggregator: connectStatusAggregator,
+		ConnectGuidesLoader:     connectGuidesLoader,
+		RedpandaSvc:             redpandaSvc,
+		Hooks:                   newDefaultHooks(),
+		FrontendResources:       fsys,
 		License: redpanda.License{
 			Source:    redpanda.LicenseSourceConsole,
 			Type:      redpanda.LicenseTypeOpenSource,
@@ -124,6 +176,30 @@ func (api *API) Start() {
 		api.Logger.Fatal("failed to start console service", zap.Error(err))
 	}
 
+	if api.ConnectSvc != nil {
+		api.ConnectSvc.StartWorkerDiscovery(context.Background())
+	}
+
+	if api.ConnectGitOpsSvc != nil {
+		if err := api.ConnectGitOpsSvc.Start(); err != nil {
+			api.Logger.Fatal("failed to start Kafka connect gitops reconciler", zap.Error(err))
+		}
+	}
+
+	if api.ConnectHealthMonitor != nil {
+		api.ConnectHealthMonitor.Start(context.Background())
+	}
+
+	if api.ConnectStatusAggregator != nil {
+		api.ConnectStatusAggregator.Start(context.Background())
+	}
+
+	if api.ConnectGuidesLoader != nil {
+		if err := api.ConnectGuidesLoader.Start(); err != nil {
+			api.Logger.Fatal("failed to start Kafka connect guides loader", zap.Error(err))
+		}
+	}
+
 	mux := api.routes()
 
 	// Server
@@ -139,6 +215,14 @@ func (api *API) Start() {
 	if err != nil {
 		api.Logger.Fatal("REST Server returned an error", zap.Error(err))
 	}
+
+	// Pending logger level reverts that are held in memory only must be carried
+	// out before Console exits.
+	if api.ConnectSvc != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), api.Cfg.REST.ServerGracefulShutdownTimeout)
+		api.ConnectSvc.Stop(ctx)
+		cancel()
+	}
 }
 
 // Stop gracefully stops the API.
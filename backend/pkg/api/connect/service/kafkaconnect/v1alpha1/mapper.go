@@ -15,8 +15,12 @@ import (
 	"fmt"
 
 	con "github.com/cloudhut/connect-client"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	kafkaconnect "github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/connect/gitops"
+	"github.com/redpanda-data/console/backend/pkg/git"
 	dataplanev1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1"
 )
 
@@ -249,6 +253,241 @@ func (m mapper) connectorStatusToProto(status kafkaconnect.ConnectorStatus) (*da
 	}, nil
 }
 
+func (mapper) loggerLevelToProto(logger kafkaconnect.LoggerLevel) *dataplanev1alpha1.ConnectLogger {
+	res := &dataplanev1alpha1.ConnectLogger{
+		Name:        logger.Name,
+		Level:       logger.Level,
+		RevertLevel: logger.RevertLevel,
+	}
+	if logger.LastModified != nil {
+		res.LastModified = timestamppb.New(*logger.LastModified)
+	}
+	if logger.RevertAt != nil {
+		res.RevertTime = timestamppb.New(*logger.RevertAt)
+	}
+	return res
+}
+
+func (m mapper) loggerLevelsToProto(loggers []kafkaconnect.LoggerLevel) []*dataplanev1alpha1.ConnectLogger {
+	res := make([]*dataplanev1alpha1.ConnectLogger, len(loggers))
+	for i, logger := range loggers {
+		res[i] = m.loggerLevelToProto(logger)
+	}
+	return res
+}
+
+func (mapper) historyOperationToProto(operation kafkaconnect.HistoryOperation) dataplanev1alpha1.ConnectorHistoryOperation {
+	switch operation {
+	case kafkaconnect.HistoryOperationCreate:
+		return dataplanev1alpha1.ConnectorHistoryOperation_CONNECTOR_HISTORY_OPERATION_CREATE
+	case kafkaconnect.HistoryOperationUpdate:
+		return dataplanev1alpha1.ConnectorHistoryOperation_CONNECTOR_HISTORY_OPERATION_UPDATE
+	case kafkaconnect.HistoryOperationDelete:
+		return dataplanev1alpha1.ConnectorHistoryOperation_CONNECTOR_HISTORY_OPERATION_DELETE
+	case kafkaconnect.HistoryOperationRollback:
+		return dataplanev1alpha1.ConnectorHistoryOperation_CONNECTOR_HISTORY_OPERATION_ROLLBACK
+	default:
+		return dataplanev1alpha1.ConnectorHistoryOperation_CONNECTOR_HISTORY_OPERATION_UNSPECIFIED
+	}
+}
+
+func (m mapper) historyEntriesToProto(entries []kafkaconnect.HistoryEntry) []*dataplanev1alpha1.ConnectorHistoryEntry {
+	res := make([]*dataplanev1alpha1.ConnectorHistoryEntry, len(entries))
+	for i, entry := range entries {
+		res[i] = &dataplanev1alpha1.ConnectorHistoryEntry{
+			Version:             int32(entry.Version),
+			Operation:           m.historyOperationToProto(entry.Operation),
+			Actor:               entry.Actor,
+			Time:                timestamppb.New(entry.Timestamp),
+			ConfigBefore:        entry.ConfigBefore,
+			ConfigAfter:         entry.ConfigAfter,
+			RolledBackToVersion: int32(entry.RolledBackToVersion),
+		}
+	}
+	return res
+}
+
+func (mapper) configChangeTypeToProto(changeType kafkaconnect.ConfigChangeType) dataplanev1alpha1.ConnectorConfigChangeType {
+	switch changeType {
+	case kafkaconnect.ConfigChangeTypeAdded:
+		return dataplanev1alpha1.ConnectorConfigChangeType_CONNECTOR_CONFIG_CHANGE_TYPE_ADDED
+	case kafkaconnect.ConfigChangeTypeRemoved:
+		return dataplanev1alpha1.ConnectorConfigChangeType_CONNECTOR_CONFIG_CHANGE_TYPE_REMOVED
+	case kafkaconnect.ConfigChangeTypeChanged:
+		return dataplanev1alpha1.ConnectorConfigChangeType_CONNECTOR_CONFIG_CHANGE_TYPE_CHANGED
+	default:
+		return dataplanev1alpha1.ConnectorConfigChangeType_CONNECTOR_CONFIG_CHANGE_TYPE_UNSPECIFIED
+	}
+}
+
+func (m mapper) connectorConfigDiffToProto(diff kafkaconnect.ConnectorConfigDiff) *dataplanev1alpha1.DiffConnectorHistoryResponse {
+	return &dataplanev1alpha1.DiffConnectorHistoryResponse{
+		FromVersion: int32(diff.FromVersion),
+		ToVersion:   int32(diff.ToVersion),
+		Changes:     m.configChangesToProto(diff.Changes),
+	}
+}
+
+func (m mapper) configChangesToProto(changes []kafkaconnect.ConfigChange) []*dataplanev1alpha1.ConnectorConfigChange {
+	res := make([]*dataplanev1alpha1.ConnectorConfigChange, len(changes))
+	for i, change := range changes {
+		res[i] = &dataplanev1alpha1.ConnectorConfigChange{
+			Key:  change.Key,
+			Type: m.configChangeTypeToProto(change.Type),
+		}
+		if change.OldValue != nil {
+			res[i].OldValue = *change.OldValue
+		}
+		if change.NewValue != nil {
+			res[i].NewValue = *change.NewValue
+		}
+	}
+	return res
+}
+
+func (mapper) gitOpsRepositoryToProto(repository git.RepositoryStatus) *dataplanev1alpha1.ConnectGitOpsRepository {
+	res := &dataplanev1alpha1.ConnectGitOpsRepository{
+		Url:           repository.URL,
+		Reference:     repository.Reference,
+		BaseDirectory: repository.BaseDirectory,
+		LastError:     repository.LastError,
+		Files:         int32(repository.Files),
+	}
+	if !repository.LastSyncedAt.IsZero() {
+		res.LastSyncTime = timestamppb.New(repository.LastSyncedAt)
+	}
+	return res
+}
+
+func (mapper) gitOpsConnectorStateToProto(state gitops.ConnectorState) dataplanev1alpha1.ConnectGitOpsConnectorState {
+	switch state {
+	case gitops.ConnectorStateInSync:
+		return dataplanev1alpha1.ConnectGitOpsConnectorState_CONNECT_GIT_OPS_CONNECTOR_STATE_IN_SYNC
+	case gitops.ConnectorStateMissing:
+		return dataplanev1alpha1.ConnectGitOpsConnectorState_CONNECT_GIT_OPS_CONNECTOR_STATE_MISSING
+	case gitops.ConnectorStateDrifted:
+		return dataplanev1alpha1.ConnectGitOpsConnectorState_CONNECT_GIT_OPS_CONNECTOR_STATE_DRIFTED
+	case gitops.ConnectorStateConflict:
+		return dataplanev1alpha1.ConnectGitOpsConnectorState_CONNECT_GIT_OPS_CONNECTOR_STATE_CONFLICT
+	case gitops.ConnectorStateOrphaned:
+		return dataplanev1alpha1.ConnectGitOpsConnectorState_CONNECT_GIT_OPS_CONNECTOR_STATE_ORPHANED
+	case gitops.ConnectorStateUnmanaged:
+		return dataplanev1alpha1.ConnectGitOpsConnectorState_CONNECT_GIT_OPS_CONNECTOR_STATE_UNMANAGED
+	default:
+		return dataplanev1alpha1.ConnectGitOpsConnectorState_CONNECT_GIT_OPS_CONNECTOR_STATE_UNSPECIFIED
+	}
+}
+
+func (mapper) gitOpsActionToProto(action gitops.Action) dataplanev1alpha1.ConnectGitOpsAction {
+	switch action {
+	case gitops.ActionNone:
+		return dataplanev1alpha1.ConnectGitOpsAction_CONNECT_GIT_OPS_ACTION_NONE
+	case gitops.ActionCreate:
+		return dataplanev1alpha1.ConnectGitOpsAction_CONNECT_GIT_OPS_ACTION_CREATE
+	case gitops.ActionUpdate:
+		return dataplanev1alpha1.ConnectGitOpsAction_CONNECT_GIT_OPS_ACTION_UPDATE
+	case gitops.ActionDelete:
+		return dataplanev1alpha1.ConnectGitOpsAction_CONNECT_GIT_OPS_ACTION_DELETE
+	default:
+		return dataplanev1alpha1.ConnectGitOpsAction_CONNECT_GIT_OPS_ACTION_UNSPECIFIED
+	}
+}
+
+// gitOpsPlanToProto maps the plan with the items of all clusters for which
+// canView returns true. It returns nil if the plan is nil.
+func (m mapper) gitOpsPlanToProto(plan *gitops.Plan, canView func(clusterName string) bool) *dataplanev1alpha1.ConnectGitOpsPlan {
+	if plan == nil {
+		return nil
+	}
+	items := make([]*dataplanev1alpha1.ConnectGitOpsPlanItem, 0, len(plan.Items))
+	for _, item := range plan.Items {
+		if !canView(item.ClusterName) {
+			continue
+		}
+		items = append(items, &dataplanev1alpha1.ConnectGitOpsPlanItem{
+			ClusterName:   item.ClusterName,
+			ConnectorName: item.ConnectorName,
+			Owner:         item.Owner,
+			Source:        item.Source,
+			State:         m.gitOpsConnectorStateToProto(item.State),
+			Action:        m.gitOpsActionToProto(item.Action),
+			Changes:       m.configChangesToProto(item.Changes),
+			Error:         item.Error,
+		})
+	}
+	return &dataplanev1alpha1.ConnectGitOpsPlan{
+		DryRun:     plan.DryRun,
+		CreateTime: timestamppb.New(plan.CreatedAt),
+		Items:      items,
+		Errors:     plan.Errors,
+	}
+}
+
+func (mapper) connectorOffsetsToProto(offsets kafkaconnect.ConnectorOffsetsResponse) (*dataplanev1alpha1.ListConnectorOffsetsResponse, error) {
+	protoOffsets := make([]*dataplanev1alpha1.ConnectorOffset, len(offsets.Offsets))
+	for i, offset := range offsets.Offsets {
+		partition, err := structpb.NewStruct(offset.Partition)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map partition of connector offset: %w", err)
+		}
+		var protoOffset *structpb.Struct
+		if offset.Offset != nil {
+			protoOffset, err = structpb.NewStruct(offset.Offset)
+			if err != nil {
+				return nil, fmt.Errorf("failed to map offset of connector offset: %w", err)
+			}
+		}
+		protoOffsets[i] = &dataplanev1alpha1.ConnectorOffset{
+			Partition: partition,
+			Offset:    protoOffset,
+		}
+	}
+
+	sinkOffsets := make([]*dataplanev1alpha1.SinkConnectorOffset, len(offsets.SinkOffsets))
+	for i, offset := range offsets.SinkOffsets {
+		sinkOffsets[i] = &dataplanev1alpha1.SinkConnectorOffset{
+			Topic:     offset.Topic,
+			Partition: offset.Partition,
+			Offset:    offset.Offset,
+		}
+	}
+
+	return &dataplanev1alpha1.ListConnectorOffsetsResponse{
+		Type:            offsets.Type,
+		Offsets:         protoOffsets,
+		ConsumerGroupId: offsets.ConsumerGroupID,
+		SinkOffsets:     sinkOffsets,
+	}, nil
+}
+
+func (mapper) connectorOffsetsToKafkaConnect(offsets []*dataplanev1alpha1.ConnectorOffset) ([]kafkaconnect.ConnectorOffset, error) {
+	connectorOffsets := make([]kafkaconnect.ConnectorOffset, len(offsets))
+	for i, offset := range offsets {
+		if len(offset.GetPartition().GetFields()) == 0 {
+			return nil, errors.New("each offset must specify a partition")
+		}
+		connectorOffsets[i] = kafkaconnect.ConnectorOffset{
+			Partition: offset.GetPartition().AsMap(),
+		}
+		if offset.GetOffset() != nil {
+			connectorOffsets[i].Offset = offset.GetOffset().AsMap()
+		}
+	}
+	return connectorOffsets, nil
+}
+
+func (mapper) sinkConnectorOffsetsToKafkaConnect(offsets []*dataplanev1alpha1.SinkConnectorOffset) []kafkaconnect.SinkConnectorOffset {
+	sinkOffsets := make([]kafkaconnect.SinkConnectorOffset, len(offsets))
+	for i, offset := range offsets {
+		sinkOffsets[i] = kafkaconnect.SinkConnectorOffset{
+			Topic:     offset.Topic,
+			Partition: offset.Partition,
+			Offset:    offset.Offset,
+		}
+	}
+	return sinkOffsets
+}
+
 // convertStringMapToInterfaceMap converts interface map to string map
 func convertStringMapToInterfaceMap(stringMap map[string]string) map[string]any {
 	interfaceMap := make(map[string]any, len(stringMap))
@@ -16,17 +16,22 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"time"
 
+	commonv1alpha1 "buf.build/gen/go/redpandadata/common/protocolbuffers/go/redpanda/api/common/v1alpha1"
 	"connectrpc.com/connect"
 	"github.com/cloudhut/common/rest"
 	con "github.com/cloudhut/connect-client"
 	"github.com/redpanda-data/common-go/api/pagination"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	"github.com/redpanda-data/console/backend/pkg/api/hooks"
 	"github.com/redpanda-data/console/backend/pkg/config"
 	kafkaconnect "github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/connect/gitops"
 	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1"
 	"github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1/dataplanev1alpha1connect"
 )
@@ -40,22 +45,52 @@ type Service struct {
 	connectSvc *kafkaconnect.Service
 	mapper     *mapper
 	defaulter  defaulter
+
+	// gitOpsSvc reconciles the connectors declared in a Git repository. It is
+	// nil if GitOps for Kafka connect is not enabled.
+	gitOpsSvc *gitops.Reconciler
+	authHooks hooks.AuthorizationHooks
+
+	// loggingFieldsHook returns additional fields, such as the requester,
+	// for the audit log entries and the connector config history of changes
+	// to the connect clusters.
+	loggingFieldsHook func(ctx context.Context) []zapcore.Field
 }
 
 // NewService creates a new user service handler.
 func NewService(cfg *config.Config,
 	logger *zap.Logger,
 	kafkaConnectSrv *kafkaconnect.Service,
+	gitOpsSvc *gitops.Reconciler,
+	authHooks hooks.AuthorizationHooks,
+	loggingFieldsHook func(ctx context.Context) []zapcore.Field,
 ) *Service {
 	return &Service{
-		cfg:        cfg,
-		logger:     logger,
-		connectSvc: kafkaConnectSrv,
-		mapper:     &mapper{},
-		defaulter:  defaulter{},
+		cfg:               cfg,
+		logger:            logger,
+		connectSvc:        kafkaConnectSrv,
+		mapper:            &mapper{},
+		defaulter:         defaulter{},
+		gitOpsSvc:         gitOpsSvc,
+		authHooks:         authHooks,
+		loggingFieldsHook: loggingFieldsHook,
 	}
 }
 
+// requesterFields returns the fields that identify the requester.
+func (s *Service) requesterFields(ctx context.Context) []zapcore.Field {
+	if s.loggingFieldsHook == nil {
+		return nil
+	}
+	return s.loggingFieldsHook(ctx)
+}
+
+// withActor returns a context that carries the requester as actor, which is
+// recorded in the connector config history.
+func (s *Service) withActor(ctx context.Context) context.Context {
+	return kafkaconnect.ContextWithActor(ctx, kafkaconnect.ActorFromLogFields(s.requesterFields(ctx)))
+}
+
 // ListConnectors implements the handler for the list connectors operation.
 func (s *Service) ListConnectors(ctx context.Context, req *connect.Request[v1alpha1.ListConnectorsRequest]) (*connect.Response[v1alpha1.ListConnectorsResponse], error) {
 	s.defaulter.applyListConnectorsRequest(req.Msg)
@@ -117,7 +152,7 @@ func (s *Service) CreateConnector(ctx context.Context, req *connect.Request[v1al
 		)
 	}
 
-	response, err := s.connectSvc.CreateConnector(ctx, req.Msg.ClusterName, *kafkaConnectResponse)
+	response, err := s.connectSvc.CreateConnector(s.withActor(ctx), req.Msg.ClusterName, *kafkaConnectResponse)
 	if err != nil {
 		return nil, s.matchError(err)
 	}
@@ -205,7 +240,7 @@ func (s *Service) PauseConnector(ctx context.Context, req *connect.Request[v1alp
 // DeleteConnector implements the handler for the delete connector operation
 func (s *Service) DeleteConnector(ctx context.Context, req *connect.Request[v1alpha1.DeleteConnectorRequest]) (*connect.Response[emptypb.Empty], error) {
 	// attempt to delete connector
-	if err := s.connectSvc.DeleteConnector(ctx, req.Msg.ClusterName, req.Msg.Name); err != nil {
+	if err := s.connectSvc.DeleteConnector(s.withActor(ctx), req.Msg.ClusterName, req.Msg.Name); err != nil {
 		return nil, s.matchError(err)
 	}
 
@@ -282,7 +317,7 @@ func (s *Service) UpsertConnector(ctx context.Context, req *connect.Request[v1al
 		}
 	}
 
-	conInfo, err := s.connectSvc.PutConnectorConfig(ctx, req.Msg.ClusterName, req.Msg.Name, putConnectorConfigRequest)
+	conInfo, err := s.connectSvc.PutConnectorConfig(s.withActor(ctx), req.Msg.ClusterName, req.Msg.Name, putConnectorConfigRequest)
 	if err != nil {
 		return nil, s.matchError(err)
 	}
@@ -336,6 +371,209 @@ func (s *Service) ResetConnectorTopics(ctx context.Context, req *connect.Request
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// ListConnectLoggers implements the handler for the list connect loggers
+// operation, it returns the loggers of the connect workers and their levels.
+func (s *Service) ListConnectLoggers(ctx context.Context, req *connect.Request[v1alpha1.ListConnectLoggersRequest]) (*connect.Response[v1alpha1.ListConnectLoggersResponse], error) {
+	loggers, err := s.connectSvc.ListLoggers(ctx, req.Msg.ClusterName)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(&v1alpha1.ListConnectLoggersResponse{
+		Loggers: s.mapper.loggerLevelsToProto(loggers),
+	}), nil
+}
+
+// GetConnectLogger implements the handler for the get connect logger operation.
+func (s *Service) GetConnectLogger(ctx context.Context, req *connect.Request[v1alpha1.GetConnectLoggerRequest]) (*connect.Response[v1alpha1.GetConnectLoggerResponse], error) {
+	logger, err := s.connectSvc.GetLogger(ctx, req.Msg.ClusterName, req.Msg.Name)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(&v1alpha1.GetConnectLoggerResponse{
+		Logger: s.mapper.loggerLevelToProto(logger),
+	}), nil
+}
+
+// SetConnectLoggerLevel implements the handler for the set connect logger
+// level operation. The level is set on all workers of the connect cluster and
+// reverted after the requested TTL. Every change is recorded in the audit log.
+func (s *Service) SetConnectLoggerLevel(ctx context.Context, req *connect.Request[v1alpha1.SetConnectLoggerLevelRequest]) (*connect.Response[v1alpha1.SetConnectLoggerLevelResponse], error) {
+	auditFields := s.requesterFields(ctx)
+
+	logger, err := s.connectSvc.SetLoggerLevel(ctx, req.Msg.ClusterName, req.Msg.Name, req.Msg.Level, req.Msg.GetTtl().AsDuration(), auditFields)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	fields := []zapcore.Field{
+		zap.String("cluster", req.Msg.ClusterName),
+		zap.String("logger", logger.Name),
+		zap.String("level", logger.Level),
+		zap.String("revert_level", logger.RevertLevel),
+	}
+	if logger.RevertAt != nil {
+		fields = append(fields, zap.Time("revert_at", *logger.RevertAt))
+	}
+	s.logger.Info("set kafka connect logger level", append(fields, auditFields...)...)
+
+	return connect.NewResponse(&v1alpha1.SetConnectLoggerLevelResponse{
+		Logger: s.mapper.loggerLevelToProto(logger),
+	}), nil
+}
+
+// ListConnectorHistory implements the handler for the list connector history
+// operation, it returns the recorded config changes of a connector.
+func (s *Service) ListConnectorHistory(ctx context.Context, req *connect.Request[v1alpha1.ListConnectorHistoryRequest]) (*connect.Response[v1alpha1.ListConnectorHistoryResponse], error) {
+	entries, err := s.connectSvc.ListConnectorHistory(ctx, req.Msg.ClusterName, req.Msg.Name)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(&v1alpha1.ListConnectorHistoryResponse{
+		Entries: s.mapper.historyEntriesToProto(entries),
+	}), nil
+}
+
+// DiffConnectorHistory implements the handler for the diff connector history
+// operation.
+func (s *Service) DiffConnectorHistory(ctx context.Context, req *connect.Request[v1alpha1.DiffConnectorHistoryRequest]) (*connect.Response[v1alpha1.DiffConnectorHistoryResponse], error) {
+	diff, err := s.connectSvc.DiffConnectorHistory(ctx, req.Msg.ClusterName, req.Msg.Name, int(req.Msg.FromVersion), int(req.Msg.ToVersion))
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(s.mapper.connectorConfigDiffToProto(diff)), nil
+}
+
+// RollbackConnector implements the handler for the rollback connector
+// operation. The connector and all its tasks are restarted afterwards, so that
+// the restored config is applied.
+func (s *Service) RollbackConnector(ctx context.Context, req *connect.Request[v1alpha1.RollbackConnectorRequest]) (*connect.Response[v1alpha1.RollbackConnectorResponse], error) {
+	ctx = s.withActor(ctx)
+	conInfo, err := s.connectSvc.RollbackConnector(ctx, req.Msg.ClusterName, req.Msg.Name, int(req.Msg.Version), req.Msg.SecretValues)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	if err := s.connectSvc.RestartConnector(ctx, req.Msg.ClusterName, req.Msg.Name, true, false); err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(&v1alpha1.RollbackConnectorResponse{
+		Connector: s.mapper.connectorSpecToProto(conInfo),
+	}), nil
+}
+
+// ListConnectorOffsets implements the handler for the list connector offsets
+// operation.
+func (s *Service) ListConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error) {
+	offsets, restErr := s.connectSvc.GetConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name)
+	if restErr != nil {
+		return nil, s.matchError(restErr)
+	}
+
+	res, err := s.mapper.connectorOffsetsToProto(offsets)
+	if err != nil {
+		return nil, apierrors.NewConnectError(
+			connect.CodeInternal,
+			err,
+			apierrors.NewErrorInfo(v1alpha1.Reason_REASON_CONSOLE_ERROR.String()),
+		)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// AlterConnectorOffsets implements the handler for the alter connector offsets
+// operation. Either the offsets in the format of the connector or, for sink
+// connectors, consumer group offsets must be given.
+func (s *Service) AlterConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error) {
+	if (len(req.Msg.Offsets) == 0) == (len(req.Msg.SinkOffsets) == 0) {
+		return nil, apierrors.NewConnectError(
+			connect.CodeInvalidArgument,
+			errors.New("exactly one of offsets or sink_offsets must be set"),
+			apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_INVALID_INPUT.String()),
+		)
+	}
+
+	var msg string
+	var restErr *rest.Error
+	if len(req.Msg.SinkOffsets) > 0 {
+		sinkOffsets := s.mapper.sinkConnectorOffsetsToKafkaConnect(req.Msg.SinkOffsets)
+		msg, restErr = s.connectSvc.AlterSinkConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name, sinkOffsets)
+	} else {
+		offsets, err := s.mapper.connectorOffsetsToKafkaConnect(req.Msg.Offsets)
+		if err != nil {
+			return nil, apierrors.NewConnectError(
+				connect.CodeInvalidArgument,
+				err,
+				apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_INVALID_INPUT.String()),
+			)
+		}
+		msg, restErr = s.connectSvc.AlterConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name, offsets)
+	}
+	if restErr != nil {
+		return nil, s.matchError(restErr)
+	}
+
+	return connect.NewResponse(&v1alpha1.AlterConnectorOffsetsResponse{Message: msg}), nil
+}
+
+// ResetConnectorOffsets implements the handler for the reset connector offsets
+// operation.
+func (s *Service) ResetConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error) {
+	msg, restErr := s.connectSvc.ResetConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name)
+	if restErr != nil {
+		return nil, s.matchError(restErr)
+	}
+
+	return connect.NewResponse(&v1alpha1.ResetConnectorOffsetsResponse{Message: msg}), nil
+}
+
+// gitOpsStatusTimeout is the maximum duration for computing the GitOps drift.
+const gitOpsStatusTimeout = 2 * time.Minute
+
+// GetConnectGitOpsStatus implements the handler for the get connect GitOps
+// status operation. Only connectors of clusters that the requester is allowed
+// to view are returned.
+func (s *Service) GetConnectGitOpsStatus(ctx context.Context, _ *connect.Request[v1alpha1.GetConnectGitOpsStatusRequest]) (*connect.Response[v1alpha1.GetConnectGitOpsStatusResponse], error) {
+	if s.gitOpsSvc == nil {
+		return nil, apierrors.NewConnectError(
+			connect.CodeUnimplemented,
+			errors.New("gitops for kafka connect is not enabled"),
+			apierrors.NewErrorInfo(v1alpha1.Reason_REASON_FEATURE_NOT_CONFIGURED.String()),
+			apierrors.NewHelp(apierrors.NewHelpLinkConsoleReferenceConfig()),
+		)
+	}
+
+	statusCtx, cancel := context.WithTimeout(ctx, gitOpsStatusTimeout)
+	defer cancel()
+	status := s.gitOpsSvc.Status(statusCtx)
+
+	canViewByCluster := make(map[string]bool)
+	canView := func(clusterName string) bool {
+		allowed, exists := canViewByCluster[clusterName]
+		if !exists {
+			var restErr *rest.Error
+			allowed, restErr = s.authHooks.CanViewConnectCluster(ctx, clusterName)
+			if restErr != nil {
+				s.logger.Error("failed to check view connect cluster permissions", zap.Error(restErr.Err))
+				allowed = false
+			}
+			canViewByCluster[clusterName] = allowed
+		}
+		return allowed
+	}
+
+	return connect.NewResponse(&v1alpha1.GetConnectGitOpsStatusResponse{
+		Repository:         s.mapper.gitOpsRepositoryToProto(status.Repository),
+		Drift:              s.mapper.gitOpsPlanToProto(status.Drift, canView),
+		LastReconciliation: s.mapper.gitOpsPlanToProto(status.LastReconciliation, canView),
+	}), nil
+}
+
 func (*Service) matchError(err *rest.Error) *connect.Error {
 	switch err.Status {
 	case http.StatusNotFound:
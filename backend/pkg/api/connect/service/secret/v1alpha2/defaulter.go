@@ -0,0 +1,21 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secret
+
+import v1alpha2 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha2"
+
+// defaulter updates a given secret request with defaults.
+type defaulter struct{}
+
+func (*defaulter) applyListConnectSecretsRequest(req *v1alpha2.ListConnectSecretsRequest) {
+	if req.GetPageSize() == 0 {
+		req.PageSize = 100
+	}
+}
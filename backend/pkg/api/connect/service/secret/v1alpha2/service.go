@@ -0,0 +1,240 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package secret implements the Kafka connect secret endpoints backed by the
+// configured secret store.
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/redpanda-data/common-go/api/pagination"
+	"go.uber.org/zap"
+
+	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	"github.com/redpanda-data/console/backend/pkg/api/hooks"
+	kafkaconnect "github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/connect/secrets"
+	v1alpha2 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha2"
+	"github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2connect"
+)
+
+var _ dataplanev1alpha2connect.SecretServiceHandler = (*Service)(nil)
+
+// Service that implements the SecretServiceHandler interface for the secrets of
+// Kafka connect clusters.
+type Service struct {
+	logger     *zap.Logger
+	connectSvc *kafkaconnect.Service
+	authHooks  hooks.AuthorizationHooks
+	defaulter  defaulter
+}
+
+// NewService creates a new secret service handler.
+func NewService(logger *zap.Logger, connectSvc *kafkaconnect.Service, authHooks hooks.AuthorizationHooks) *Service {
+	return &Service{
+		logger:     logger,
+		connectSvc: connectSvc,
+		authHooks:  authHooks,
+		defaulter:  defaulter{},
+	}
+}
+
+// GetConnectSecret returns the secret without its value.
+func (s *Service) GetConnectSecret(ctx context.Context, req *connect.Request[v1alpha2.GetConnectSecretRequest]) (*connect.Response[v1alpha2.GetConnectSecretResponse], error) {
+	canView, restErr := s.authHooks.CanViewConnectCluster(ctx, req.Msg.GetClusterName())
+	if err := apierrors.NewPermissionDeniedConnectError(canView, restErr, "you don't have permissions to view secrets of this Kafka connect cluster"); err != nil {
+		return nil, err
+	}
+	store, err := s.storeForCluster(req.Msg.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := store.Get(ctx, req.Msg.GetClusterName(), req.Msg.GetId())
+	if err != nil {
+		return nil, s.matchError(err, "failed to get secret")
+	}
+
+	return connect.NewResponse(&v1alpha2.GetConnectSecretResponse{Secret: secretToProto(secret)}), nil
+}
+
+// ListConnectSecrets lists all secrets of a Kafka connect cluster that pass the filter.
+func (s *Service) ListConnectSecrets(ctx context.Context, req *connect.Request[v1alpha2.ListConnectSecretsRequest]) (*connect.Response[v1alpha2.ListConnectSecretsResponse], error) {
+	canView, restErr := s.authHooks.CanViewConnectCluster(ctx, req.Msg.GetClusterName())
+	if err := apierrors.NewPermissionDeniedConnectError(canView, restErr, "you don't have permissions to view secrets of this Kafka connect cluster"); err != nil {
+		return nil, err
+	}
+	store, err := s.storeForCluster(req.Msg.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+	s.defaulter.applyListConnectSecretsRequest(req.Msg)
+
+	list, err := store.List(ctx, req.Msg.GetClusterName())
+	if err != nil {
+		return nil, s.matchError(err, "failed to list secrets")
+	}
+
+	filtered := make([]*v1alpha2.Secret, 0, len(list))
+	for _, secret := range list {
+		if !secretPassesFilter(secret, req.Msg.GetFilter()) {
+			continue
+		}
+		filtered = append(filtered, secretToProto(secret))
+	}
+
+	var nextPageToken string
+	if req.Msg.GetPageSize() > 0 {
+		page, token, err := pagination.SliceToPaginatedWithToken(filtered, int(req.Msg.GetPageSize()), req.Msg.GetPageToken(), "id", func(x *v1alpha2.Secret) string {
+			return x.GetId()
+		})
+		if err != nil {
+			return nil, apierrors.NewConnectError(
+				connect.CodeInternal,
+				fmt.Errorf("failed to apply pagination: %w", err),
+				apierrors.NewErrorInfo(v1alpha2.Reason_REASON_CONSOLE_ERROR.String()),
+			)
+		}
+		filtered = page
+		nextPageToken = token
+	}
+
+	return connect.NewResponse(&v1alpha2.ListConnectSecretsResponse{
+		Secrets:       filtered,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// CreateConnectSecret creates a new secret. The name of the secret is used as ID.
+func (s *Service) CreateConnectSecret(ctx context.Context, req *connect.Request[v1alpha2.CreateConnectSecretRequest]) (*connect.Response[v1alpha2.CreateConnectSecretResponse], error) {
+	canEdit, restErr := s.authHooks.CanEditConnectCluster(ctx, req.Msg.GetClusterName())
+	if err := apierrors.NewPermissionDeniedConnectError(canEdit, restErr, "you don't have permissions to create secrets in this Kafka connect cluster"); err != nil {
+		return nil, err
+	}
+	store, err := s.storeForCluster(req.Msg.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+
+	secret := secrets.Secret{ID: req.Msg.GetName(), Labels: req.Msg.GetLabels()}
+	if err := store.Create(ctx, req.Msg.GetClusterName(), secret, req.Msg.GetSecretData()); err != nil {
+		return nil, s.matchError(err, "failed to create secret")
+	}
+	s.logger.Info("created connect secret",
+		zap.String("cluster_name", req.Msg.GetClusterName()),
+		zap.String("secret_id", secret.ID))
+
+	return connect.NewResponse(&v1alpha2.CreateConnectSecretResponse{Secret: secretToProto(secret)}), nil
+}
+
+// UpdateConnectSecret replaces the value and labels of an existing secret.
+func (s *Service) UpdateConnectSecret(ctx context.Context, req *connect.Request[v1alpha2.UpdateConnectSecretRequest]) (*connect.Response[v1alpha2.UpdateConnectSecretResponse], error) {
+	canEdit, restErr := s.authHooks.CanEditConnectCluster(ctx, req.Msg.GetClusterName())
+	if err := apierrors.NewPermissionDeniedConnectError(canEdit, restErr, "you don't have permissions to update secrets in this Kafka connect cluster"); err != nil {
+		return nil, err
+	}
+	store, err := s.storeForCluster(req.Msg.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+
+	secret := secrets.Secret{ID: req.Msg.GetId(), Labels: req.Msg.GetLabels()}
+	if err := store.Update(ctx, req.Msg.GetClusterName(), secret, req.Msg.GetSecretData()); err != nil {
+		return nil, s.matchError(err, "failed to update secret")
+	}
+	s.logger.Info("updated connect secret",
+		zap.String("cluster_name", req.Msg.GetClusterName()),
+		zap.String("secret_id", secret.ID))
+
+	return connect.NewResponse(&v1alpha2.UpdateConnectSecretResponse{Secret: secretToProto(secret)}), nil
+}
+
+// DeleteConnectSecret deletes a secret.
+func (s *Service) DeleteConnectSecret(ctx context.Context, req *connect.Request[v1alpha2.DeleteConnectSecretRequest]) (*connect.Response[v1alpha2.DeleteConnectSecretResponse], error) {
+	canDelete, restErr := s.authHooks.CanDeleteConnectCluster(ctx, req.Msg.GetClusterName())
+	if err := apierrors.NewPermissionDeniedConnectError(canDelete, restErr, "you don't have permissions to delete secrets in this Kafka connect cluster"); err != nil {
+		return nil, err
+	}
+	store, err := s.storeForCluster(req.Msg.GetClusterName())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.Delete(ctx, req.Msg.GetClusterName(), req.Msg.GetId()); err != nil {
+		return nil, s.matchError(err, "failed to delete secret")
+	}
+	s.logger.Info("deleted connect secret",
+		zap.String("cluster_name", req.Msg.GetClusterName()),
+		zap.String("secret_id", req.Msg.GetId()))
+
+	return connect.NewResponse(&v1alpha2.DeleteConnectSecretResponse{}), nil
+}
+
+// storeForCluster returns the secret store if it is configured and the Kafka
+// connect cluster exists.
+func (s *Service) storeForCluster(clusterName string) (secrets.Store, error) {
+	if s.connectSvc.SecretStore == nil {
+		return nil, apierrors.NewConnectError(
+			connect.CodeUnimplemented,
+			errors.New("a secret store must be configured to manage Kafka connect secrets"),
+			apierrors.NewErrorInfo(v1alpha2.Reason_REASON_FEATURE_NOT_CONFIGURED.String()),
+			apierrors.NewHelp(apierrors.NewHelpLinkConsoleReferenceConfig()),
+		)
+	}
+	if _, exists := s.connectSvc.ClientsByCluster[clusterName]; !exists {
+		return nil, apierrors.NewConnectError(
+			connect.CodeNotFound,
+			fmt.Errorf("kafka connect cluster %q does not exist", clusterName),
+			apierrors.NewErrorInfo(v1alpha2.Reason_REASON_KAFKA_CONNECT_API_ERROR.String()),
+		)
+	}
+	return s.connectSvc.SecretStore, nil
+}
+
+func (*Service) matchError(err error, msg string) error {
+	code := connect.CodeInternal
+	switch {
+	case errors.Is(err, secrets.ErrSecretNotFound):
+		code = connect.CodeNotFound
+	case errors.Is(err, secrets.ErrSecretAlreadyExists):
+		code = connect.CodeAlreadyExists
+	}
+	return apierrors.NewConnectError(
+		code,
+		fmt.Errorf("%s: %w", msg, err),
+		apierrors.NewErrorInfo(v1alpha2.Reason_REASON_SECRET_STORE_ERROR.String()),
+	)
+}
+
+func secretToProto(secret secrets.Secret) *v1alpha2.Secret {
+	return &v1alpha2.Secret{
+		Id:     secret.ID,
+		Labels: secret.Labels,
+	}
+}
+
+func secretPassesFilter(secret secrets.Secret, filter *v1alpha2.ListSecretsFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if !strings.Contains(secret.ID, filter.GetNameContains()) {
+		return false
+	}
+	for k, v := range filter.GetLabels() {
+		if secret.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+	"github.com/redpanda-data/console/backend/pkg/git"
+)
+
+// maxGitWebhookPayloadSize is the maximum size of webhook payloads that we read for
+// validating the signature. Push event payloads are usually way smaller.
+const maxGitWebhookPayloadSize = 5 * 1024 * 1024
+
+// gitWebhookResponse reports the sync state of all Git repositories after a refresh.
+type gitWebhookResponse struct {
+	*console.GitRefreshResult
+	ConnectGitOps *git.RepositoryStatus `json:"connectGitOps,omitempty"`
+}
+
+func (api *API) handleGitWebhook() http.HandlerFunc {
+	if !api.Cfg.Console.GitWebhook.Enabled {
+		return func(w http.ResponseWriter, r *http.Request) {
+			http.NotFound(w, r)
+		}
+	}
+	secret := []byte(api.Cfg.Console.GitWebhook.Secret)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxGitWebhookPayloadSize)
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("failed to read webhook payload: %w", err),
+				Status:   http.StatusBadRequest,
+				Message:  "Failed to read webhook payload",
+				IsSilent: false,
+			})
+			return
+		}
+
+		if err := verifyGitWebhookRequest(r.Header, payload, secret); err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusUnauthorized,
+				Message:  "Invalid webhook secret",
+				IsSilent: false,
+			})
+			return
+		}
+
+		res, err := api.ConsoleSvc.RefreshGitRepositories(r.Context())
+		if err != nil {
+			// Repositories that could not be pulled are reported as part of the response.
+			api.Logger.Warn("failed to refresh git repositories from webhook", zap.Error(err))
+		}
+
+		response := gitWebhookResponse{GitRefreshResult: res}
+		if api.ConnectGitOpsSvc != nil {
+			if err := api.ConnectGitOpsSvc.Refresh(r.Context()); err != nil {
+				api.Logger.Warn("failed to refresh kafka connect gitops repository from webhook", zap.Error(err))
+			}
+			status := api.ConnectGitOpsSvc.RepositoryStatus()
+			response.ConnectGitOps = &status
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response)
+	}
+}
+
+// verifyGitWebhookRequest checks that the request has been signed (GitHub, Gitea) or
+// contains a token (GitLab) that matches the configured shared secret.
+func verifyGitWebhookRequest(header http.Header, payload, secret []byte) error {
+	if signature := header.Get("X-Hub-Signature-256"); signature != "" {
+		hexSignature, ok := strings.CutPrefix(signature, "sha256=")
+		if !ok {
+			return errors.New("webhook signature has an unsupported format")
+		}
+		decodedSignature, err := hex.DecodeString(hexSignature)
+		if err != nil {
+			return fmt.Errorf("failed to decode webhook signature: %w", err)
+		}
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(payload)
+		if !hmac.Equal(decodedSignature, mac.Sum(nil)) {
+			return errors.New("webhook signature does not match")
+		}
+		return nil
+	}
+
+	if token := header.Get("X-Gitlab-Token"); token != "" {
+		if subtle.ConstantTimeCompare([]byte(token), secret) != 1 {
+			return errors.New("webhook token does not match")
+		}
+		return nil
+	}
+
+	return errors.New("webhook request contains neither a signature nor a token")
+}
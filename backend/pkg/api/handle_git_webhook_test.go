@@ -0,0 +1,75 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyGitWebhookRequest(t *testing.T) {
+	secret := []byte("s3cr3t")
+	payload := []byte(`{"ref":"refs/heads/main"}`)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	validSignature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name    string
+		header  http.Header
+		wantErr bool
+	}{
+		{
+			name:   "valid signature",
+			header: http.Header{"X-Hub-Signature-256": []string{validSignature}},
+		},
+		{
+			name:    "invalid signature",
+			header:  http.Header{"X-Hub-Signature-256": []string{"sha256=" + hex.EncodeToString([]byte("nope"))}},
+			wantErr: true,
+		},
+		{
+			name:    "signature without prefix",
+			header:  http.Header{"X-Hub-Signature-256": []string{validSignature[len("sha256="):]}},
+			wantErr: true,
+		},
+		{
+			name:   "valid token",
+			header: http.Header{"X-Gitlab-Token": []string{"s3cr3t"}},
+		},
+		{
+			name:    "invalid token",
+			header:  http.Header{"X-Gitlab-Token": []string{"wrong"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing credentials",
+			header:  http.Header{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyGitWebhookRequest(tt.header, payload, secret)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
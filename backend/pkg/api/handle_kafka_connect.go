@@ -129,6 +129,9 @@ func (api *API) handleGetClusterInfo() http.HandlerFunc {
 			return
 		}
 
+		if api.ConnectSvc.SecretStore != nil {
+			clusterInfo.EnabledFeatures = append(clusterInfo.EnabledFeatures, connect.ClusterFeatureSecretStore)
+		}
 		clusterFeatures := api.Hooks.Console.EnabledConnectClusterFeatures(r.Context(), clusterName)
 		clusterInfo.EnabledFeatures = append(clusterInfo.EnabledFeatures, clusterFeatures...)
 
@@ -215,7 +218,7 @@ func (api *API) handlePutConnectorConfig() http.HandlerFunc {
 			return
 		}
 
-		cInfo, restErr := api.ConnectSvc.PutConnectorConfig(r.Context(), clusterName, connectorName, req.ToClientRequest())
+		cInfo, restErr := api.ConnectSvc.PutConnectorConfig(api.withConnectActor(r.Context()), clusterName, connectorName, req.ToClientRequest())
 		if restErr != nil {
 			rest.SendRESTError(w, r, api.Logger, restErr)
 			return
@@ -313,7 +316,7 @@ func (api *API) handleCreateConnector() http.HandlerFunc {
 			return
 		}
 
-		cInfo, restErr := api.ConnectSvc.CreateConnector(r.Context(), clusterName, req.ToClientRequest())
+		cInfo, restErr := api.ConnectSvc.CreateConnector(api.withConnectActor(r.Context()), clusterName, req.ToClientRequest())
 		if restErr != nil {
 			rest.SendRESTError(w, r, api.Logger, restErr)
 			return
@@ -328,7 +331,7 @@ func (api *API) handleDeleteConnector() http.HandlerFunc {
 		clusterName := rest.GetURLParam(r, "clusterName")
 		connector := rest.GetURLParam(r, "connector")
 
-		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		ctx, cancel := context.WithTimeout(api.withConnectActor(r.Context()), api.ConnectSvc.Cfg.RequestTimeout)
 		defer cancel()
 
 		canDelete, restErr := api.Hooks.Authorization.CanDeleteConnectCluster(r.Context(), clusterName)
@@ -0,0 +1,68 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+func (api *API) handleGetConnectorDependencies() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		if !api.checkCanViewConnectCluster(w, r, clusterName) {
+			return
+		}
+
+		deps, restErr := api.ConsoleSvc.GetConnectorDependencies(r.Context(), clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// Topics, consumer groups and schemas are only part of the graph if the
+		// requester is allowed to see them. The lag and subjects of hidden topics
+		// are removed along with the topics.
+		restErr = deps.FilterTopics(func(topicName string) (bool, *rest.Error) {
+			return api.Hooks.Authorization.CanSeeTopic(r.Context(), topicName)
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if deps.ConsumerGroup != nil {
+			canSee, restErr := api.Hooks.Authorization.CanSeeConsumerGroup(r.Context(), deps.ConsumerGroup.GroupID)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if !canSee {
+				deps.ConsumerGroup = nil
+			}
+		}
+
+		canViewSchemas, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canViewSchemas {
+			deps.Subjects = make([]console.ConnectorDependencySubject, 0)
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, deps)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/api/httptypes"
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+const (
+	defaultConnectorDLQMaxResults = 100
+	maxConnectorDLQMaxResults     = 1000
+	maxConnectorDLQResendRecords  = 500
+)
+
+func (api *API) handleGetConnectorDLQ() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		maxResults := defaultConnectorDLQMaxResults
+		if maxResultsStr := r.URL.Query().Get("maxResults"); maxResultsStr != "" {
+			var err error
+			maxResults, err = strconv.Atoi(maxResultsStr)
+			if err != nil || maxResults < 1 || maxResults > maxConnectorDLQMaxResults {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("failed to parse max results as number"),
+					Status:   http.StatusBadRequest,
+					Message:  fmt.Sprintf("Invalid max results given. The max results must be a number between 1 and %d.", maxConnectorDLQMaxResults),
+					IsSilent: false,
+				})
+				return
+			}
+		}
+
+		if !api.checkCanViewConnectCluster(w, r, clusterName) {
+			return
+		}
+
+		// The dead letter queue topic is resolved first, so that no records are
+		// fetched unless the requester is allowed to consume the topic.
+		dlqTopic, restErr := api.ConsoleSvc.GetConnectorDLQTopic(r.Context(), clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !api.checkCanViewDLQMessages(w, r, dlqTopic.TopicName, maxResults) {
+			return
+		}
+
+		dlq, restErr := api.ConsoleSvc.GetConnectorDLQ(r.Context(), dlqTopic, maxResults)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, dlq)
+	}
+}
+
+type resendConnectorDLQRecordsRequest struct {
+	Records []console.ConnectorDLQRecordRef `json:"records"`
+}
+
+func (c *resendConnectorDLQRecordsRequest) OK() error {
+	if len(c.Records) == 0 {
+		return fmt.Errorf("at least one record must be specified")
+	}
+	if len(c.Records) > maxConnectorDLQResendRecords {
+		return fmt.Errorf("at most %d records can be re-sent at once", maxConnectorDLQResendRecords)
+	}
+	return nil
+}
+
+func (api *API) handleResendConnectorDLQRecords() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		var req resendConnectorDLQRecordsRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if !api.checkCanViewConnectCluster(w, r, clusterName) {
+			return
+		}
+
+		dlqTopic, restErr := api.ConsoleSvc.GetConnectorDLQTopic(r.Context(), clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !api.checkCanViewDLQMessages(w, r, dlqTopic.TopicName, len(req.Records)) {
+			return
+		}
+
+		dlq, restErr := api.ConsoleSvc.GetConnectorDLQRecords(r.Context(), dlqTopic, req.Records)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// Records are re-sent to their original topics, hence the requester must be
+		// allowed to publish to all of them.
+		checkedTopics := make(map[string]struct{})
+		for _, record := range dlq.Records {
+			topicName := record.Error.OriginalTopic
+			if _, checked := checkedTopics[topicName]; checked || topicName == "" {
+				continue
+			}
+			checkedTopics[topicName] = struct{}{}
+
+			canPublish, restErr := api.Hooks.Authorization.CanPublishTopicRecords(r.Context(), topicName)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if !canPublish {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("requester has no permissions to publish records in topic '%v'", topicName),
+					Status:   http.StatusForbidden,
+					Message:  fmt.Sprintf("You don't have permissions to publish records in topic '%v'", topicName),
+					IsSilent: false,
+				})
+				return
+			}
+		}
+
+		res := api.ConsoleSvc.ResendConnectorDLQRecords(r.Context(), dlq.Records)
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+// checkCanViewDLQMessages checks whether the requester is allowed to consume the
+// dead letter queue topic. An error response is sent if the requester is not
+// allowed to.
+func (api *API) checkCanViewDLQMessages(w http.ResponseWriter, r *http.Request, topicName string, maxResults int) bool {
+	canView, restErr := api.Hooks.Authorization.CanViewTopicMessages(r.Context(), &httptypes.ListMessagesRequest{
+		TopicName:   topicName,
+		StartOffset: -1,
+		PartitionID: -1,
+		MaxResults:  maxResults,
+	})
+	if restErr != nil {
+		rest.SendRESTError(w, r, api.Logger, restErr)
+		return false
+	}
+	if !canView {
+		rest.SendRESTError(w, r, api.Logger, &rest.Error{
+			Err:      fmt.Errorf("requester has no permissions to view messages in topic '%v'", topicName),
+			Status:   http.StatusForbidden,
+			Message:  fmt.Sprintf("You don't have permissions to view messages in topic '%v'", topicName),
+			IsSilent: false,
+		})
+		return false
+	}
+	return true
+}
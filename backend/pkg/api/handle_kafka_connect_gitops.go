@@ -0,0 +1,153 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/gitops"
+)
+
+// connectGitOpsTimeout is the maximum duration for computing or applying a plan.
+const connectGitOpsTimeout = 2 * time.Minute
+
+func (api *API) handleGetConnectGitOpsStatus() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !api.checkConnectGitOpsEnabled(w, r) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), connectGitOpsTimeout)
+		defer cancel()
+
+		status := api.ConnectGitOpsSvc.Status(ctx)
+		status.Drift = api.filterConnectGitOpsPlan(r.Context(), status.Drift)
+		status.LastReconciliation = api.filterConnectGitOpsPlan(r.Context(), status.LastReconciliation)
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, status)
+	}
+}
+
+func (api *API) handleGetConnectGitOpsPlan() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !api.checkConnectGitOpsEnabled(w, r) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), connectGitOpsTimeout)
+		defer cancel()
+
+		plan := api.ConnectGitOpsSvc.Plan(ctx)
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, api.filterConnectGitOpsPlan(r.Context(), plan))
+	}
+}
+
+func (api *API) handleReconcileConnectGitOps() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !api.checkConnectGitOpsEnabled(w, r) {
+			return
+		}
+
+		dryRun := false
+		if dryRunStr := r.URL.Query().Get("dryRun"); dryRunStr != "" {
+			var err error
+			dryRun, err = strconv.ParseBool(dryRunStr)
+			if err != nil {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("failed to parse dryRun query param: %w", err),
+					Status:   http.StatusBadRequest,
+					Message:  "Invalid dryRun query parameter, must be a boolean",
+					IsSilent: false,
+				})
+				return
+			}
+		}
+
+		// A reconciliation may create, update and delete connectors in all clusters.
+		for clusterName := range api.ConnectSvc.ClientsByCluster {
+			canEdit, restErr := api.Hooks.Authorization.CanEditConnectCluster(r.Context(), clusterName)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			canDelete, restErr := api.Hooks.Authorization.CanDeleteConnectCluster(r.Context(), clusterName)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if !canEdit || !canDelete {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:          fmt.Errorf("requester has no permissions to edit and delete in this connect cluster"),
+					Status:       http.StatusForbidden,
+					Message:      "You don't have permissions to reconcile connectors in all Kafka connect clusters",
+					InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+					IsSilent:     false,
+				})
+				return
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), connectGitOpsTimeout)
+		defer cancel()
+
+		plan := api.ConnectGitOpsSvc.Reconcile(ctx, dryRun)
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, plan)
+	}
+}
+
+func (api *API) checkConnectGitOpsEnabled(w http.ResponseWriter, r *http.Request) bool {
+	if api.ConnectGitOpsSvc != nil {
+		return true
+	}
+	rest.SendRESTError(w, r, api.Logger, &rest.Error{
+		Err:      errors.New("kafka connect gitops is not enabled"),
+		Status:   http.StatusBadRequest,
+		Message:  "GitOps for Kafka connect is not enabled",
+		IsSilent: false,
+	})
+	return false
+}
+
+// filterConnectGitOpsPlan returns a copy of the plan that only contains items of
+// connect clusters that the requester is allowed to view.
+func (api *API) filterConnectGitOpsPlan(ctx context.Context, plan *gitops.Plan) *gitops.Plan {
+	if plan == nil {
+		return nil
+	}
+
+	canViewByCluster := make(map[string]bool)
+	filtered := *plan
+	filtered.Items = make([]gitops.PlanItem, 0, len(plan.Items))
+	for _, item := range plan.Items {
+		canView, exists := canViewByCluster[item.ClusterName]
+		if !exists {
+			var restErr *rest.Error
+			canView, restErr = api.Hooks.Authorization.CanViewConnectCluster(ctx, item.ClusterName)
+			if restErr != nil {
+				api.Logger.Error("failed to check view connect cluster permissions", zap.Error(restErr.Err))
+				canView = false
+			}
+			canViewByCluster[item.ClusterName] = canView
+		}
+		if canView {
+			filtered.Items = append(filtered.Items, item)
+		}
+	}
+	return &filtered
+}
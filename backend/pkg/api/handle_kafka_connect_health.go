@@ -0,0 +1,65 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/health"
+)
+
+type getConnectRestartEventsResponse struct {
+	RestartEvents []health.RestartEvent `json:"restartEvents"`
+}
+
+func (api *API) handleGetConnectRestartEvents() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if api.ConnectHealthMonitor == nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("kafka connect health monitor is not enabled"),
+				Status:   http.StatusBadRequest,
+				Message:  "The health monitor for Kafka connect is not enabled",
+				IsSilent: false,
+			})
+			return
+		}
+
+		filter := health.RestartEventFilter{
+			ClusterName:   r.URL.Query().Get("clusterName"),
+			ConnectorName: r.URL.Query().Get("connector"),
+		}
+		events := api.ConnectHealthMonitor.RestartEvents(filter)
+
+		// Only return events of connect clusters that the requester is allowed to view.
+		canViewByCluster := make(map[string]bool)
+		filtered := make([]health.RestartEvent, 0, len(events))
+		for _, event := range events {
+			canView, exists := canViewByCluster[event.ClusterName]
+			if !exists {
+				var restErr *rest.Error
+				canView, restErr = api.Hooks.Authorization.CanViewConnectCluster(r.Context(), event.ClusterName)
+				if restErr != nil {
+					api.Logger.Error("failed to check view connect cluster permissions", zap.Error(restErr.Err))
+					canView = false
+				}
+				canViewByCluster[event.ClusterName] = canView
+			}
+			if canView {
+				filtered = append(filtered, event)
+			}
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, getConnectRestartEventsResponse{RestartEvents: filtered})
+	}
+}
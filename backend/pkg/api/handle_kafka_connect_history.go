@@ -0,0 +1,182 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+func (api *API) handleGetConnectorHistory() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		if !api.checkCanViewConnectCluster(w, r, clusterName) {
+			return
+		}
+
+		entries, restErr := api.ConnectSvc.ListConnectorHistory(r.Context(), clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, entries)
+	}
+}
+
+func (api *API) handleGetConnectorHistoryDiff() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		toVersion, err := strconv.Atoi(r.URL.Query().Get("to"))
+		if err != nil || toVersion < 1 {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("failed to parse to version as number"),
+				Status:   http.StatusBadRequest,
+				Message:  "Invalid to version given. The to version must be a positive number.",
+				IsSilent: false,
+			})
+			return
+		}
+		fromVersion := 0
+		if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+			fromVersion, err = strconv.Atoi(fromStr)
+			if err != nil || fromVersion < 1 {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("failed to parse from version as number"),
+					Status:   http.StatusBadRequest,
+					Message:  "Invalid from version given. The from version must be a positive number.",
+					IsSilent: false,
+				})
+				return
+			}
+		}
+
+		if !api.checkCanViewConnectCluster(w, r, clusterName) {
+			return
+		}
+
+		diff, restErr := api.ConnectSvc.DiffConnectorHistory(r.Context(), clusterName, connector, fromVersion, toVersion)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, diff)
+	}
+}
+
+type rollbackConnectorRequest struct {
+	// SecretValues provides values for config keys that have been redacted
+	// in the history. Keys that are not provided are taken from the current
+	// connector config.
+	SecretValues map[string]string `json:"secretValues"`
+}
+
+func (*rollbackConnectorRequest) OK() error {
+	return nil
+}
+
+func (api *API) handleRollbackConnector() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+		version, err := strconv.Atoi(rest.GetURLParam(r, "version"))
+		if err != nil || version < 1 {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("failed to parse version as number"),
+				Status:   http.StatusBadRequest,
+				Message:  "Invalid version given. Version must be a positive number.",
+				IsSilent: false,
+			})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(api.withConnectActor(r.Context()), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		canEdit, restErr := api.Hooks.Authorization.CanEditConnectCluster(r.Context(), clusterName)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canEdit {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:          fmt.Errorf("requester has no permissions to edit in this connect cluster"),
+				Status:       http.StatusForbidden,
+				Message:      "You don't have permissions to edit connectors in this Kafka connect cluster",
+				InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+				IsSilent:     false,
+			})
+			return
+		}
+
+		var req rollbackConnectorRequest
+		restErr = rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		cInfo, restErr := api.ConnectSvc.RollbackConnector(ctx, clusterName, connector, version, req.SecretValues)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// restart the instance and all the tasks, so that the restored config is applied
+		restErr = api.ConnectSvc.RestartConnector(ctx, clusterName, connector, true, false)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, cInfo)
+	}
+}
+
+// checkCanViewConnectCluster sends an error response and returns false if the
+// requester is not allowed to view the given connect cluster.
+func (api *API) checkCanViewConnectCluster(w http.ResponseWriter, r *http.Request, clusterName string) bool {
+	canSee, restErr := api.Hooks.Authorization.CanViewConnectCluster(r.Context(), clusterName)
+	if restErr != nil {
+		rest.SendRESTError(w, r, api.Logger, restErr)
+		return false
+	}
+	if !canSee {
+		rest.SendRESTError(w, r, api.Logger, &rest.Error{
+			Err:          fmt.Errorf("requester has no permissions to view this connect cluster"),
+			Status:       http.StatusForbidden,
+			Message:      "You don't have permissions to view connectors in this Kafka connect cluster",
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+			IsSilent:     false,
+		})
+		return false
+	}
+	return true
+}
+
+// withConnectActor returns a context that carries the authenticated requester,
+// so that connector config changes are recorded with their actor in the
+// connector config history.
+func (api *API) withConnectActor(ctx context.Context) context.Context {
+	return connect.ContextWithActor(ctx, connect.ActorFromLogFields(api.Hooks.Console.AdditionalLogFields(ctx)))
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+func (api *API) handleListMirrorReplications() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		replications, restErr := api.ConsoleSvc.ListMirrorReplications()
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// Replications are only listed if the requester is allowed to view the
+		// connect cluster that runs their connectors.
+		visible := make([]console.MirrorReplicationInfo, 0, len(replications))
+		for _, replication := range replications {
+			canView, restErr := api.Hooks.Authorization.CanViewConnectCluster(r.Context(), replication.ConnectClusterName)
+			if restErr != nil {
+				api.Logger.Error("failed to check view connect cluster permissions", zap.Error(restErr.Err))
+				continue
+			}
+			if canView {
+				visible = append(visible, replication)
+			}
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, visible)
+	}
+}
+
+func (api *API) handleGetMirrorReplication() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := rest.GetURLParam(r, "replicationName")
+		if !api.checkCanViewMirrorReplication(w, r, name) {
+			return
+		}
+
+		replication, restErr := api.ConsoleSvc.GetMirrorReplication(r.Context(), name)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// Partitions are only part of the response if the requester is allowed
+		// to see the replicated topic.
+		canSeeByTopic := make(map[string]bool)
+		partitions := make([]console.MirrorReplicationPartition, 0, len(replication.Partitions))
+		replication.TotalLag = 0
+		for _, partition := range replication.Partitions {
+			canSee, checked := canSeeByTopic[partition.TargetTopic]
+			if !checked {
+				canSee, restErr = api.Hooks.Authorization.CanSeeTopic(r.Context(), partition.TargetTopic)
+				if restErr != nil {
+					rest.SendRESTError(w, r, api.Logger, restErr)
+					return
+				}
+				canSeeByTopic[partition.TargetTopic] = canSee
+			}
+			if canSee {
+				partitions = append(partitions, partition)
+				replication.TotalLag += max(0, partition.Lag)
+			}
+		}
+		replication.Partitions = partitions
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, replication)
+	}
+}
+
+func (api *API) handleGetMirrorTranslatedOffsets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := rest.GetURLParam(r, "replicationName")
+		groupIDs := splitQueryValues(r.URL.Query()["groupId"])
+		if !api.checkCanViewMirrorReplication(w, r, name) {
+			return
+		}
+
+		translated, restErr := api.ConsoleSvc.GetMirrorTranslatedOffsets(r.Context(), name, groupIDs)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		groups := make([]console.MirrorTranslatedGroup, 0, len(translated.Groups))
+		for _, group := range translated.Groups {
+			canSee, restErr := api.Hooks.Authorization.CanSeeConsumerGroup(r.Context(), group.GroupID)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if canSee {
+				groups = append(groups, group)
+			}
+		}
+		translated.Groups = groups
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, translated)
+	}
+}
+
+func (api *API) handleApplyMirrorTranslatedOffsets() http.HandlerFunc {
+	type response struct {
+		*console.EditConsumerGroupOffsetsResponse
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := rest.GetURLParam(r, "replicationName")
+		groupID := rest.GetURLParam(r, "groupId")
+		if !api.checkCanViewMirrorReplication(w, r, name) {
+			return
+		}
+
+		canEdit, restErr := api.Hooks.Authorization.CanEditConsumerGroup(r.Context(), groupID)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canEdit {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:          fmt.Errorf("requester has no permissions to edit consumer group"),
+				Status:       http.StatusForbidden,
+				Message:      "You don't have permissions to edit this consumer group",
+				InternalLogs: []zapcore.Field{zap.String("group_id", groupID)},
+				IsSilent:     false,
+			})
+			return
+		}
+
+		res, restErr := api.ConsoleSvc.ApplyMirrorTranslatedOffsets(r.Context(), name, groupID)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{res})
+	}
+}
+
+// checkCanViewMirrorReplication checks whether the requester is allowed to view
+// the connect cluster of the replication. It sends the error response and
+// returns false otherwise.
+func (api *API) checkCanViewMirrorReplication(w http.ResponseWriter, r *http.Request, name string) bool {
+	replications, restErr := api.ConsoleSvc.ListMirrorReplications()
+	if restErr != nil {
+		rest.SendRESTError(w, r, api.Logger, restErr)
+		return false
+	}
+	for _, replication := range replications {
+		if replication.Name == name {
+			return api.checkCanViewConnectCluster(w, r, replication.ConnectClusterName)
+		}
+	}
+
+	rest.SendRESTError(w, r, api.Logger, &rest.Error{
+		Err:      fmt.Errorf("replication %q is not configured", name),
+		Status:   http.StatusNotFound,
+		Message:  fmt.Sprintf("MirrorMaker 2 replication %q is not configured", name),
+		IsSilent: false,
+	})
+	return false
+}
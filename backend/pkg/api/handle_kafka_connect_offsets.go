@@ -0,0 +1,154 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+func (api *API) handleGetConnectorOffsets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		if !api.checkCanViewConnectCluster(w, r, clusterName) {
+			return
+		}
+
+		offsets, restErr := api.ConnectSvc.GetConnectorOffsets(ctx, clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, offsets)
+	}
+}
+
+// alterConnectorOffsetsRequest either carries the offsets in the format of the
+// connector or, for sink connectors, consumer group offsets.
+type alterConnectorOffsetsRequest struct {
+	Offsets     []connect.ConnectorOffset     `json:"offsets"`
+	SinkOffsets []connect.SinkConnectorOffset `json:"sinkOffsets"`
+}
+
+func (c *alterConnectorOffsetsRequest) OK() error {
+	if len(c.Offsets) == 0 && len(c.SinkOffsets) == 0 {
+		return errors.New("either offsets or sinkOffsets must be set")
+	}
+	if len(c.Offsets) > 0 && len(c.SinkOffsets) > 0 {
+		return errors.New("offsets and sinkOffsets must not be set at the same time")
+	}
+	for _, o := range c.Offsets {
+		if len(o.Partition) == 0 {
+			return errors.New("each offset must specify a partition")
+		}
+	}
+	for _, o := range c.SinkOffsets {
+		if o.Topic == "" || o.Partition < 0 {
+			return errors.New("each sink offset must specify a topic and a partition")
+		}
+		if o.Offset != nil && *o.Offset < 0 {
+			return fmt.Errorf("offset of topic %q partition %d must not be negative", o.Topic, o.Partition)
+		}
+	}
+	return nil
+}
+
+type connectorOffsetsResponse struct {
+	Message string `json:"message"`
+}
+
+func (api *API) handleAlterConnectorOffsets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		var req alterConnectorOffsetsRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		if !api.checkCanEditConnectorOffsets(w, r, clusterName) {
+			return
+		}
+
+		var msg string
+		if len(req.SinkOffsets) > 0 {
+			msg, restErr = api.ConnectSvc.AlterSinkConnectorOffsets(ctx, clusterName, connector, req.SinkOffsets)
+		} else {
+			msg, restErr = api.ConnectSvc.AlterConnectorOffsets(ctx, clusterName, connector, req.Offsets)
+		}
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, connectorOffsetsResponse{Message: msg})
+	}
+}
+
+func (api *API) handleResetConnectorOffsets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		if !api.checkCanEditConnectorOffsets(w, r, clusterName) {
+			return
+		}
+
+		msg, restErr := api.ConnectSvc.ResetConnectorOffsets(ctx, clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, connectorOffsetsResponse{Message: msg})
+	}
+}
+
+func (api *API) checkCanEditConnectorOffsets(w http.ResponseWriter, r *http.Request, clusterName string) bool {
+	canEdit, restErr := api.Hooks.Authorization.CanEditConnectCluster(r.Context(), clusterName)
+	if restErr != nil {
+		rest.SendRESTError(w, r, api.Logger, restErr)
+		return false
+	}
+	if !canEdit {
+		rest.SendRESTError(w, r, api.Logger, &rest.Error{
+			Err:          fmt.Errorf("requester has no permissions to edit in this connect cluster"),
+			Status:       http.StatusForbidden,
+			Message:      "You don't have permissions to edit connector offsets in this Kafka connect cluster",
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+			IsSilent:     false,
+		})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+func (api *API) handleGetConnectPluginCatalog() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		// Clusters the requester is not allowed to view are omitted.
+		clusterInfos := api.ConnectSvc.GetAllClusterInfo(ctx)
+		visible := make([]connect.ClusterInfoWithError, 0, len(clusterInfos))
+		for _, info := range clusterInfos {
+			canSee, restErr := api.Hooks.Authorization.CanViewConnectCluster(r.Context(), info.Name)
+			if restErr != nil {
+				api.Logger.Error("failed to check view connect cluster permissions", zap.Error(restErr.Err))
+				continue
+			}
+			if canSee {
+				visible = append(visible, info)
+			}
+		}
+
+		// The config definitions of all installed plugins are snapshotted in the
+		// background, so that they can be compared after the plugins are upgraded.
+		go api.ConnectSvc.SnapshotInstalledPlugins(context.WithoutCancel(r.Context()), clusterInfos)
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, connect.NewPluginCatalog(visible))
+	}
+}
+
+func (api *API) handleGetConnectPluginConfigSnapshot() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ref := connect.PluginConfigRef{
+			ClusterName: rest.GetURLParam(r, "clusterName"),
+			Class:       rest.GetURLParam(r, "pluginClassName"),
+			Version:     r.URL.Query().Get("version"),
+		}
+		if !api.checkCanViewConnectCluster(w, r, ref.ClusterName) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		snapshot, restErr := api.ConnectSvc.GetPluginConfigSnapshot(ctx, ref)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, snapshot)
+	}
+}
+
+func (api *API) handleGetConnectPluginConfigDiff() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		base := connect.PluginConfigRef{
+			ClusterName: query.Get("baseCluster"),
+			Class:       query.Get("class"),
+			Version:     query.Get("baseVersion"),
+		}
+		target := connect.PluginConfigRef{
+			ClusterName: query.Get("targetCluster"),
+			Class:       query.Get("class"),
+			Version:     query.Get("targetVersion"),
+		}
+		if targetClass := query.Get("targetClass"); targetClass != "" {
+			target.Class = targetClass
+		}
+		if base.ClusterName == "" || target.ClusterName == "" || base.Class == "" {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("missing query parameters"),
+				Status:   http.StatusBadRequest,
+				Message:  "The query parameters class, baseCluster and targetCluster must be set.",
+				IsSilent: false,
+			})
+			return
+		}
+
+		if !api.checkCanViewConnectCluster(w, r, base.ClusterName) || !api.checkCanViewConnectCluster(w, r, target.ClusterName) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		diff, restErr := api.ConnectSvc.DiffPluginConfigSnapshots(ctx, base, target)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, diff)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+type promoteConnectorRequest struct {
+	TargetClusterName   string                         `json:"targetClusterName"`
+	TargetConnectorName string                         `json:"targetConnectorName"`
+	Replacements        []connect.PromotionReplacement `json:"replacements"`
+	Overrides           map[string]string              `json:"overrides"`
+	ExcludeKeys         []string                       `json:"excludeKeys"`
+	DryRun              bool                           `json:"dryRun"`
+}
+
+func (p *promoteConnectorRequest) OK() error {
+	if p.TargetClusterName == "" {
+		return fmt.Errorf("target cluster name must be set")
+	}
+	return nil
+}
+
+func (api *API) handlePromoteConnector() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		var req promoteConnectorRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if !api.checkCanViewConnectCluster(w, r, clusterName) {
+			return
+		}
+		// A dry run does not modify the target cluster, hence view permissions suffice.
+		if req.DryRun {
+			if !api.checkCanViewConnectCluster(w, r, req.TargetClusterName) {
+				return
+			}
+		} else {
+			canEdit, restErr := api.Hooks.Authorization.CanEditConnectCluster(r.Context(), req.TargetClusterName)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if !canEdit {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:          fmt.Errorf("requester has no permissions to edit in this connect cluster"),
+					Status:       http.StatusForbidden,
+					Message:      "You don't have permissions to edit connectors in the target Kafka connect cluster",
+					InternalLogs: []zapcore.Field{zap.String("cluster_name", req.TargetClusterName)},
+					IsSilent:     false,
+				})
+				return
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(api.withConnectActor(r.Context()), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		result, restErr := api.ConnectSvc.PromoteConnector(ctx, connect.PromoteConnectorRequest{
+			SourceClusterName:   clusterName,
+			SourceConnectorName: connector,
+			TargetClusterName:   req.TargetClusterName,
+			TargetConnectorName: req.TargetConnectorName,
+			Replacements:        req.Replacements,
+			Overrides:           req.Overrides,
+			ExcludeKeys:         req.ExcludeKeys,
+			DryRun:              req.DryRun,
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// The result includes the validation errors if the promoted config could
+		// not be applied, because it is invalid in the target cluster.
+		status := http.StatusOK
+		if !result.DryRun && !result.Applied {
+			status = http.StatusUnprocessableEntity
+		}
+		rest.SendResponse(w, r, api.Logger, status, result)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/status"
+)
+
+const maxConnectorStatusesPageSize = 1000
+
+// handleListConnectorStatuses returns a page of the cached connectors of all
+// Kafka connect clusters. Repeated or comma separated clusterName and state
+// parameters are combined with OR.
+func (api *API) handleListConnectorStatuses() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if api.ConnectStatusAggregator == nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("kafka connect status aggregator is not enabled"),
+				Status:   http.StatusBadRequest,
+				Message:  "The status aggregator for Kafka connect is not enabled",
+				IsSilent: false,
+			})
+			return
+		}
+
+		query := r.URL.Query()
+		req := status.ListRequest{
+			Filter: status.Filter{
+				ClusterNames: splitQueryValues(query["clusterName"]),
+				States:       splitQueryValues(query["state"]),
+				Class:        query.Get("class"),
+			},
+			PageToken: query.Get("pageToken"),
+		}
+		if nameRegex := query.Get("nameRegex"); nameRegex != "" {
+			compiled, err := regexp.Compile(nameRegex)
+			if err != nil {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("failed to compile name regex: %w", err),
+					Status:   http.StatusBadRequest,
+					Message:  fmt.Sprintf("Invalid name regex given: %v", err.Error()),
+					IsSilent: false,
+				})
+				return
+			}
+			req.Filter.NameRegex = compiled
+		}
+		if pageSizeStr := query.Get("pageSize"); pageSizeStr != "" {
+			pageSize, err := strconv.Atoi(pageSizeStr)
+			if err != nil || pageSize < 1 || pageSize > maxConnectorStatusesPageSize {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("failed to parse page size as number"),
+					Status:   http.StatusBadRequest,
+					Message:  fmt.Sprintf("Invalid page size given. The page size must be a number between 1 and %d.", maxConnectorStatusesPageSize),
+					IsSilent: false,
+				})
+				return
+			}
+			req.PageSize = pageSize
+		}
+
+		// Only list connectors of clusters that the requester is allowed to view.
+		allowedClusters := make([]string, 0)
+		for _, clusterName := range api.ConnectStatusAggregator.ClusterNames() {
+			if len(req.Filter.ClusterNames) > 0 && !slices.Contains(req.Filter.ClusterNames, clusterName) {
+				continue
+			}
+			canView, restErr := api.Hooks.Authorization.CanViewConnectCluster(r.Context(), clusterName)
+			if restErr != nil {
+				api.Logger.Error("failed to check view connect cluster permissions", zap.Error(restErr.Err))
+				continue
+			}
+			if canView {
+				allowedClusters = append(allowedClusters, clusterName)
+			}
+		}
+		if len(allowedClusters) == 0 {
+			rest.SendResponse(w, r, api.Logger, http.StatusOK, status.ListResponse{
+				Connectors: make([]status.Connector, 0),
+				Clusters:   make([]status.ClusterStatus, 0),
+			})
+			return
+		}
+		req.Filter.ClusterNames = allowedClusters
+
+		res, err := api.ConnectStatusAggregator.List(req)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if errors.Is(err, status.ErrInvalidPageToken) {
+				statusCode = http.StatusBadRequest
+			}
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   statusCode,
+				Message:  fmt.Sprintf("Failed to list connectors: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+// splitQueryValues splits comma separated query parameter values.
+func splitQueryValues(values []string) []string {
+	var split []string
+	for _, value := range values {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				split = append(split, v)
+			}
+		}
+	}
+	return split
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+)
+
+func (api *API) handleProtobufNotConfigured() http.HandlerFunc {
+	type response struct {
+		IsConfigured bool `json:"isConfigured"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, &response{IsConfigured: false})
+	}
+}
+
+func (api *API) handleGetProtobufDiagnostics() http.HandlerFunc {
+	if !api.Cfg.Kafka.Protobuf.Enabled {
+		return api.handleProtobufNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to view protobuf diagnostics"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to view protobuf diagnostics.",
+				IsSilent: false,
+			})
+			return
+		}
+
+		res, err := api.ConsoleSvc.GetProtobufDiagnostics(r.Context())
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Failed to get protobuf diagnostics: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+type decodeProtobufPayloadRequest struct {
+	// Payload is the base64 encoded raw record key or value.
+	Payload string `json:"payload"`
+
+	// MessageType is the fully qualified name of the message type. If empty, the
+	// payload must use the schema registry wire format.
+	MessageType string `json:"messageType"`
+}
+
+// OK validates the decode request.
+func (d *decodeProtobufPayloadRequest) OK() error {
+	if d.Payload == "" {
+		return fmt.Errorf("payload must be set")
+	}
+	return nil
+}
+
+func (api *API) handleDecodeProtobufPayload() http.HandlerFunc {
+	if !api.Cfg.Kafka.Protobuf.Enabled {
+		return api.handleProtobufNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to decode protobuf payloads"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to decode protobuf payloads.",
+				IsSilent: false,
+			})
+			return
+		}
+
+		var req decodeProtobufPayloadRequest
+		restErr = rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		payload, err := base64.StdEncoding.DecodeString(req.Payload)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("failed to decode base64 payload: %w", err),
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Payload is not valid base64: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		res, err := api.ConsoleSvc.DecodeProtobufPayload(r.Context(), payload, req.MessageType)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Failed to decode protobuf payload: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
@@ -71,6 +71,295 @@ func (api *API) handleGetSchemaRegistryMode() http.HandlerFunc {
 	}
 }
 
+func (api *API) handlePutSchemaRegistryMode() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	type request struct {
+		Mode schema.Mode `json:"mode"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canManage, restErr := api.Hooks.Authorization.CanManageSchemaRegistry(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canManage {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to change the schema registry mode"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to change the schema registry mode.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		req := request{}
+		restErr = rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		force, restErr := getForceFromQuery(r)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		res, err := api.ConsoleSvc.PutSchemaRegistryMode(r.Context(), req.Mode, force)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, newSchemaModeRESTError(err, "Failed to set global mode"))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleGetSchemaRegistrySubjectMode() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to get the subject mode"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to get the subject mode.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		subjectName := getSubjectFromRequestPath(r)
+
+		res, err := api.ConsoleSvc.GetSchemaRegistrySubjectMode(r.Context(), subjectName)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, newSchemaModeRESTError(err, "Failed to retrieve subject's mode"))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handlePutSchemaRegistrySubjectMode() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	type request struct {
+		Mode schema.Mode `json:"mode"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canManage, restErr := api.Hooks.Authorization.CanManageSchemaRegistry(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canManage {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to change the subject mode"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to change the subject mode.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 1. Parse request parameters
+		subjectName := getSubjectFromRequestPath(r)
+
+		req := request{}
+		restErr = rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		force, restErr := getForceFromQuery(r)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 2. Set subject mode
+		res, err := api.ConsoleSvc.PutSchemaRegistrySubjectMode(r.Context(), subjectName, req.Mode, force)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, newSchemaModeRESTError(err, "Failed to set subject's mode"))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleDeleteSchemaRegistrySubjectMode() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canManage, restErr := api.Hooks.Authorization.CanManageSchemaRegistry(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canManage {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to change the subject mode"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to change the subject mode.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		subjectName := getSubjectFromRequestPath(r)
+
+		res, err := api.ConsoleSvc.DeleteSchemaRegistrySubjectMode(r.Context(), subjectName)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, newSchemaModeRESTError(err, "Failed to delete subject's mode"))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+// getForceFromQuery parses the optional "force" query parameter, which allows to
+// switch into IMPORT mode even if schemas are registered already.
+func getForceFromQuery(r *http.Request) (bool, *rest.Error) {
+	forceStr := r.URL.Query().Get("force")
+	if forceStr == "" {
+		return false, nil
+	}
+	force, err := strconv.ParseBool(forceStr)
+	if err != nil {
+		return false, &rest.Error{
+			Err:      fmt.Errorf("failed to parse force query param: %w", err),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Failed to parse force query param %q, must be a boolean", forceStr),
+			IsSilent: false,
+		}
+	}
+	return force, nil
+}
+
+// newSchemaModeRESTError maps errors returned by the schema registry's mode endpoints
+// to REST errors. The schema registry rejects invalid mode transitions (e.g. switching
+// into IMPORT mode while schemas exist) with a 422, which is passed on as bad request.
+func newSchemaModeRESTError(err error, message string) *rest.Error {
+	var schemaError *schema.RestError
+	if errors.As(err, &schemaError) {
+		switch {
+		case schemaError.ErrorCode == schema.CodeSubjectNotFound:
+			return &rest.Error{
+				Err:      err,
+				Status:   http.StatusNotFound,
+				Message:  "Requested subject does not exist",
+				IsSilent: false,
+			}
+		case schemaError.ErrorCode/100 == http.StatusUnprocessableEntity:
+			return &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("%s: %v", message, schemaError.Message),
+				IsSilent: false,
+			}
+		}
+	}
+
+	return &rest.Error{
+		Err:      err,
+		Status:   http.StatusBadGateway,
+		Message:  fmt.Sprintf("%s: %v", message, err.Error()),
+		IsSilent: false,
+	}
+}
+
+func (api *API) handleGetSchemaRegistryContexts() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to get schema registry contexts"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to get the schema registry contexts.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		res, err := api.ConsoleSvc.GetSchemaRegistryContexts(r.Context())
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadGateway,
+				Message:  fmt.Sprintf("Failed to retrieve contexts from the schema registry: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleGetSchemaRegistryContextSubjects() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to get schema subjects"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to get the schema subjects.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		schemaContext := rest.GetURLParam(r, "context")
+
+		res, err := api.ConsoleSvc.GetSchemaRegistryContextSubjects(r.Context(), schemaContext)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadGateway,
+				Message:  fmt.Sprintf("Failed to retrieve subjects of context %q from the schema registry: %v", schemaContext, err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
 func (api *API) handleGetSchemaRegistrySchemaTypes() http.HandlerFunc {
 	if !api.Cfg.Kafka.Schema.Enabled {
 		return api.handleSchemaRegistryNotConfigured()
@@ -846,6 +1135,7 @@ func getSubjectFromRequestPath(r *http.Request) string {
 	requestURI = strings.Replace(requestURI, r.Host, "", 1)
 	requestURI = strings.Replace(requestURI, "/api/schema-registry/subjects/", "", 1)
 	requestURI = strings.Replace(requestURI, "/api/schema-registry/config/", "", 1)
+	requestURI = strings.Replace(requestURI, "/api/schema-registry/mode/", "", 1)
 	if r.URL.RawQuery != "" {
 		requestURI = strings.TrimSuffix(requestURI, "?"+r.URL.RawQuery)
 	}
@@ -866,3 +1156,268 @@ func getSubjectFromRequestPath(r *http.Request) string {
 
 	return subject
 }
+
+func (api *API) handleDiffSchema() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to diff schemas"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to diff schemas.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 1. Parse request parameters
+		subjectName := getSubjectFromRequestPath(r)
+
+		version := rest.GetURLParam(r, "version")
+		if restErr := validateSchemaVersionParam(version); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		compatLevel, restErr := getCompatibilityFromQuery(r)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		var payload schema.Schema
+		restErr = rest.Decode(w, r, &payload)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if payload.Schema == "" {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:          fmt.Errorf("payload validation failed for diffing schema"),
+				Status:       http.StatusBadRequest,
+				Message:      "You must set the schema field when diffing the schema",
+				InternalLogs: []zapcore.Field{zap.String("subject_name", subjectName)},
+				IsSilent:     false,
+			})
+			return
+		}
+
+		// 2. Compare candidate with existing versions
+		res, err := api.ConsoleSvc.DiffSchemaRegistrySchema(r.Context(), subjectName, version, payload, compatLevel)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, newSchemaDiffRESTError(err, subjectName, version))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleDiffSubjectVersions() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to diff schemas"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to diff schemas.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 1. Parse request parameters
+		subjectName := getSubjectFromRequestPath(r)
+
+		version := rest.GetURLParam(r, "version")
+		if restErr := validateSchemaVersionParam(version); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// The base version is optional, if not set the previous version will be used
+		baseVersion := rest.GetQueryParam(r, "baseVersion")
+		if baseVersion != "" {
+			if restErr := validateSchemaVersionParam(baseVersion); restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+		}
+
+		compatLevel, restErr := getCompatibilityFromQuery(r)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 2. Compare both versions
+		res, err := api.ConsoleSvc.DiffSchemaRegistrySubjectVersions(r.Context(), subjectName, baseVersion, version, compatLevel)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, newSchemaDiffRESTError(err, subjectName, version))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+// validateSchemaVersionParam checks whether the given version is either "latest" or a number.
+func validateSchemaVersionParam(version string) *rest.Error {
+	if version == console.SchemaVersionsLatest {
+		return nil
+	}
+	if _, err := strconv.Atoi(version); err != nil {
+		descriptiveErr := fmt.Errorf("version %q is not valid. Must be %q or a positive integer", version, console.SchemaVersionsLatest)
+		return &rest.Error{
+			Err:      descriptiveErr,
+			Status:   http.StatusBadRequest,
+			Message:  descriptiveErr.Error(),
+			IsSilent: false,
+		}
+	}
+	return nil
+}
+
+// getCompatibilityFromQuery parses the optional "compatibility" query parameter that can be used
+// to evaluate schema changes against a different compatibility level than the configured one.
+func getCompatibilityFromQuery(r *http.Request) (schema.CompatibilityLevel, *rest.Error) {
+	var compatLevel schema.CompatibilityLevel
+	compatStr := rest.GetQueryParam(r, "compatibility")
+	if compatStr == "" {
+		return compatLevel, nil
+	}
+	if err := compatLevel.UnmarshalText([]byte(compatStr)); err != nil {
+		return compatLevel, &rest.Error{
+			Err:      err,
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Failed to parse 'compatibility' query param: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	return compatLevel, nil
+}
+
+func newSchemaDiffRESTError(err error, subjectName, version string) *rest.Error {
+	var schemaError *schema.RestError
+	if errors.As(err, &schemaError) && schemaError.ErrorCode == schema.CodeSubjectNotFound {
+		return &rest.Error{
+			Err:      err,
+			Status:   http.StatusNotFound,
+			Message:  "Requested subject does not exist",
+			IsSilent: false,
+		}
+	}
+	if errors.As(err, &schemaError) && schemaError.ErrorCode == schema.CodeVersionNotFound {
+		return &rest.Error{
+			Err:      err,
+			Status:   http.StatusNotFound,
+			Message:  "Requested version does not exist on the given subject",
+			IsSilent: false,
+		}
+	}
+
+	return &rest.Error{
+		Err:     err,
+		Status:  http.StatusBadGateway,
+		Message: fmt.Sprintf("Failed to diff schemas: %v", err.Error()),
+		InternalLogs: []zapcore.Field{
+			zap.String("subject_name", subjectName),
+			zap.String("version", version),
+		},
+		IsSilent: false,
+	}
+}
+
+func (api *API) handleGetSchemaUsageReport() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to get the schema usage report"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to get the schema usage report.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		forceRefresh := false
+		if refreshStr := r.URL.Query().Get("refresh"); refreshStr != "" {
+			var err error
+			forceRefresh, err = strconv.ParseBool(refreshStr)
+			if err != nil {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("failed to parse refresh query param: %w", err),
+					Status:   http.StatusBadRequest,
+					Message:  fmt.Sprintf("Failed to parse refresh query param %q, must be a boolean", refreshStr),
+					IsSilent: false,
+				})
+				return
+			}
+		}
+
+		report, err := api.ConsoleSvc.GetSchemaUsageReport(r.Context(), forceRefresh)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadGateway,
+				Message:  fmt.Sprintf("Failed to create schema usage report: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		// The report is shared across all requesters, hence we have to remove all topics
+		// the requester is not allowed to see from a copy.
+		res := *report
+		res.Topics = make([]console.SchemaUsageTopic, 0, len(report.Topics))
+		for _, topic := range report.Topics {
+			canSee, restErr := api.Hooks.Authorization.CanSeeTopic(r.Context(), topic.TopicName)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if canSee {
+				res.Topics = append(res.Topics, topic)
+			}
+		}
+		res.Errors = make([]console.SchemaUsageTopicError, 0, len(report.Errors))
+		for _, topicErr := range report.Errors {
+			canSee, restErr := api.Hooks.Authorization.CanSeeTopic(r.Context(), topicErr.TopicName)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if canSee {
+				res.Errors = append(res.Errors, topicErr)
+			}
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, &res)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/schema"
+)
+
+// maxSchemaRegistryBackupSize is the maximum size of a backup that can be uploaded
+// for a restore. The generic 1MB request limit is too small for larger registries.
+const maxSchemaRegistryBackupSize = 64 * 1024 * 1024
+
+func (api *API) handleExportSchemaRegistryBackup() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canView, restErr := api.Hooks.Authorization.CanViewSchemas(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canView {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to export the schema registry"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to export the schema registry.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		res, err := api.ConsoleSvc.ExportSchemaRegistryBackup(r.Context())
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadGateway,
+				Message:  fmt.Sprintf("Failed to export schema registry: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		filename := fmt.Sprintf("schema-registry-backup-%s.json", res.CreatedAt.Format("20060102T150405Z"))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleRestoreSchemaRegistryBackup() http.HandlerFunc {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return api.handleSchemaRegistryNotConfigured()
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		canManage, restErr := api.Hooks.Authorization.CanManageSchemaRegistry(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canManage {
+			restErr := &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to restore the schema registry"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to restore the schema registry.",
+				IsSilent: false,
+			}
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 1. Parse request parameters
+		opts := schema.RestoreOptions{}
+		if dryRunStr := r.URL.Query().Get("dryRun"); dryRunStr != "" {
+			dryRun, err := strconv.ParseBool(dryRunStr)
+			if err != nil {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("failed to parse dryRun query param: %w", err),
+					Status:   http.StatusBadRequest,
+					Message:  fmt.Sprintf("Failed to parse dryRun query param %q, must be a boolean", dryRunStr),
+					IsSilent: false,
+				})
+				return
+			}
+			opts.DryRun = dryRun
+		}
+		opts.Force, restErr = getForceFromQuery(r)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		var backup schema.Backup
+		r.Body = http.MaxBytesReader(w, r.Body, maxSchemaRegistryBackupSize)
+		if err := json.NewDecoder(r.Body).Decode(&backup); err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Failed to decode schema registry backup: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+		if backup.FormatVersion != schema.BackupFormatVersion {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("unsupported backup format version %d", backup.FormatVersion),
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Unsupported backup format version %d, expected %d", backup.FormatVersion, schema.BackupFormatVersion),
+				IsSilent: false,
+			})
+			return
+		}
+
+		// 2. Restore backup
+		res, err := api.ConsoleSvc.RestoreSchemaRegistryBackup(r.Context(), &backup, opts)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadGateway,
+				Message:  fmt.Sprintf("Failed to restore schema registry backup: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		status := http.StatusOK
+		if len(res.Conflicts) > 0 && !res.DryRun {
+			status = http.StatusConflict
+		}
+		rest.SendResponse(w, r, api.Logger, status, res)
+	}
+}
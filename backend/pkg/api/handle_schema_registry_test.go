@@ -83,6 +83,16 @@ func Test_getSubjectFromRequestPath(t *testing.T) {
 			target:   "http://example.com/api/schema-registry/config/with%252Fslash/suffix",
 			expected: "with%2Fslash",
 		},
+		{
+			name:     "with mode",
+			target:   "http://example.com/api/schema-registry/mode/orders-value",
+			expected: "orders-value",
+		},
+		{
+			name:     "with mode context-qualified subject",
+			target:   "http://example.com/api/schema-registry/mode/%3A.staging%3Aorders-value",
+			expected: ":.staging:orders-value",
+		},
 		{
 			name:     "with port",
 			target:   "http://example.com:8080/api/schema-registry/subjects/with%252Fslash/versions/last",
@@ -10,6 +10,8 @@
 package api
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/cloudhut/common/rest"
@@ -18,7 +20,7 @@ import (
 	"github.com/redpanda-data/console/backend/pkg/console"
 )
 
-// handleGetTopicDocumentation returns the respective topic documentation from the git repository
+// handleGetTopicDocumentation returns the respective topic documentation from the configured sources
 func (api *API) handleGetTopicDocumentation() http.HandlerFunc {
 	type response struct {
 		TopicName     string                      `json:"topicName"`
@@ -29,7 +31,7 @@ func (api *API) handleGetTopicDocumentation() http.HandlerFunc {
 		topicName := rest.GetURLParam(r, "topicName")
 		logger := api.Logger.With(zap.String("topic_name", topicName))
 
-		doc := api.ConsoleSvc.GetTopicDocumentation(topicName)
+		doc := api.ConsoleSvc.GetTopicDocumentation(r.Context(), topicName)
 
 		rest.SendResponse(w, r, logger, http.StatusOK, &response{
 			TopicName:     topicName,
@@ -37,3 +39,72 @@ func (api *API) handleGetTopicDocumentation() http.HandlerFunc {
 		})
 	}
 }
+
+type updateTopicDocumentationRequest struct {
+	Markdown string `json:"markdown"`
+}
+
+func (*updateTopicDocumentationRequest) OK() error {
+	return nil
+}
+
+// handleUpdateTopicDocumentation commits the edited topic documentation to the git repository
+func (api *API) handleUpdateTopicDocumentation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		topicName := rest.GetURLParam(r, "topicName")
+		logger := api.Logger.With(zap.String("topic_name", topicName))
+
+		var req updateTopicDocumentationRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, logger, restErr)
+			return
+		}
+
+		canEdit, restErr := api.Hooks.Authorization.CanEditTopicDocumentation(r.Context(), topicName)
+		if restErr != nil {
+			rest.SendRESTError(w, r, logger, restErr)
+			return
+		}
+		if !canEdit {
+			rest.SendRESTError(w, r, logger, &rest.Error{
+				Err:      fmt.Errorf("requester has no permissions to edit this topic's documentation"),
+				Status:   http.StatusForbidden,
+				Message:  "You don't have permissions to edit this topic's documentation",
+				IsSilent: false,
+			})
+			return
+		}
+
+		res, err := api.ConsoleSvc.UpdateTopicDocumentation(r.Context(), topicName, []byte(req.Markdown))
+		if err != nil {
+			if errors.Is(err, console.ErrTopicDocumentationEditingDisabled) {
+				rest.SendRESTError(w, r, logger, &rest.Error{
+					Err:      err,
+					Status:   http.StatusBadRequest,
+					Message:  "Editing topic documentation is not enabled",
+					IsSilent: false,
+				})
+				return
+			}
+			if errors.Is(err, console.ErrInvalidTopicName) {
+				rest.SendRESTError(w, r, logger, &rest.Error{
+					Err:      err,
+					Status:   http.StatusBadRequest,
+					Message:  err.Error(),
+					IsSilent: false,
+				})
+				return
+			}
+			rest.SendRESTError(w, r, logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusServiceUnavailable,
+				Message:  fmt.Sprintf("Failed to update topic documentation: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, logger, http.StatusOK, res)
+	}
+}
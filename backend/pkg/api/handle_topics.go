@@ -12,6 +12,7 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -21,6 +22,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/redpanda-data/console/backend/pkg/console"
+	"github.com/redpanda-data/console/backend/pkg/topicdocs"
 )
 
 func (api *API) handleGetTopics() http.HandlerFunc {
@@ -29,6 +31,8 @@ func (api *API) handleGetTopics() http.HandlerFunc {
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
+		docFilter := topicDocumentationFilterFromQuery(r.URL.Query())
+
 		topics, err := api.ConsoleSvc.GetTopicsOverview(r.Context())
 		if err != nil {
 			restErr := &rest.Error{
@@ -50,7 +54,7 @@ func (api *API) handleGetTopics() http.HandlerFunc {
 				return
 			}
 
-			if canSee {
+			if canSee && docFilter.matches(topic.DocumentationMetadata) {
 				visibleTopics = append(visibleTopics, topic)
 			}
 
@@ -592,3 +596,35 @@ func (api *API) handleGetTopicsOffsets() http.HandlerFunc {
 		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
 	}
 }
+
+// topicDocumentationFilter filters topics by the metadata declared in the front-matter
+// of their documentation. Empty fields match all topics.
+type topicDocumentationFilter struct {
+	owner             string
+	team              string
+	piiClassification string
+}
+
+func topicDocumentationFilterFromQuery(query url.Values) topicDocumentationFilter {
+	return topicDocumentationFilter{
+		owner:             query.Get("owner"),
+		team:              query.Get("team"),
+		piiClassification: query.Get("piiClassification"),
+	}
+}
+
+func (f topicDocumentationFilter) matches(metadata *topicdocs.Metadata) bool {
+	if f == (topicDocumentationFilter{}) {
+		return true
+	}
+	if metadata == nil {
+		return false
+	}
+	return matchesFilterValue(f.owner, metadata.Owner) &&
+		matchesFilterValue(f.team, metadata.Team) &&
+		matchesFilterValue(f.piiClassification, metadata.PIIClassification)
+}
+
+func matchesFilterValue(filter, value string) bool {
+	return filter == "" || strings.EqualFold(filter, value)
+}
@@ -110,6 +110,7 @@ type AuthorizationHooks interface {
 	CanViewTopicMessages(ctx context.Context, req *httptypes.ListMessagesRequest) (bool, *rest.Error)
 	CanUseMessageSearchFilters(ctx context.Context, req *httptypes.ListMessagesRequest) (bool, *rest.Error)
 	CanViewTopicConsumers(ctx context.Context, topicName string) (bool, *rest.Error)
+	CanEditTopicDocumentation(ctx context.Context, topicName string) (bool, *rest.Error)
 	AllowedTopicActions(ctx context.Context, topicName string) ([]string, *rest.Error)
 	PrintListMessagesAuditLog(ctx context.Context, r any, req *console.ListMessageRequest)
 
@@ -267,6 +268,10 @@ func (*defaultHooks) CanViewTopicConsumers(_ context.Context, _ string) (bool, *
 	return true, nil
 }
 
+func (*defaultHooks) CanEditTopicDocumentation(_ context.Context, _ string) (bool, *rest.Error) {
+	return true, nil
+}
+
 func (*defaultHooks) AllowedTopicActions(_ context.Context, _ string) ([]string, *rest.Error) {
 	// "all" will be considered as wild card - all actions are allowed
 	return []string{"all"}, nil
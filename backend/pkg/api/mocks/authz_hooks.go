@@ -328,6 +328,21 @@ func (mr *MockAuthorizationHooksMockRecorder) CanEditTopicConfig(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanEditTopicConfig", reflect.TypeOf((*MockAuthorizationHooks)(nil).CanEditTopicConfig), arg0, arg1)
 }
 
+// CanEditTopicDocumentation mocks base method.
+func (m *MockAuthorizationHooks) CanEditTopicDocumentation(arg0 context.Context, arg1 string) (bool, *rest.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanEditTopicDocumentation", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*rest.Error)
+	return ret0, ret1
+}
+
+// CanEditTopicDocumentation indicates an expected call of CanEditTopicDocumentation.
+func (mr *MockAuthorizationHooksMockRecorder) CanEditTopicDocumentation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanEditTopicDocumentation", reflect.TypeOf((*MockAuthorizationHooks)(nil).CanEditTopicDocumentation), arg0, arg1)
+}
+
 // CanListACLs mocks base method.
 func (m *MockAuthorizationHooks) CanListACLs(arg0 context.Context) (bool, *rest.Error) {
 	m.ctrl.T.Helper()
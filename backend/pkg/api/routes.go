@@ -36,6 +36,7 @@ import (
 	consolesvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/console"
 	apikafkaconnectsvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/kafkaconnect/v1alpha1"
 	"github.com/redpanda-data/console/backend/pkg/api/connect/service/rpconnect"
+	secretsvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/secret/v1alpha2"
 	topicsvcv1alpha1 "github.com/redpanda-data/console/backend/pkg/api/connect/service/topic/v1alpha1"
 	topicsvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/topic/v1alpha2"
 	transformsvcv1alpha1 "github.com/redpanda-data/console/backend/pkg/api/connect/service/transform/v1alpha1"
@@ -105,6 +106,7 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	topicSvc := topicsvc.NewService(api.Cfg, api.Logger.Named("topic_service"), api.ConsoleSvc)
 	userSvc := apiusersvc.NewService(api.Cfg, api.Logger.Named("user_service"), api.RedpandaSvc, api.ConsoleSvc, api.Hooks.Authorization.IsProtectedKafkaUser)
 	transformSvc := transformsvc.NewService(api.Cfg, api.Logger.Named("transform_service"), api.RedpandaSvc, v)
+	secretSvc := secretsvc.NewService(api.Logger.Named("secret_service"), api.ConnectSvc, api.Hooks.Authorization)
 	consoleTransformSvc := &transformsvc.ConsoleService{Impl: transformSvc}
 
 	// v1alpha1
@@ -112,7 +114,7 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	// Create OSS Connect handlers only after calling hook. We need the hook output's final list of interceptors.
 	userSvcV1alpha1 := apiusersvcv1alpha1.NewService(userSvc)
 	aclSvcV1alpha1 := apiaclsvcv1alpha1.NewService(aclSvc)
-	kafkaConnectSvc := apikafkaconnectsvc.NewService(api.Cfg, api.Logger.Named("kafka_connect_service"), api.ConnectSvc)
+	kafkaConnectSvc := apikafkaconnectsvc.NewService(api.Cfg, api.Logger.Named("kafka_connect_service"), api.ConnectSvc, api.ConnectGitOpsSvc, api.Hooks.Authorization, api.Hooks.Console.AdditionalLogFields)
 	topicSvcV1alpha1 := topicsvcv1alpha1.NewService(topicSvc)
 	transformSvcV1alpha1 := transformsvcv1alpha1.NewService(transformSvc)
 	consoleSvc := consolesvc.NewService(api.Logger.Named("console_service"), api.ConsoleSvc, api.Hooks.Authorization)
@@ -140,6 +142,7 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 			dataplanev1alpha2connect.TopicServiceName:         topicSvc,
 			dataplanev1alpha2connect.UserServiceName:          userSvc,
 			dataplanev1alpha2connect.TransformServiceName:     transformSvc,
+			dataplanev1alpha2connect.SecretServiceName:        secretSvc,
 		},
 	})
 
@@ -199,6 +202,9 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	transformSvcPath, transformSvcHandler := dataplanev1alpha2connect.NewTransformServiceHandler(
 		hookOutput.Services[dataplanev1alpha2connect.TransformServiceName].(dataplanev1alpha2connect.TransformServiceHandler),
 		connect.WithInterceptors(hookOutput.Interceptors...))
+	secretSvcPath, secretSvcHandler := dataplanev1alpha2connect.NewSecretServiceHandler(
+		hookOutput.Services[dataplanev1alpha2connect.SecretServiceName].(dataplanev1alpha2connect.SecretServiceHandler),
+		connect.WithInterceptors(hookOutput.Interceptors...))
 
 	ossServices := []ConnectService{
 		{
@@ -266,6 +272,11 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 			MountPath:   transformSvcPath,
 			Handler:     transformSvcHandler,
 		},
+		{
+			ServiceName: dataplanev1alpha2connect.SecretServiceName,
+			MountPath:   secretSvcPath,
+			Handler:     secretSvcHandler,
+		},
 	}
 
 	// Order matters. OSS services first, so Enterprise handlers override OSS.
@@ -293,6 +304,7 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	dataplanev1alpha2connect.RegisterTopicServiceHandlerGatewayServer(gwMux, topicSvc, connectgateway.WithInterceptors(hookOutput.Interceptors...))
 	dataplanev1alpha2connect.RegisterUserServiceHandlerGatewayServer(gwMux, userSvc, connectgateway.WithInterceptors(hookOutput.Interceptors...))
 	dataplanev1alpha2connect.RegisterTransformServiceHandlerGatewayServer(gwMux, transformSvc, connectgateway.WithInterceptors(hookOutput.Interceptors...))
+	dataplanev1alpha2connect.RegisterSecretServiceHandlerGatewayServer(gwMux, secretSvc, connectgateway.WithInterceptors(hookOutput.Interceptors...))
 
 	reflector := grpcreflect.NewStaticReflector(reflectServiceNames...)
 	r.Mount(grpcreflect.NewHandlerV1(reflector))
@@ -379,6 +391,11 @@ func (api *API) routes() *chi.Mux {
 			})
 		}
 
+		// Webhook routes - these are authenticated using a shared secret rather than user sessions
+		router.Group(func(r chi.Router) {
+			r.Post("/webhooks/git", api.handleGitWebhook())
+		})
+
 		// API routes
 		router.Group(func(r chi.Router) {
 			r.Use(createSetVersionInfoHeader(version.BuiltAt))
@@ -415,6 +432,7 @@ func (api *API) routes() *chi.Mux {
 				r.Patch("/topics/{topicName}/configuration", api.handleEditTopicConfig())
 				r.Get("/topics/{topicName}/consumers", api.handleGetTopicConsumers())
 				r.Get("/topics/{topicName}/documentation", api.handleGetTopicDocumentation())
+				r.Put("/topics/{topicName}/documentation", api.handleUpdateTopicDocumentation())
 
 				// Quotas
 				r.Get("/quotas", api.handleGetQuotas())
@@ -432,8 +450,21 @@ func (api *API) routes() *chi.Mux {
 				r.Patch("/operations/reassign-partitions", api.handlePatchPartitionAssignments())
 				r.Patch("/operations/configs", api.handlePatchConfigs())
 
+				// Protobuf
+				r.Get("/protobuf/diagnostics", api.handleGetProtobufDiagnostics())
+				r.Post("/protobuf/decode", api.handleDecodeProtobufPayload())
+
 				// Schema Registry
 				r.Get("/schema-registry/mode", api.handleGetSchemaRegistryMode())
+				r.Put("/schema-registry/mode", api.handlePutSchemaRegistryMode())
+				r.Get("/schema-registry/mode/{subject}", api.handleGetSchemaRegistrySubjectMode())
+				r.Put("/schema-registry/mode/{subject}", api.handlePutSchemaRegistrySubjectMode())
+				r.Delete("/schema-registry/mode/{subject}", api.handleDeleteSchemaRegistrySubjectMode())
+				r.Get("/schema-registry/contexts", api.handleGetSchemaRegistryContexts())
+				r.Get("/schema-registry/contexts/{context}/subjects", api.handleGetSchemaRegistryContextSubjects())
+				r.Get("/schema-registry/backup", api.handleExportSchemaRegistryBackup())
+				r.Get("/schema-registry/usage", api.handleGetSchemaUsageReport())
+				r.Post("/schema-registry/backup/restore", api.handleRestoreSchemaRegistryBackup())
 				r.Get("/schema-registry/config", api.handleGetSchemaRegistryConfig())
 				r.Put("/schema-registry/config", api.handlePutSchemaRegistryConfig())
 				r.Put("/schema-registry/config/{subject}", api.handlePutSchemaRegistrySubjectConfig())
@@ -444,23 +475,47 @@ func (api *API) routes() *chi.Mux {
 				r.Delete("/schema-registry/subjects/{subject}", api.handleDeleteSubject())
 				r.Post("/schema-registry/subjects/{subject}/versions", api.handleCreateSchema())
 				r.Post("/schema-registry/subjects/{subject}/versions/{version}/validate", api.handleValidateSchema())
+				r.Post("/schema-registry/subjects/{subject}/versions/{version}/diff", api.handleDiffSchema())
+				r.Get("/schema-registry/subjects/{subject}/versions/{version}/diff", api.handleDiffSubjectVersions())
 				r.Delete("/schema-registry/subjects/{subject}/versions/{version}", api.handleDeleteSubjectVersion())
 				r.Get("/schema-registry/subjects/{subject}/versions/{version}", api.handleGetSchemaSubjectDetails())
 				r.Get("/schema-registry/subjects/{subject}/versions/{version}/referencedby", api.handleGetSchemaReferencedBy())
 
 				// Kafka Connect
 				r.Get("/kafka-connect/connectors", api.handleGetConnectors())
+				r.Get("/kafka-connect/connector-statuses", api.handleListConnectorStatuses())
 				r.Get("/kafka-connect/clusters/{clusterName}", api.handleGetClusterInfo())
 				r.Get("/kafka-connect/clusters/{clusterName}/connectors", api.handleGetClusterConnectors())
 				r.Post("/kafka-connect/clusters/{clusterName}/connectors", api.handleCreateConnector())
 				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}", api.handleGetConnector())
 				r.Put("/kafka-connect/clusters/{clusterName}/connectors/{connector}", api.handlePutConnectorConfig())
 				r.Put("/kafka-connect/clusters/{clusterName}/connector-plugins/{pluginClassName}/config/validate", api.handlePutValidateConnectorConfig())
+				r.Get("/kafka-connect/clusters/{clusterName}/connector-plugins/{pluginClassName}/config-definitions", api.handleGetConnectPluginConfigSnapshot())
 				r.Delete("/kafka-connect/clusters/{clusterName}/connectors/{connector}", api.handleDeleteConnector())
 				r.Put("/kafka-connect/clusters/{clusterName}/connectors/{connector}/pause", api.handlePauseConnector())
 				r.Put("/kafka-connect/clusters/{clusterName}/connectors/{connector}/resume", api.handleResumeConnector())
 				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/restart", api.handleRestartConnector())
+				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/promote", api.handlePromoteConnector())
 				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/tasks/{taskID}/restart", api.handleRestartConnectorTask())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/offsets", api.handleGetConnectorOffsets())
+				r.Patch("/kafka-connect/clusters/{clusterName}/connectors/{connector}/offsets", api.handleAlterConnectorOffsets())
+				r.Delete("/kafka-connect/clusters/{clusterName}/connectors/{connector}/offsets", api.handleResetConnectorOffsets())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/dependencies", api.handleGetConnectorDependencies())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/dlq", api.handleGetConnectorDLQ())
+				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/dlq/resend", api.handleResendConnectorDLQRecords())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/history", api.handleGetConnectorHistory())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/history/diff", api.handleGetConnectorHistoryDiff())
+				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/history/{version}/rollback", api.handleRollbackConnector())
+				r.Get("/kafka-connect/mirror-maker/replications", api.handleListMirrorReplications())
+				r.Get("/kafka-connect/mirror-maker/replications/{replicationName}", api.handleGetMirrorReplication())
+				r.Get("/kafka-connect/mirror-maker/replications/{replicationName}/translated-offsets", api.handleGetMirrorTranslatedOffsets())
+				r.Post("/kafka-connect/mirror-maker/replications/{replicationName}/translated-offsets/{groupId}/apply", api.handleApplyMirrorTranslatedOffsets())
+				r.Get("/kafka-connect/plugin-catalog", api.handleGetConnectPluginCatalog())
+				r.Get("/kafka-connect/plugin-catalog/diff", api.handleGetConnectPluginConfigDiff())
+				r.Get("/kafka-connect/gitops/status", api.handleGetConnectGitOpsStatus())
+				r.Get("/kafka-connect/gitops/plan", api.handleGetConnectGitOpsPlan())
+				r.Post("/kafka-connect/gitops/reconcile", api.handleReconcileConnectGitOps())
+				r.Get("/kafka-connect/health/restart-events", api.handleGetConnectRestartEvents())
 
 				// Wasm Transforms
 				r.Put("/transforms", transformSvc.HandleDeployTransform())
@@ -18,11 +18,20 @@ import (
 // Connect defines all configuration options for connecting to one or more
 // Kafka Connect clusters.
 type Connect struct {
-	Enabled        bool             `yaml:"enabled"`
-	Clusters       []ConnectCluster `yaml:"clusters"`
-	ConnectTimeout time.Duration    `yaml:"connectTimeout"` // used for connectivity test
-	ReadTimeout    time.Duration    `yaml:"readTimeout"`    // overall REST/HTTP read timeout
-	RequestTimeout time.Duration    `yaml:"requestTimeout"` // timeout for REST requests to Kafka Connect
+	Enabled        bool                 `yaml:"enabled"`
+	Clusters       []ConnectCluster     `yaml:"clusters"`
+	ConnectTimeout time.Duration        `yaml:"connectTimeout"` // used for connectivity test
+	ReadTimeout    time.Duration        `yaml:"readTimeout"`    // overall REST/HTTP read timeout
+	RequestTimeout time.Duration        `yaml:"requestTimeout"` // timeout for REST requests to Kafka Connect
+	History        ConnectHistory       `yaml:"history"`
+	GitOps         ConnectGitOps        `yaml:"gitops"`
+	HealthMonitor  ConnectHealthMonitor `yaml:"healthMonitor"`
+	Guides         ConnectGuides        `yaml:"guides"`
+	SecretStore    ConnectSecretStore   `yaml:"secretStore"`
+
+	StatusAggregator ConnectStatusAggregator `yaml:"statusAggregator"`
+	MirrorMaker2     ConnectMirrorMaker2     `yaml:"mirrorMaker2"`
+	LoggerReverts    ConnectLoggerReverts    `yaml:"loggerReverts"`
 }
 
 // SetDefaults for Kafka connect configuration.
@@ -33,6 +42,13 @@ func (c *Connect) SetDefaults() {
 	c.ConnectTimeout = 15 * time.Second
 	c.ReadTimeout = 6 * time.Second
 	c.RequestTimeout = 6 * time.Second
+	c.History.SetDefaults()
+	c.GitOps.SetDefaults()
+	c.HealthMonitor.SetDefaults()
+	c.Guides.SetDefaults()
+	c.SecretStore.SetDefaults()
+	c.StatusAggregator.SetDefaults()
+	c.LoggerReverts.SetDefaults()
 }
 
 // RegisterFlags registers all nested config flags.
@@ -41,6 +57,10 @@ func (c *Connect) RegisterFlags(f *flag.FlagSet) {
 		flagNamePrefix := fmt.Sprintf("connect.clusters.%d.", i)
 		cluster.RegisterFlagsWithPrefix(f, flagNamePrefix)
 	}
+	c.GitOps.RegisterFlags(f)
+	c.Guides.RegisterFlags(f)
+	c.SecretStore.RegisterFlags(f)
+	c.MirrorMaker2.RegisterFlags(f)
 }
 
 // Validate provided configurations for Kafka connect clusters.
@@ -51,5 +71,29 @@ func (c *Connect) Validate() error {
 			return fmt.Errorf("failed to validate cluster at index '%d' (name: '%v'): %w", i, cluster.Name, err)
 		}
 	}
+	if err := c.History.Validate(); err != nil {
+		return fmt.Errorf("failed to validate history config: %w", err)
+	}
+	if err := c.GitOps.Validate(); err != nil {
+		return fmt.Errorf("failed to validate gitops config: %w", err)
+	}
+	if err := c.HealthMonitor.Validate(); err != nil {
+		return fmt.Errorf("failed to validate health monitor config: %w", err)
+	}
+	if err := c.Guides.Validate(); err != nil {
+		return fmt.Errorf("failed to validate guides config: %w", err)
+	}
+	if err := c.SecretStore.Validate(); err != nil {
+		return fmt.Errorf("failed to validate secret store config: %w", err)
+	}
+	if err := c.StatusAggregator.Validate(); err != nil {
+		return fmt.Errorf("failed to validate status aggregator config: %w", err)
+	}
+	if err := c.MirrorMaker2.Validate(); err != nil {
+		return fmt.Errorf("failed to validate mirror maker 2 config: %w", err)
+	}
+	if err := c.LoggerReverts.Validate(); err != nil {
+		return fmt.Errorf("failed to validate logger reverts config: %w", err)
+	}
 	return nil
 }
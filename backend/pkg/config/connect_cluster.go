@@ -10,6 +10,7 @@
 package config
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 )
@@ -20,19 +21,25 @@ type ConnectCluster struct {
 	Name string `yaml:"name"`
 	// URL is the HTTP address that will be set as base url for all requests
 	URL string `yaml:"url"`
+	// Discovery discovers the URLs of all workers. If enabled, URL is not required.
+	Discovery ConnectClusterDiscovery `yaml:"discovery"`
 
-	// Authentication configuration
+	// Authentication configuration. At most one of basic auth, token and OAuth2
+	// can be used. A TLS client certificate (mTLS) can be used on its own or in
+	// combination with any of them.
 	//
-	TLS      TLS    `yaml:"tls"`
-	Username string `yaml:"username"`
-	Password string `yaml:"password"`
-	Token    string `yaml:"token"`
+	TLS      TLS                  `yaml:"tls"`
+	Username string               `yaml:"username"`
+	Password string               `yaml:"password"`
+	Token    string               `yaml:"token"`
+	OAuth2   ConnectClusterOAuth2 `yaml:"oauth2"`
 }
 
 // RegisterFlagsWithPrefix registers all nested config flags.
 func (c *ConnectCluster) RegisterFlagsWithPrefix(f *flag.FlagSet, prefix string) {
 	f.StringVar(&c.Password, prefix+"password", "", "Basic auth password for connect cluster authentication")
 	f.StringVar(&c.Token, prefix+"token", "", "Bearer token for connect cluster authentication")
+	c.OAuth2.RegisterFlagsWithPrefix(f, prefix)
 }
 
 // SetDefaults for a target Kafka connect cluster.
@@ -46,10 +53,31 @@ func (c *ConnectCluster) Validate() error {
 		return fmt.Errorf("a cluster name must be set to identify the connect cluster")
 	}
 
-	if c.URL == "" {
+	if c.URL == "" && !c.Discovery.Enabled {
 		return fmt.Errorf("url to access the Connect cluster API must be set")
 	}
 
+	if err := c.Discovery.Validate(); err != nil {
+		return fmt.Errorf("failed to validate discovery config: %w", err)
+	}
+
+	authMethods := 0
+	for _, configured := range []bool{c.Username != "", c.Token != "", c.OAuth2.Enabled} {
+		if configured {
+			authMethods++
+		}
+	}
+	if authMethods > 1 {
+		return errors.New("only one of basic auth, token and oauth2 can be configured")
+	}
+
+	if err := c.OAuth2.Validate(); err != nil {
+		return fmt.Errorf("failed to validate oauth2 config: %w", err)
+	}
+
+	if (c.TLS.CertFilepath == "") != (c.TLS.KeyFilepath == "") {
+		return errors.New("tls certFilepath and keyFilepath must be set together to use a client certificate")
+	}
 	err := c.TLS.Validate()
 	if err != nil {
 		return fmt.Errorf("failed to validate TLS config: %w", err)
@@ -0,0 +1,99 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// ConnectClusterDiscoveryTypeDNSSRV discovers the Kafka connect workers via DNS SRV records.
+	ConnectClusterDiscoveryTypeDNSSRV = "dns-srv"
+	// ConnectClusterDiscoveryTypeKubernetes discovers the Kafka connect workers via the
+	// endpoints of a Kubernetes service.
+	ConnectClusterDiscoveryTypeKubernetes = "kubernetes"
+)
+
+// ConnectClusterDiscovery is the configuration for discovering the URLs of all
+// workers of a Kafka connect cluster. Requests are sent to one of the discovered
+// workers and fail over to the next worker if a worker is not reachable.
+type ConnectClusterDiscovery struct {
+	Enabled bool   `yaml:"enabled"`
+	Type    string `yaml:"type"`
+	// Scheme that is used for the discovered workers. Defaults to https if TLS
+	// is enabled for the cluster, otherwise http.
+	Scheme string `yaml:"scheme"`
+	// RefreshInterval is the interval in which the workers are discovered again.
+	// Defaults to 30s.
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+
+	DNSSRV     ConnectClusterDiscoveryDNSSRV     `yaml:"dnsSrv"`
+	Kubernetes ConnectClusterDiscoveryKubernetes `yaml:"kubernetes"`
+}
+
+// ConnectClusterDiscoveryDNSSRV is the configuration for discovering workers via
+// DNS SRV records. If Service and Proto are set, the record
+// _service._proto.name is looked up, otherwise name is looked up directly.
+type ConnectClusterDiscoveryDNSSRV struct {
+	Service string `yaml:"service"`
+	Proto   string `yaml:"proto"`
+	Name    string `yaml:"name"`
+}
+
+// ConnectClusterDiscoveryKubernetes is the configuration for discovering workers
+// via the endpoints of a Kubernetes service. Console's service account must be
+// allowed to get endpoints in the namespace.
+type ConnectClusterDiscoveryKubernetes struct {
+	// APIServerURL defaults to https://kubernetes.default.svc.
+	APIServerURL string `yaml:"apiServerUrl"`
+	// Namespace defaults to the namespace of Console's service account.
+	Namespace string `yaml:"namespace"`
+	// Service is the name of the Kubernetes service of the Kafka connect workers.
+	Service string `yaml:"service"`
+	// PortName selects the endpoint port. Defaults to the first port.
+	PortName string `yaml:"portName"`
+	// TokenFilepath defaults to the token of the mounted service account.
+	TokenFilepath string `yaml:"tokenFilepath"`
+	// CAFilepath defaults to the CA of the mounted service account.
+	CAFilepath string `yaml:"caFilepath"`
+}
+
+// Validate the discovery configuration.
+func (c *ConnectClusterDiscovery) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Scheme != "" && c.Scheme != "http" && c.Scheme != "https" {
+		return fmt.Errorf("discovery scheme must be http or https, but got %q", c.Scheme)
+	}
+	if c.RefreshInterval < 0 {
+		return errors.New("discovery refresh interval must not be negative")
+	}
+
+	switch c.Type {
+	case ConnectClusterDiscoveryTypeDNSSRV:
+		if c.DNSSRV.Name == "" {
+			return errors.New("dns srv name must be set")
+		}
+		if (c.DNSSRV.Service == "") != (c.DNSSRV.Proto == "") {
+			return errors.New("dns srv service and proto must be set together")
+		}
+	case ConnectClusterDiscoveryTypeKubernetes:
+		if c.Kubernetes.Service == "" {
+			return errors.New("kubernetes service name must be set")
+		}
+	default:
+		return fmt.Errorf("unknown discovery type %q, must be one of %q or %q",
+			c.Type, ConnectClusterDiscoveryTypeDNSSRV, ConnectClusterDiscoveryTypeKubernetes)
+	}
+	return nil
+}
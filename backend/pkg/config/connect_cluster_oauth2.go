@@ -0,0 +1,49 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+)
+
+// ConnectClusterOAuth2 is the configuration for authenticating against a Kafka
+// connect cluster with access tokens that are obtained via the OAuth2 client
+// credentials grant. This is commonly required if the Kafka connect REST API is
+// exposed via an API gateway.
+type ConnectClusterOAuth2 struct {
+	Enabled       bool     `yaml:"enabled"`
+	TokenEndpoint string   `yaml:"tokenEndpoint"`
+	ClientID      string   `yaml:"clientId"`
+	ClientSecret  string   `yaml:"clientSecret"`
+	Scopes        []string `yaml:"scopes"`
+	// Audience is sent as additional audience parameter, which is required by
+	// some identity providers.
+	Audience string `yaml:"audience"`
+}
+
+// RegisterFlagsWithPrefix registers all sensitive OAuth2 settings as flag.
+func (c *ConnectClusterOAuth2) RegisterFlagsWithPrefix(f *flag.FlagSet, prefix string) {
+	f.StringVar(&c.ClientSecret, prefix+"oauth2.client-secret", "", "OAuth2 client secret for connect cluster authentication")
+}
+
+// Validate the OAuth2 configuration.
+func (c *ConnectClusterOAuth2) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.TokenEndpoint == "" {
+		return errors.New("oauth2 token endpoint must be set")
+	}
+	if c.ClientID == "" || c.ClientSecret == "" {
+		return errors.New("oauth2 client credentials must be set")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+)
+
+// ConnectGitOps configures the declarative management of Kafka connect connectors.
+// Connectors are defined as YAML files in a Git repository and Console reconciles
+// them against the configured Kafka connect clusters.
+type ConnectGitOps struct {
+	Enabled bool `yaml:"enabled"`
+	Git     Git  `yaml:"git"`
+
+	// ReconcileInterval specifies how often the declared connectors are reconciled,
+	// regardless of whether the repository has changed. Changes in the repository
+	// always trigger a reconciliation. Set it to 0 to only reconcile on changes.
+	ReconcileInterval time.Duration `yaml:"reconcileInterval"`
+
+	// DryRun only computes the plan, but never applies it. The plan can be
+	// inspected via the API.
+	DryRun bool `yaml:"dryRun"`
+
+	// Owner is written as ownership label to all connectors that are managed by
+	// the reconciler. Connectors that carry a different owner are never modified.
+	Owner string `yaml:"owner"`
+
+	// DeleteUnmanaged deletes connectors that are owned by the reconciler but are
+	// no longer declared in the repository.
+	DeleteUnmanaged bool `yaml:"deleteUnmanaged"`
+
+	// DeleteUnlabeled additionally deletes connectors that are not declared in the
+	// repository and don't carry an ownership label at all, i.e. connectors that
+	// have been created by other means. Requires DeleteUnmanaged.
+	DeleteUnlabeled bool `yaml:"deleteUnlabeled"`
+}
+
+// SetDefaults for the connect GitOps config.
+func (c *ConnectGitOps) SetDefaults() {
+	c.Git.SetDefaults()
+	c.Git.IndexByFullFilepath = true
+	c.Git.AllowedFileExtensions = []string{"yaml", "yml"}
+	c.ReconcileInterval = 5 * time.Minute
+	c.Owner = "redpanda-console"
+}
+
+// RegisterFlags registers all sensitive GitOps config flags.
+func (c *ConnectGitOps) RegisterFlags(f *flag.FlagSet) {
+	c.Git.RegisterFlagsWithPrefix(f, "connect.gitops.")
+}
+
+// Validate the connect GitOps config.
+func (c *ConnectGitOps) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if !c.Git.Enabled {
+		return errors.New("git must be enabled")
+	}
+	if err := c.Git.Validate(); err != nil {
+		return fmt.Errorf("failed to validate git config: %w", err)
+	}
+	if c.Owner == "" {
+		return errors.New("owner must be set")
+	}
+	if c.ReconcileInterval < 0 {
+		return errors.New("reconcile interval must not be negative")
+	}
+	if c.DeleteUnlabeled && !c.DeleteUnmanaged {
+		return errors.New("deleteUnlabeled requires deleteUnmanaged to be enabled")
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+)
+
+// ConnectGuides configures the sources of declarative connector guides. Guides
+// are defined in YAML or JSON files and are reloaded when the files change.
+type ConnectGuides struct {
+	Enabled    bool       `yaml:"enabled"`
+	FileSystem Filesystem `yaml:"fileSystem"`
+	Git        Git        `yaml:"git"`
+}
+
+// SetDefaults for the connect guides config.
+func (c *ConnectGuides) SetDefaults() {
+	c.FileSystem.SetDefaults()
+	c.FileSystem.AllowedFileExtensions = []string{"yaml", "yml", "json"}
+	c.Git.SetDefaults()
+	c.Git.IndexByFullFilepath = true
+	c.Git.AllowedFileExtensions = []string{"yaml", "yml", "json"}
+}
+
+// RegisterFlags registers all sensitive connect guides config flags.
+func (c *ConnectGuides) RegisterFlags(f *flag.FlagSet) {
+	c.Git.RegisterFlagsWithPrefix(f, "connect.guides.")
+}
+
+// Validate the connect guides config.
+func (c *ConnectGuides) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if !c.FileSystem.Enabled && !c.Git.Enabled {
+		return errors.New("connector guides are enabled, but neither the filesystem nor git source is enabled")
+	}
+	if err := c.FileSystem.Validate(); err != nil {
+		return fmt.Errorf("failed to validate filesystem config: %w", err)
+	}
+	if err := c.Git.Validate(); err != nil {
+		return fmt.Errorf("failed to validate git config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// ConnectHealthMonitor configures the background monitor that periodically polls
+// the status of all connectors and tasks. The states are exposed as Prometheus
+// metrics and failed connectors or tasks can be restarted automatically.
+type ConnectHealthMonitor struct {
+	Enabled      bool          `yaml:"enabled"`
+	PollInterval time.Duration `yaml:"pollInterval"`
+
+	// MaxRestartEvents is the number of restart events that are kept in memory.
+	MaxRestartEvents int `yaml:"maxRestartEvents"`
+
+	AutoRestart ConnectAutoRestart `yaml:"autoRestart"`
+}
+
+// ConnectAutoRestart is the policy for automatically restarting failed connectors
+// and tasks. The wait time between two restarts of the same connector or task
+// grows exponentially.
+type ConnectAutoRestart struct {
+	Enabled bool `yaml:"enabled"`
+
+	// MaxAttempts is the number of restarts of a single connector or task before
+	// the monitor gives up. The attempts are reset once it is running for ResetAfter.
+	MaxAttempts int           `yaml:"maxAttempts"`
+	ResetAfter  time.Duration `yaml:"resetAfter"`
+
+	BaseInterval time.Duration `yaml:"baseInterval"`
+	MaxInterval  time.Duration `yaml:"maxInterval"`
+	Multiplier   float64       `yaml:"multiplier"`
+
+	// TracePatterns are regular expressions that are matched against the error
+	// trace of a failed connector or task. If set, only failures whose trace
+	// matches at least one pattern are restarted.
+	TracePatterns []string `yaml:"tracePatterns"`
+
+	// ConnectorPatterns are regular expressions that are matched against the
+	// connector names. If set, only matching connectors are restarted.
+	ConnectorPatterns []string `yaml:"connectorPatterns"`
+}
+
+// SetDefaults for the connect health monitor config.
+func (c *ConnectHealthMonitor) SetDefaults() {
+	c.PollInterval = 30 * time.Second
+	c.MaxRestartEvents = 1000
+	c.AutoRestart.SetDefaults()
+}
+
+// Validate the connect health monitor config.
+func (c *ConnectHealthMonitor) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.PollInterval <= 0 {
+		return errors.New("poll interval must be greater than 0")
+	}
+	if c.MaxRestartEvents < 0 {
+		return errors.New("max restart events must not be negative")
+	}
+	if err := c.AutoRestart.Validate(); err != nil {
+		return fmt.Errorf("failed to validate auto restart config: %w", err)
+	}
+	return nil
+}
+
+// SetDefaults for the auto restart policy.
+func (c *ConnectAutoRestart) SetDefaults() {
+	c.MaxAttempts = 5
+	c.ResetAfter = 10 * time.Minute
+	c.BaseInterval = 30 * time.Second
+	c.MaxInterval = 30 * time.Minute
+	c.Multiplier = 2
+}
+
+// Validate the auto restart policy.
+func (c *ConnectAutoRestart) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxAttempts < 1 {
+		return errors.New("max attempts must be at least 1")
+	}
+	if c.BaseInterval <= 0 || c.MaxInterval < c.BaseInterval {
+		return errors.New("base interval must be greater than 0 and not exceed the max interval")
+	}
+	if c.Multiplier < 1 {
+		return errors.New("multiplier must be at least 1")
+	}
+	for _, pattern := range c.TracePatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid trace pattern %q: %w", pattern, err)
+		}
+	}
+	for _, pattern := range c.ConnectorPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid connector pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ConnectHistory configures the connector config history. Every create, update
+// and delete of a connector that is issued via Console is recorded in a compacted
+// Kafka topic that is owned by Console.
+type ConnectHistory struct {
+	Enabled bool   `yaml:"enabled"`
+	Topic   string `yaml:"topic"`
+
+	// ReplicationFactor and Partitions are used when Console creates the history topic.
+	// A replication factor of -1 uses the broker default.
+	ReplicationFactor int16 `yaml:"replicationFactor"`
+	Partitions        int32 `yaml:"partitions"`
+
+	// SensitiveKeyPatterns are regular expressions that are matched against
+	// connector config keys. Values of matching keys are redacted before they are
+	// written to the history topic.
+	SensitiveKeyPatterns []string `yaml:"sensitiveKeyPatterns"`
+}
+
+// SetDefaults for the connect history config.
+func (c *ConnectHistory) SetDefaults() {
+	c.Topic = "_redpanda_console_connect_history"
+	c.ReplicationFactor = -1
+	c.Partitions = 1
+	c.SensitiveKeyPatterns = []string{
+		"(?i)password",
+		"(?i)secret",
+		"(?i)token",
+		"(?i)credentials?",
+		"(?i)(api|private|access)[._-]?key",
+		"(?i)sasl\\.jaas\\.config",
+		"(?i)\\.key$",
+	}
+}
+
+// Validate the connect history config.
+func (c *ConnectHistory) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Topic == "" {
+		return errors.New("topic must be set")
+	}
+	if c.Partitions < 1 {
+		return errors.New("partitions must be at least 1")
+	}
+	for _, pattern := range c.SensitiveKeyPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid sensitive key pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
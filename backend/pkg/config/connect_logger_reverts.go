@@ -0,0 +1,50 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+)
+
+// ConnectLoggerReverts configures how pending reverts of Kafka connect logger
+// levels are persisted. If enabled, pending reverts are stored in a compacted
+// Kafka topic that is owned by Console, so that they survive restarts and are
+// carried out by any Console instance. Otherwise pending reverts are held in
+// memory and carried out when Console shuts down.
+type ConnectLoggerReverts struct {
+	Enabled bool   `yaml:"enabled"`
+	Topic   string `yaml:"topic"`
+
+	// ReplicationFactor and Partitions are used when Console creates the topic.
+	// A replication factor of -1 uses the broker default.
+	ReplicationFactor int16 `yaml:"replicationFactor"`
+	Partitions        int32 `yaml:"partitions"`
+}
+
+// SetDefaults for the connect logger reverts config.
+func (c *ConnectLoggerReverts) SetDefaults() {
+	c.Topic = "_redpanda_console_connect_logger_reverts"
+	c.ReplicationFactor = -1
+	c.Partitions = 1
+}
+
+// Validate the connect logger reverts config.
+func (c *ConnectLoggerReverts) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Topic == "" {
+		return errors.New("topic must be set")
+	}
+	if c.Partitions < 1 {
+		return errors.New("partitions must be at least 1")
+	}
+	return nil
+}
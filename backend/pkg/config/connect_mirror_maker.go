@@ -0,0 +1,140 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+)
+
+const (
+	// MirrorReplicationPolicyDefault prefixes replicated topics with the source
+	// cluster alias, like MirrorMaker 2's DefaultReplicationPolicy.
+	MirrorReplicationPolicyDefault = "default"
+	// MirrorReplicationPolicyIdentity keeps the topic names of the source
+	// cluster, like MirrorMaker 2's IdentityReplicationPolicy.
+	MirrorReplicationPolicyIdentity = "identity"
+)
+
+// ConnectMirrorMaker2 configures the MirrorMaker 2 replication dashboard. Each
+// replication mirrors a source cluster into the Kafka cluster that Console is
+// connected to.
+type ConnectMirrorMaker2 struct {
+	Enabled      bool                       `yaml:"enabled"`
+	Replications []ConnectMirrorReplication `yaml:"replications"`
+}
+
+// ConnectMirrorReplication is a single source -> target replication flow of
+// MirrorMaker 2. The target is always the Kafka cluster that Console is
+// connected to.
+type ConnectMirrorReplication struct {
+	// Name identifies the replication in the API.
+	Name string `yaml:"name"`
+	// SourceClusterAlias and TargetClusterAlias are the cluster aliases as
+	// configured in the MirrorMaker 2 connectors (source.cluster.alias and
+	// target.cluster.alias).
+	SourceClusterAlias string `yaml:"sourceClusterAlias"`
+	TargetClusterAlias string `yaml:"targetClusterAlias"`
+
+	// ConnectClusterName and SourceConnectorName identify the
+	// MirrorSourceConnector. Its source offsets are the last replicated offsets
+	// of each source partition.
+	ConnectClusterName  string `yaml:"connectClusterName"`
+	SourceConnectorName string `yaml:"sourceConnectorName"`
+
+	// ReplicationPolicy is either "default" or "identity". Separator is the
+	// replication.policy.separator of the default replication policy.
+	ReplicationPolicy string `yaml:"replicationPolicy"`
+	Separator         string `yaml:"separator"`
+
+	// Source is the connection to the source cluster.
+	Source ConnectMirrorSourceCluster `yaml:"source"`
+}
+
+// ConnectMirrorSourceCluster is the connection to the source cluster of a
+// MirrorMaker 2 replication.
+type ConnectMirrorSourceCluster struct {
+	Brokers  []string  `yaml:"brokers"`
+	ClientID string    `yaml:"clientId"`
+	TLS      TLS       `yaml:"tls"`
+	SASL     KafkaSASL `yaml:"sasl"`
+}
+
+// RegisterFlags registers all nested config flags.
+func (c *ConnectMirrorMaker2) RegisterFlags(f *flag.FlagSet) {
+	for i := range c.Replications {
+		flagNamePrefix := fmt.Sprintf("connect.mirrorMaker2.replications.%d.source.sasl.", i)
+		f.StringVar(&c.Replications[i].Source.SASL.Password, flagNamePrefix+"password", "", "SASL password of the MirrorMaker 2 source cluster")
+	}
+}
+
+// Validate the MirrorMaker 2 config.
+func (c *ConnectMirrorMaker2) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	names := make(map[string]struct{}, len(c.Replications))
+	for i, replication := range c.Replications {
+		if err := replication.Validate(); err != nil {
+			return fmt.Errorf("failed to validate replication at index '%d' (name: '%v'): %w", i, replication.Name, err)
+		}
+		if _, exists := names[replication.Name]; exists {
+			return fmt.Errorf("replication name '%v' is used more than once", replication.Name)
+		}
+		names[replication.Name] = struct{}{}
+	}
+	return nil
+}
+
+// Validate a single MirrorMaker 2 replication.
+func (c *ConnectMirrorReplication) Validate() error {
+	if c.Name == "" {
+		return errors.New("a name must be set to identify the replication")
+	}
+	if c.SourceClusterAlias == "" || c.TargetClusterAlias == "" {
+		return errors.New("source and target cluster alias must be set")
+	}
+	if c.ConnectClusterName == "" || c.SourceConnectorName == "" {
+		return errors.New("connect cluster name and source connector name must be set")
+	}
+	switch c.ReplicationPolicy {
+	case "", MirrorReplicationPolicyDefault, MirrorReplicationPolicyIdentity:
+	default:
+		return fmt.Errorf("given replication policy '%v' is invalid", c.ReplicationPolicy)
+	}
+	if len(c.Source.Brokers) == 0 {
+		return errors.New("you must specify at least one broker of the source cluster")
+	}
+	if c.Source.SASL.Enabled {
+		if err := c.Source.SASL.Validate(); err != nil {
+			return fmt.Errorf("failed to validate source sasl config: %w", err)
+		}
+	}
+	if err := c.Source.TLS.Validate(); err != nil {
+		return fmt.Errorf("failed to validate source tls config: %w", err)
+	}
+	return nil
+}
+
+// KafkaConfig returns the Kafka client config for the source cluster.
+func (c *ConnectMirrorSourceCluster) KafkaConfig() Kafka {
+	clientID := c.ClientID
+	if clientID == "" {
+		clientID = "redpanda-console"
+	}
+	return Kafka{
+		Brokers:  c.Brokers,
+		ClientID: clientID,
+		TLS:      c.TLS,
+		SASL:     c.SASL,
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+)
+
+// Supported secret store types.
+const (
+	ConnectSecretStoreTypeKubernetes = "kubernetes"
+	ConnectSecretStoreTypeVault      = "vault"
+	ConnectSecretStoreTypeFile       = "file"
+)
+
+// ConnectSecretStore configures the store that holds the secrets of Kafka connect
+// connectors. The Kafka connect workers must be configured with a config provider
+// that resolves references to secrets of the same store.
+type ConnectSecretStore struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Type is one of kubernetes, vault or file.
+	Type string `yaml:"type"`
+
+	// ConfigProvider is the name of the config provider on the Kafka connect workers,
+	// which is used in config references such as ${vault:path:key}. If empty, the
+	// name defaults to "secrets" for kubernetes and "vault" for vault. The file store
+	// requires a custom config provider that decrypts the secrets file, so its name
+	// must always be set.
+	ConfigProvider string `yaml:"configProvider"`
+
+	// RewriteSensitiveConfigs moves the values of all connector configs of type PASSWORD
+	// into the secret store and replaces them with references when a connector is
+	// created or its config is updated.
+	RewriteSensitiveConfigs bool `yaml:"rewriteSensitiveConfigs"`
+
+	Kubernetes ConnectSecretStoreKubernetes `yaml:"kubernetes"`
+	Vault      ConnectSecretStoreVault      `yaml:"vault"`
+	File       ConnectSecretStoreFile       `yaml:"file"`
+}
+
+// ConnectSecretStoreKubernetes stores secrets as Kubernetes secrets. References are
+// written in the format of the Strimzi KubernetesSecretConfigProvider.
+type ConnectSecretStoreKubernetes struct {
+	// APIServerURL defaults to the in-cluster API server.
+	APIServerURL string `yaml:"apiServerUrl"`
+	// Namespace defaults to the namespace of the service account Console runs with.
+	Namespace     string `yaml:"namespace"`
+	TokenFilepath string `yaml:"tokenFilepath"`
+	CAFilepath    string `yaml:"caFilepath"`
+	// NamePrefix is prepended to the names of all Kubernetes secrets.
+	NamePrefix string `yaml:"namePrefix"`
+	// DataKey is the key within the Kubernetes secret that holds the value.
+	DataKey string `yaml:"dataKey"`
+}
+
+// ConnectSecretStoreVault stores secrets in a HashiCorp Vault KV version 2 engine.
+type ConnectSecretStoreVault struct {
+	Address   string `yaml:"address"`
+	Token     string `yaml:"token"`
+	Namespace string `yaml:"namespace"`
+	// MountPath is the path of the KV secrets engine.
+	MountPath string `yaml:"mountPath"`
+	// PathPrefix is prepended to the paths of all secrets within the engine.
+	PathPrefix string `yaml:"pathPrefix"`
+	// DataKey is the key within the Vault secret that holds the value.
+	DataKey string `yaml:"dataKey"`
+	TLS     TLS    `yaml:"tls"`
+}
+
+// ConnectSecretStoreFile stores secrets in a local file that is encrypted with
+// AES-256-GCM.
+type ConnectSecretStoreFile struct {
+	Path string `yaml:"path"`
+	// EncryptionKey is the base64 encoded 32 byte key.
+	EncryptionKey string `yaml:"encryptionKey"`
+}
+
+// SetDefaults for the secret store config.
+func (c *ConnectSecretStore) SetDefaults() {
+	c.RewriteSensitiveConfigs = true
+
+	c.Kubernetes.APIServerURL = "https://kubernetes.default.svc"
+	c.Kubernetes.TokenFilepath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	c.Kubernetes.CAFilepath = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	c.Kubernetes.NamePrefix = "console-connect"
+	c.Kubernetes.DataKey = "value"
+
+	c.Vault.MountPath = "secret"
+	c.Vault.PathPrefix = "redpanda-console/connect"
+	c.Vault.DataKey = "value"
+	c.Vault.TLS.SetDefaults()
+}
+
+// RegisterFlags registers all sensitive secret store config flags.
+func (c *ConnectSecretStore) RegisterFlags(f *flag.FlagSet) {
+	f.StringVar(&c.Vault.Token, "connect.secret-store.vault.token", "", "Token for authenticating against Vault")
+	f.StringVar(&c.File.EncryptionKey, "connect.secret-store.file.encryption-key", "", "Base64 encoded 32 byte key to encrypt the secrets file with")
+}
+
+// Validate the secret store config.
+func (c *ConnectSecretStore) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Type {
+	case ConnectSecretStoreTypeKubernetes:
+		if c.Kubernetes.APIServerURL == "" {
+			return errors.New("kubernetes api server url must be set")
+		}
+		if c.Kubernetes.DataKey == "" {
+			return errors.New("kubernetes data key must be set")
+		}
+	case ConnectSecretStoreTypeVault:
+		if c.Vault.Address == "" {
+			return errors.New("vault address must be set")
+		}
+		if c.Vault.MountPath == "" {
+			return errors.New("vault mount path must be set")
+		}
+		if c.Vault.DataKey == "" {
+			return errors.New("vault data key must be set")
+		}
+		if err := c.Vault.TLS.Validate(); err != nil {
+			return fmt.Errorf("failed to validate vault tls config: %w", err)
+		}
+	case ConnectSecretStoreTypeFile:
+		if c.File.Path == "" {
+			return errors.New("file path must be set")
+		}
+		if c.ConfigProvider == "" {
+			return errors.New("config provider must be set for the file store, because the encrypted secrets file can only be read by a custom config provider")
+		}
+		key, err := base64.StdEncoding.DecodeString(c.File.EncryptionKey)
+		if err != nil {
+			return fmt.Errorf("file encryption key must be base64 encoded: %w", err)
+		}
+		if len(key) != 32 {
+			return fmt.Errorf("file encryption key must be 32 bytes long, but it is %d bytes long", len(key))
+		}
+	default:
+		return fmt.Errorf("unknown secret store type %q, must be one of kubernetes, vault or file", c.Type)
+	}
+
+	return nil
+}
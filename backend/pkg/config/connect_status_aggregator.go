@@ -0,0 +1,51 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// ConnectStatusAggregator configures the background cache of all connectors
+// and their states across all Kafka connect clusters. It serves the filtered
+// and paginated connector list, so that slow or unavailable clusters do not
+// delay the response.
+type ConnectStatusAggregator struct {
+	Enabled         bool          `yaml:"enabled"`
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+	// ClusterTimeout is the maximum time to wait for a single cluster to list
+	// its connectors. Clusters that time out keep their previous connectors and
+	// are marked as stale.
+	ClusterTimeout time.Duration `yaml:"clusterTimeout"`
+}
+
+// SetDefaults for the connect status aggregator config.
+func (c *ConnectStatusAggregator) SetDefaults() {
+	c.RefreshInterval = 30 * time.Second
+	c.ClusterTimeout = 10 * time.Second
+}
+
+// Validate the connect status aggregator config.
+func (c *ConnectStatusAggregator) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.RefreshInterval <= 0 {
+		return errors.New("refresh interval must be greater than 0")
+	}
+	if c.ClusterTimeout <= 0 {
+		return errors.New("cluster timeout must be greater than 0")
+	}
+	if c.ClusterTimeout > c.RefreshInterval {
+		return errors.New("cluster timeout must not be greater than the refresh interval")
+	}
+	return nil
+}
@@ -26,6 +26,8 @@ type Console struct {
 	TopicDocumentation            ConsoleTopicDocumentation `yaml:"topicDocumentation"`
 	MaxDeserializationPayloadSize int                       `yaml:"maxDeserializationPayloadSize"`
 	API                           ConsoleAPI                `yaml:"api"`
+	SchemaUsage                   ConsoleSchemaUsage        `yaml:"schemaUsage"`
+	GitWebhook                    ConsoleGitWebhook         `yaml:"gitWebhook"`
 }
 
 // SetDefaults for Console configs.
@@ -34,11 +36,13 @@ func (c *Console) SetDefaults() {
 	c.TopicDocumentation.SetDefaults()
 	c.MaxDeserializationPayloadSize = DefaultMaxDeserializationPayloadSize
 	c.API.SetDefaults()
+	c.SchemaUsage.SetDefaults()
 }
 
 // RegisterFlags for sensitive Console configurations.
 func (c *Console) RegisterFlags(f *flag.FlagSet) {
 	c.TopicDocumentation.RegisterFlags(f)
+	c.GitWebhook.RegisterFlags(f)
 }
 
 // Validate Console configurations.
@@ -51,5 +55,13 @@ func (c *Console) Validate() error {
 		return fmt.Errorf("failed to validate API config: %w", err)
 	}
 
+	if err := c.SchemaUsage.Validate(); err != nil {
+		return fmt.Errorf("failed to validate schema usage config: %w", err)
+	}
+
+	if err := c.GitWebhook.Validate(); err != nil {
+		return fmt.Errorf("failed to validate git webhook config: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,42 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+)
+
+// ConsoleGitWebhook configures the webhook endpoint that triggers an immediate pull
+// of all Git repositories that are used for topic documentation and proto files.
+type ConsoleGitWebhook struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Secret is the shared secret that is used to validate incoming webhook requests.
+	// GitHub style HMAC signatures (X-Hub-Signature-256) as well as plain tokens
+	// (X-Gitlab-Token) are supported.
+	Secret string `yaml:"secret"`
+}
+
+// RegisterFlags for sensitive webhook configurations.
+func (c *ConsoleGitWebhook) RegisterFlags(f *flag.FlagSet) {
+	f.StringVar(&c.Secret, "console.git-webhook.secret", "", "Shared secret for validating Git webhook requests")
+}
+
+// Validate the webhook configuration.
+func (c *ConsoleGitWebhook) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Secret == "" {
+		return errors.New("git webhook is enabled but no secret is configured")
+	}
+	return nil
+}
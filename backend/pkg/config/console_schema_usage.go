@@ -0,0 +1,62 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// ConsoleSchemaUsage configures the schema usage sampler, which periodically reads the
+// most recent records of all topics to find out which schema IDs are actually in use.
+type ConsoleSchemaUsage struct {
+	// Enabled turns on the periodic refresh of the schema usage report. If disabled the
+	// report is only created on demand.
+	Enabled bool `yaml:"enabled"`
+
+	// RefreshInterval is the interval at which the schema usage report is refreshed.
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+
+	// RecordsPerPartition is the maximum number of most recent records that are read
+	// from each partition.
+	RecordsPerPartition int `yaml:"recordsPerPartition"`
+
+	// TopicConcurrency is the number of topics that are sampled concurrently.
+	TopicConcurrency int `yaml:"topicConcurrency"`
+
+	// TopicTimeout is the maximum time spent sampling a single topic.
+	TopicTimeout time.Duration `yaml:"topicTimeout"`
+}
+
+// SetDefaults for the schema usage sampler.
+func (c *ConsoleSchemaUsage) SetDefaults() {
+	c.Enabled = false
+	c.RefreshInterval = 15 * time.Minute
+	c.RecordsPerPartition = 20
+	c.TopicConcurrency = 4
+	c.TopicTimeout = 10 * time.Second
+}
+
+// Validate the schema usage sampler configuration.
+func (c *ConsoleSchemaUsage) Validate() error {
+	if c.RecordsPerPartition <= 0 {
+		return errors.New("recordsPerPartition must be greater than 0")
+	}
+	if c.TopicConcurrency <= 0 {
+		return errors.New("topicConcurrency must be greater than 0")
+	}
+	if c.TopicTimeout <= 0 {
+		return errors.New("topicTimeout must be greater than 0")
+	}
+	if c.Enabled && c.RefreshInterval <= 0 {
+		return errors.New("refreshInterval must be greater than 0 if the periodic refresh is enabled")
+	}
+	return nil
+}
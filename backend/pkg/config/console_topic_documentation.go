@@ -12,6 +12,7 @@ package config
 import (
 	"flag"
 	"fmt"
+	"time"
 )
 
 // ConsoleTopicDocumentation declares the configuration properties that allow you to pull
@@ -20,12 +21,37 @@ import (
 type ConsoleTopicDocumentation struct {
 	Enabled bool `yaml:"enabled"`
 	Git     Git  `yaml:"git"`
+
+	// GitRepositories are additional Git repositories that contain topic documentation.
+	// Each repository has its own authentication, reference, base directory and refresh interval.
+	GitRepositories []Git `yaml:"gitRepositories"`
+
+	// FileSystem loads markdown files from the local filesystem, e.g. a mounted ConfigMap.
+	FileSystem Filesystem `yaml:"fileSystem"`
+
+	// TopicConfig loads markdown from a topic config.
+	TopicConfig TopicDocumentationTopicConfig `yaml:"topicConfig"`
+
+	// SchemaRegistry loads the documentation of a topic's value schema.
+	SchemaRegistry TopicDocumentationSchemaRegistry `yaml:"schemaRegistry"`
+
+	// HTTP loads markdown from an HTTP service such as a data catalog.
+	HTTP TopicDocumentationHTTP `yaml:"http"`
+
+	// CacheTTL is the duration for which documentation from remote sources (topic config,
+	// schema registry and HTTP) is cached.
+	CacheTTL time.Duration `yaml:"cacheTtl"`
+
+	// Editing allows users to edit topic documentation, which is then committed
+	// to the Git repository that serves it.
+	Editing TopicDocumentationEditing `yaml:"editing"`
 }
 
 // RegisterFlags with sensitive configuration options for the Console topic documentation
 // feature.
 func (c *ConsoleTopicDocumentation) RegisterFlags(f *flag.FlagSet) {
 	c.Git.RegisterFlagsWithPrefix(f, "owl.topic-documentation.")
+	c.HTTP.RegisterFlags(f, "owl.topic-documentation.")
 }
 
 // Validate configuration options for the Console topic documentation feature.
@@ -33,15 +59,62 @@ func (c *ConsoleTopicDocumentation) Validate() error {
 	if !c.Enabled {
 		return nil
 	}
-	if c.Enabled && !c.Git.Enabled {
-		return fmt.Errorf("topic documentation is enabled, but git service is disabled. At least one source for topic documentations must be configured")
+	if !c.Git.Enabled && len(c.GitRepositories) == 0 && !c.FileSystem.Enabled && !c.TopicConfig.Enabled &&
+		!c.SchemaRegistry.Enabled && !c.HTTP.Enabled {
+		return fmt.Errorf("topic documentation is enabled, but no source is configured. At least one source for topic documentations must be configured")
 	}
 
-	return c.Git.Validate()
+	for i, repo := range c.GitSources() {
+		if err := repo.Validate(); err != nil {
+			return fmt.Errorf("failed to validate git repository at index %d: %w", i, err)
+		}
+	}
+	if err := c.FileSystem.Validate(); err != nil {
+		return fmt.Errorf("failed to validate filesystem config: %w", err)
+	}
+	if err := c.TopicConfig.Validate(); err != nil {
+		return fmt.Errorf("failed to validate topic config source: %w", err)
+	}
+	if err := c.HTTP.Validate(); err != nil {
+		return fmt.Errorf("failed to validate http source: %w", err)
+	}
+	if err := c.Editing.Validate(); err != nil {
+		return fmt.Errorf("failed to validate editing config: %w", err)
+	}
+	if c.Editing.Enabled && len(c.GitSources()) == 0 {
+		return fmt.Errorf("editing topic documentation requires a git repository")
+	}
+	if c.CacheTTL < 0 {
+		return fmt.Errorf("cache ttl must not be negative")
+	}
+
+	return nil
 }
 
 // SetDefaults for ConsoleTopicDocumentation.
 func (c *ConsoleTopicDocumentation) SetDefaults() {
 	c.Git.SetDefaults()
 	c.Git.AllowedFileExtensions = []string{".md"}
+	c.FileSystem.SetDefaults()
+	c.HTTP.SetDefaults()
+	c.CacheTTL = time.Minute
+	c.Editing.SetDefaults()
+}
+
+// GitSources returns the configurations of all Git repositories that shall be used
+// as source for topic documentation. This includes the repository configured via Git,
+// if enabled, followed by all GitRepositories.
+func (c *ConsoleTopicDocumentation) GitSources() []Git {
+	sources := make([]Git, 0, len(c.GitRepositories)+1)
+	if c.Git.Enabled {
+		sources = append(sources, c.Git)
+	}
+	for _, repo := range c.GitRepositories {
+		repo.Enabled = true
+		repo.SetDefaultsForUnset()
+		repo.AllowedFileExtensions = c.Git.AllowedFileExtensions
+		repo.IndexByFullFilepath = c.Git.IndexByFullFilepath
+		sources = append(sources, repo)
+	}
+	return sources
 }
@@ -0,0 +1,73 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// TopicDocumentationEditingModeDirect commits changes to the synced branch.
+	TopicDocumentationEditingModeDirect = "direct"
+	// TopicDocumentationEditingModeChange pushes each change to a new branch, so
+	// that it can be reviewed and merged via the Git provider.
+	TopicDocumentationEditingModeChange = "change"
+)
+
+// TopicDocumentationEditing configures editing topic documentation in Console.
+// Changes are committed to the Git repository that serves the documentation.
+type TopicDocumentationEditing struct {
+	Enabled bool   `yaml:"enabled"`
+	Mode    string `yaml:"mode"`
+
+	// AuthorName and AuthorEmail are used as author identity of all commits.
+	AuthorName  string `yaml:"authorName"`
+	AuthorEmail string `yaml:"authorEmail"`
+
+	// CommitMessageTemplate is the commit message. The placeholder {topic} is
+	// replaced with the topic name.
+	CommitMessageTemplate string `yaml:"commitMessageTemplate"`
+
+	// BranchNameTemplate is the name of the branch that is pushed in change mode.
+	// The placeholders {topic} and {timestamp} are replaced with the topic name and
+	// the current time.
+	BranchNameTemplate string `yaml:"branchNameTemplate"`
+}
+
+// Validate the topic documentation editing config.
+func (c *TopicDocumentationEditing) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Mode != TopicDocumentationEditingModeDirect && c.Mode != TopicDocumentationEditingModeChange {
+		return fmt.Errorf("invalid mode %q, must be one of %q or %q",
+			c.Mode, TopicDocumentationEditingModeDirect, TopicDocumentationEditingModeChange)
+	}
+	if c.AuthorName == "" || c.AuthorEmail == "" {
+		return errors.New("author name and email must be set")
+	}
+	if c.CommitMessageTemplate == "" {
+		return errors.New("commit message template must be set")
+	}
+	if c.Mode == TopicDocumentationEditingModeChange && c.BranchNameTemplate == "" {
+		return errors.New("branch name template must be set in change mode")
+	}
+	return nil
+}
+
+// SetDefaults for the topic documentation editing config.
+func (c *TopicDocumentationEditing) SetDefaults() {
+	c.Mode = TopicDocumentationEditingModeDirect
+	c.AuthorName = "Redpanda Console"
+	c.AuthorEmail = "console@redpanda.com"
+	c.CommitMessageTemplate = "Update documentation for topic {topic}"
+	c.BranchNameTemplate = "console/topic-documentation/{topic}-{timestamp}"
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+	"strings"
+	"time"
+)
+
+// TopicDocumentationTopicConfig loads topic documentation from a topic config.
+type TopicDocumentationTopicConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// ConfigKey is the name of the topic config that contains the markdown. The
+	// cluster must accept this config key for topics.
+	ConfigKey string `yaml:"configKey"`
+}
+
+// Validate the topic config documentation source.
+func (c *TopicDocumentationTopicConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.ConfigKey == "" {
+		return errors.New("topic config documentation source is enabled but no config key is set")
+	}
+	return nil
+}
+
+// TopicDocumentationSchemaRegistry loads topic documentation from the doc (Avro)
+// or description (JSON schema) field of the latest value schema of a topic. The
+// value schema is looked up using the topic name strategy (<topic>-value).
+type TopicDocumentationSchemaRegistry struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// TopicDocumentationHTTP loads topic documentation from an HTTP service such as a
+// data catalog. The service is expected to respond with the markdown of the topic
+// or with status 404 if there is no documentation for the topic.
+type TopicDocumentationHTTP struct {
+	Enabled bool `yaml:"enabled"`
+
+	// URL is the URL that is requested for each topic. The placeholder {topic}
+	// is replaced with the URL encoded topic name.
+	URL string `yaml:"url"`
+
+	// Headers are added to every request.
+	Headers map[string]string `yaml:"headers"`
+
+	// BearerToken is sent as Authorization header if set.
+	BearerToken string `yaml:"bearerToken"`
+
+	// Timeout for each request.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// RegisterFlags for sensitive HTTP documentation source configurations.
+func (c *TopicDocumentationHTTP) RegisterFlags(f *flag.FlagSet, prefix string) {
+	f.StringVar(&c.BearerToken, prefix+"http.bearer-token", "", "Bearer token for the topic documentation HTTP source")
+}
+
+// Validate the HTTP documentation source.
+func (c *TopicDocumentationHTTP) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.URL == "" {
+		return errors.New("http documentation source is enabled but no url is set")
+	}
+	if !strings.Contains(c.URL, "{topic}") {
+		return errors.New("http documentation source url must contain the placeholder {topic}")
+	}
+	if c.Timeout <= 0 {
+		return errors.New("http documentation source timeout must be greater than 0")
+	}
+	return nil
+}
+
+// SetDefaults for the HTTP documentation source.
+func (c *TopicDocumentationHTTP) SetDefaults() {
+	c.Timeout = 5 * time.Second
+}
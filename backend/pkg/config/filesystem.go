@@ -36,6 +36,15 @@ type Filesystem struct {
 
 	// SkipHiddenFiles specifies whether or not hidden files should be watched or not
 	SkipHiddenFiles bool `yaml:"skipHiddenFiles"`
+
+	// Watch enables filesystem notifications (e.g. inotify) so that changed files are
+	// reloaded immediately. Periodic reloads based on RefreshInterval remain active as
+	// fallback unless the refresh interval is set to 0.
+	Watch bool `yaml:"watch"`
+
+	// WatchDebounce is the duration to wait for further filesystem events before the
+	// files are reloaded. This avoids multiple reloads when many files change at once.
+	WatchDebounce time.Duration `yaml:"watchDebounce"`
 }
 
 // Validate all root and child config structs
@@ -43,8 +52,11 @@ func (c *Filesystem) Validate() error {
 	if !c.Enabled {
 		return nil
 	}
-	if c.RefreshInterval == 0 {
-		return fmt.Errorf("filesystem provider is enabled but refresh interval is set to 0")
+	if c.RefreshInterval == 0 && !c.Watch {
+		return fmt.Errorf("filesystem provider is enabled but refresh interval is set to 0 and watch is disabled")
+	}
+	if c.Watch && c.WatchDebounce <= 0 {
+		return fmt.Errorf("filesystem watch is enabled but watch debounce must be greater than 0")
 	}
 
 	return nil
@@ -56,4 +68,5 @@ func (c *Filesystem) SetDefaults() {
 	c.IndexByFullFilepath = false
 	c.RefreshInterval = 5 * time.Minute
 	c.SkipHiddenFiles = false
+	c.WatchDebounce = 500 * time.Millisecond
 }
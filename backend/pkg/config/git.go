@@ -64,6 +64,21 @@ func (c *Git) Validate() error {
 	return c.Repository.Validate()
 }
 
+// SetDefaultsForUnset sets the defaults for all options that have not been configured.
+// This is required for repositories that are configured as list entries, because
+// list entries are created while unmarshalling and thus can't be defaulted upfront.
+func (c *Git) SetDefaultsForUnset() {
+	if c.RefreshInterval == 0 {
+		c.RefreshInterval = time.Minute
+	}
+	if c.MaxFileSize == 0 {
+		c.MaxFileSize = 500 * 1000 // 500KB
+	}
+	if c.Repository.BaseDirectory == "" {
+		c.Repository.BaseDirectory = "."
+	}
+}
+
 // SetDefaults for all root and child config structs
 func (c *Git) SetDefaults() {
 	c.Repository.SetDefaults()
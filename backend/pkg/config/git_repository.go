@@ -17,6 +17,9 @@ type GitRepository struct {
 	URL           string `yaml:"url"`
 	Branch        string `yaml:"branch"`
 	BaseDirectory string `yaml:"baseDirectory"`
+
+	// Tag pins the repository to a tag. A repository pinned to a tag is not pulled periodically.
+	Tag string `yaml:"tag"`
 }
 
 // Validate given input for config properties
@@ -24,6 +27,9 @@ func (c *GitRepository) Validate() error {
 	if c.URL == "" {
 		return fmt.Errorf("you must set a repository url")
 	}
+	if c.Branch != "" && c.Tag != "" {
+		return fmt.Errorf("you can only set either a branch or a tag for repository %q", c.URL)
+	}
 
 	return nil
 }
@@ -18,10 +18,20 @@ import (
 type Proto struct {
 	Enabled bool `json:"enabled"`
 
-	// The required proto definitions can be provided via SchemaRegistry, Git or Filesystem
+	// The required proto definitions can be provided via SchemaRegistry, Git, Filesystem or a Buf Schema Registry
 	SchemaRegistry ProtoSchemaRegistry `json:"schemaRegistry"`
 	Git            Git                 `json:"git"`
 	FileSystem     Filesystem          `json:"fileSystem"`
+	BSR            ProtoBSR            `json:"bsr"`
+
+	// GitRepositories are additional Git repositories that contain proto files. Each
+	// repository has its own authentication, reference, base directory and refresh interval.
+	GitRepositories []Git `json:"gitRepositories"`
+
+	// DescriptorSetFileExtensions are the file extensions of pre-compiled FileDescriptorSet
+	// binaries (e.g. created with `buf build -o` or `protoc --descriptor_set_out`). Files
+	// with these extensions are loaded from Git and Filesystem in addition to .proto files.
+	DescriptorSetFileExtensions []string `json:"descriptorSetFileExtensions"`
 
 	// Mappings define what proto types shall be used for each Kafka topic. If SchemaRegistry is used, no mappings are required.
 	Mappings []ProtoTopicMapping `json:"mappings"`
@@ -35,6 +45,7 @@ type Proto struct {
 // RegisterFlags registers all nested config flags.
 func (c *Proto) RegisterFlags(f *flag.FlagSet) {
 	c.Git.RegisterFlagsWithPrefix(f, "kafka.protobuf.")
+	c.BSR.RegisterFlagsWithPrefix(f, "kafka.protobuf.")
 }
 
 // Validate the Proto configuration options.
@@ -43,10 +54,20 @@ func (c *Proto) Validate() error {
 		return nil
 	}
 
-	if !c.Git.Enabled && !c.FileSystem.Enabled && !c.SchemaRegistry.Enabled {
+	if !c.Git.Enabled && len(c.GitRepositories) == 0 && !c.FileSystem.Enabled && !c.SchemaRegistry.Enabled && !c.BSR.Enabled {
 		return fmt.Errorf("protobuf deserializer is enabled, at least one source provider for proto files must be configured")
 	}
 
+	for i, repo := range c.GitSources() {
+		if err := repo.Validate(); err != nil {
+			return fmt.Errorf("failed to validate git repository at index %d: %w", i, err)
+		}
+	}
+
+	if err := c.BSR.Validate(); err != nil {
+		return fmt.Errorf("failed to validate bsr config: %w", err)
+	}
+
 	if len(c.Mappings) == 0 && !c.SchemaRegistry.Enabled {
 		return fmt.Errorf("protobuf deserializer is enabled, but no topic mappings have been configured")
 	}
@@ -59,6 +80,8 @@ func (c *Proto) SetDefaults() {
 	c.Git.SetDefaults()
 	c.FileSystem.SetDefaults()
 	c.SchemaRegistry.SetDefaults()
+	c.BSR.SetDefaults()
+	c.DescriptorSetFileExtensions = []string{"binpb", "desc"}
 
 	// Index by full filepath so that we support .proto files with the same filename in different directories
 	c.Git.IndexByFullFilepath = true
@@ -66,3 +89,21 @@ func (c *Proto) SetDefaults() {
 	c.FileSystem.IndexByFullFilepath = true
 	c.FileSystem.AllowedFileExtensions = []string{"proto"}
 }
+
+// GitSources returns the configurations of all Git repositories that shall be used
+// as source for proto files. This includes the repository configured via Git, if enabled,
+// followed by all GitRepositories.
+func (c *Proto) GitSources() []Git {
+	sources := make([]Git, 0, len(c.GitRepositories)+1)
+	if c.Git.Enabled {
+		sources = append(sources, c.Git)
+	}
+	for _, repo := range c.GitRepositories {
+		repo.Enabled = true
+		repo.SetDefaultsForUnset()
+		repo.AllowedFileExtensions = c.Git.AllowedFileExtensions
+		repo.IndexByFullFilepath = c.Git.IndexByFullFilepath
+		sources = append(sources, repo)
+	}
+	return sources
+}
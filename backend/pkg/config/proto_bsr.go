@@ -0,0 +1,86 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ProtoBSR configures a Buf Schema Registry (BSR) compatible HTTP API as source
+// for Protobuf types. The descriptors of the configured modules are downloaded via
+// the Buf reflection API and merged into the local proto registry.
+type ProtoBSR struct {
+	Enabled bool `yaml:"enabled"`
+
+	// URL is the base URL of the BSR-compatible API, e.g. https://buf.build.
+	URL string `yaml:"url"`
+
+	// Token is used as bearer token for authenticating against the BSR.
+	Token string `yaml:"token"`
+
+	// Modules that shall be downloaded from the registry.
+	Modules []ProtoBSRModule `yaml:"modules"`
+
+	// RefreshInterval specifies how often the modules shall be downloaded again.
+	// Set 0 to disable periodic refreshes.
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+
+	// Timeout for each download request.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// ProtoBSRModule is a single module in a Buf Schema Registry.
+type ProtoBSRModule struct {
+	// Name is the full module name including the remote, e.g. buf.build/acme/payments.
+	Name string `yaml:"name"`
+
+	// Version pins the module to a label, tag or commit. The latest version
+	// of the default label is used if not set.
+	Version string `yaml:"version"`
+}
+
+// RegisterFlagsWithPrefix registers the flags for sensitive BSR configs.
+func (c *ProtoBSR) RegisterFlagsWithPrefix(f *flag.FlagSet, prefix string) {
+	f.StringVar(&c.Token, prefix+"bsr.token", "", "Token for authenticating against the Buf Schema Registry")
+}
+
+// SetDefaults for the BSR configuration.
+func (c *ProtoBSR) SetDefaults() {
+	c.URL = "https://buf.build"
+	c.RefreshInterval = 5 * time.Minute
+	c.Timeout = 30 * time.Second
+}
+
+// Validate the BSR configuration.
+func (c *ProtoBSR) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.URL == "" {
+		return errors.New("bsr is enabled but no url is configured")
+	}
+	if len(c.Modules) == 0 {
+		return errors.New("bsr is enabled but no modules are configured")
+	}
+	for i, module := range c.Modules {
+		if strings.Count(module.Name, "/") != 2 {
+			return fmt.Errorf("bsr module at index %d has an invalid name %q, expected <remote>/<owner>/<module>", i, module.Name)
+		}
+	}
+	if c.Timeout <= 0 {
+		return errors.New("bsr timeout must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/discovery"
+)
+
+// WorkerHealth is the health of a single discovered Kafka connect worker.
+type WorkerHealth struct {
+	URL     string `json:"url"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// CheckWorkers sends a request to each discovered worker of the Kafka connect
+// cluster concurrently and returns the health of all workers. It returns nil if
+// workers are not discovered for the cluster.
+func (s *Service) CheckWorkers(ctx context.Context, clusterName string) ([]WorkerHealth, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if c.Workers == nil {
+		return nil, nil
+	}
+
+	workers := c.Workers.Workers()
+	health := make([]WorkerHealth, len(workers))
+	wg := sync.WaitGroup{}
+	for i, worker := range workers {
+		wg.Add(1)
+		go func(i int, workerURL string) {
+			defer wg.Done()
+			_, err := c.Client.GetRoot(discovery.WithWorker(ctx, workerURL))
+			c.Workers.SetHealth(workerURL, err)
+			health[i] = WorkerHealth{URL: workerURL, Healthy: err == nil}
+			if err != nil {
+				health[i].Error = err.Error()
+			}
+		}(i, worker.URL)
+	}
+	wg.Wait()
+
+	return health, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// ConnectorConfigDiff is the difference between two versions of a connector config.
+type ConnectorConfigDiff struct {
+	// FromVersion is 0 if the diff shows the change that has been made by ToVersion.
+	FromVersion int            `json:"fromVersion"`
+	ToVersion   int            `json:"toVersion"`
+	Changes     []ConfigChange `json:"changes"`
+}
+
+// ListConnectorHistory returns all recorded config changes of a connector ordered by version.
+func (s *Service) ListConnectorHistory(ctx context.Context, clusterName string, connectorName string) ([]HistoryEntry, *rest.Error) {
+	if _, restErr := s.getConnectClusterByName(clusterName); restErr != nil {
+		return nil, restErr
+	}
+	if restErr := s.checkHistoryEnabled(); restErr != nil {
+		return nil, restErr
+	}
+
+	entries, err := s.History.List(ctx, clusterName, connectorName)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:          fmt.Errorf("failed to list connector history: %w", err),
+			Status:       http.StatusServiceUnavailable,
+			Message:      fmt.Sprintf("Failed to list connector history: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName)},
+			IsSilent:     false,
+		}
+	}
+	if entries == nil {
+		entries = make([]HistoryEntry, 0)
+	}
+	return entries, nil
+}
+
+// DiffConnectorHistory compares the configs of two recorded versions of a connector.
+// If fromVersion is 0, the diff shows the change that has been made by toVersion.
+func (s *Service) DiffConnectorHistory(ctx context.Context, clusterName string, connectorName string, fromVersion int, toVersion int) (ConnectorConfigDiff, *rest.Error) {
+	entries, restErr := s.ListConnectorHistory(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return ConnectorConfigDiff{}, restErr
+	}
+
+	to, restErr := findHistoryEntry(entries, clusterName, connectorName, toVersion)
+	if restErr != nil {
+		return ConnectorConfigDiff{}, restErr
+	}
+	before := to.ConfigBefore
+	if fromVersion != 0 {
+		from, restErr := findHistoryEntry(entries, clusterName, connectorName, fromVersion)
+		if restErr != nil {
+			return ConnectorConfigDiff{}, restErr
+		}
+		before = from.ConfigAfter
+	}
+
+	return ConnectorConfigDiff{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Changes:     DiffConnectorConfigs(before, to.ConfigAfter),
+	}, nil
+}
+
+// RollbackConnector applies the config of a recorded version again. If the connector
+// has been deleted in the meantime, it will be created. Values that have been
+// redacted in the history are taken from secretValues or, if not provided there,
+// from the current connector config.
+func (s *Service) RollbackConnector(ctx context.Context, clusterName string, connectorName string, version int, secretValues map[string]string) (con.ConnectorInfo, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+	entries, restErr := s.ListConnectorHistory(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+	entry, restErr := findHistoryEntry(entries, clusterName, connectorName, version)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+	if entry.ConfigAfter == nil {
+		return con.ConnectorInfo{}, &rest.Error{
+			Err:      fmt.Errorf("history version %d has no connector config", version),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Version %d deleted the connector, there is no config to roll back to", version),
+			IsSilent: false,
+		}
+	}
+
+	currentConfig, exists, err := getRawConnectorConfig(ctx, c, connectorName)
+	if err != nil {
+		return con.ConnectorInfo{}, &rest.Error{
+			Err:          fmt.Errorf("failed to get current connector config: %w", err),
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get current connector config: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName)},
+			IsSilent:     false,
+		}
+	}
+
+	cfg := make(map[string]any, len(entry.ConfigAfter))
+	missingSecrets := make([]string, 0)
+	for key, value := range entry.ConfigAfter {
+		if value == RedactedValue {
+			if secret, ok := secretValues[key]; ok {
+				value = secret
+			} else if current, ok := currentConfig[key]; ok {
+				value = current
+			} else {
+				missingSecrets = append(missingSecrets, key)
+			}
+		}
+		cfg[key] = value
+	}
+	if len(missingSecrets) > 0 {
+		sort.Strings(missingSecrets)
+		return con.ConnectorInfo{}, &rest.Error{
+			Err:      fmt.Errorf("missing values for redacted config keys: %v", missingSecrets),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("The values of the config keys %s have been redacted in the history and must be provided", strings.Join(missingSecrets, ", ")),
+			IsSilent: false,
+		}
+	}
+
+	var cInfo con.ConnectorInfo
+	if exists {
+		cInfo, err = c.Client.PutConnectorConfig(ctx, connectorName, con.PutConnectorConfigOptions{Config: cfg})
+	} else {
+		cInfo, err = c.Client.CreateConnector(ctx, con.CreateConnectorRequest{Name: connectorName, Config: cfg})
+	}
+	if err != nil {
+		return con.ConnectorInfo{}, &rest.Error{
+			Err:          fmt.Errorf("failed to roll back connector: %w", err),
+			Status:       GetStatusCodeFromAPIError(err, http.StatusInternalServerError),
+			Message:      fmt.Sprintf("Failed to roll back connector: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName), zap.Int("version", version)},
+			IsSilent:     false,
+		}
+	}
+
+	s.recordHistory(ctx, HistoryEntry{
+		ClusterName:         clusterName,
+		ConnectorName:       connectorName,
+		Operation:           HistoryOperationRollback,
+		ConfigBefore:        currentConfig,
+		ConfigAfter:         cInfo.Config,
+		RolledBackToVersion: version,
+	})
+
+	connectorClass := getMapValueOrString(cInfo.Config, "connector.class", "unknown")
+	return con.ConnectorInfo{
+		Name:   cInfo.Name,
+		Config: s.Interceptor.KafkaConnectToConsole(connectorClass, cInfo.Config),
+		Tasks:  cInfo.Tasks,
+		Type:   cInfo.Type,
+	}, nil
+}
+
+func (s *Service) checkHistoryEnabled() *rest.Error {
+	if s.History != nil {
+		return nil
+	}
+	return &rest.Error{
+		Err:      errors.New("connector config history is not enabled"),
+		Status:   http.StatusBadRequest,
+		Message:  "Connector config history is not enabled",
+		IsSilent: false,
+	}
+}
+
+// configBeforeChange returns the current config of a connector, so that it can be
+// recorded in the history. It returns false if the connector does not exist.
+func (s *Service) configBeforeChange(ctx context.Context, c *ClientWithConfig, connectorName string) (map[string]string, bool) {
+	if s.History == nil {
+		return nil, true
+	}
+	cfg, exists, err := getRawConnectorConfig(ctx, c, connectorName)
+	if err != nil {
+		s.Logger.Warn("failed to get connector config before change, history entry will be recorded without it",
+			zap.String("cluster_name", c.Cfg.Name),
+			zap.String("connector", connectorName),
+			zap.Error(err))
+		return nil, true
+	}
+	return cfg, exists
+}
+
+// getRawConnectorConfig returns the connector config as stored in Kafka connect.
+// It returns false if the connector does not exist.
+func getRawConnectorConfig(ctx context.Context, c *ClientWithConfig, connectorName string) (map[string]string, bool, error) {
+	cfg, err := c.Client.GetConnectorConfig(ctx, connectorName)
+	if err != nil {
+		if GetStatusCodeFromAPIError(err, 0) == http.StatusNotFound {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return cfg, true, nil
+}
+
+func findHistoryEntry(entries []HistoryEntry, clusterName string, connectorName string, version int) (HistoryEntry, *rest.Error) {
+	for _, entry := range entries {
+		if entry.Version == version {
+			return entry, nil
+		}
+	}
+	return HistoryEntry{}, &rest.Error{
+		Err:          fmt.Errorf("history version %d does not exist", version),
+		Status:       http.StatusNotFound,
+		Message:      fmt.Sprintf("Version %d does not exist in the history of this connector", version),
+		InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName)},
+		IsSilent:     false,
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+type memoryHistoryStore struct {
+	mu      sync.Mutex
+	entries []HistoryEntry
+}
+
+func (m *memoryHistoryStore) Append(_ context.Context, entry *HistoryEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry.Version = 1
+	for _, e := range m.entries {
+		if e.ClusterName == entry.ClusterName && e.ConnectorName == entry.ConnectorName {
+			entry.Version = e.Version + 1
+		}
+	}
+	m.entries = append(m.entries, *entry)
+	return nil
+}
+
+func (m *memoryHistoryStore) List(_ context.Context, clusterName string, connectorName string) ([]HistoryEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var entries []HistoryEntry
+	for _, e := range m.entries {
+		if e.ClusterName == clusterName && e.ConnectorName == connectorName {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// newFakeConnectCluster serves the connector config endpoints of the Kafka connect REST API.
+func newFakeConnectCluster(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	configs := make(map[string]map[string]string)
+
+	writeJSON := func(w http.ResponseWriter, status int, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	notFound := func(w http.ResponseWriter) {
+		writeJSON(w, http.StatusNotFound, con.ApiError{ErrorCode: http.StatusNotFound, Message: "connector not found"})
+	}
+	toStringMap := func(in map[string]any) map[string]string {
+		out := make(map[string]string, len(in))
+		for k, v := range in {
+			out[k], _ = v.(string)
+		}
+		return out
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /connectors", func(w http.ResponseWriter, r *http.Request) {
+		var req con.CreateConnectorRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		mu.Lock()
+		defer mu.Unlock()
+		configs[req.Name] = toStringMap(req.Config)
+		writeJSON(w, http.StatusCreated, con.ConnectorInfo{Name: req.Name, Config: configs[req.Name]})
+	})
+	mux.HandleFunc("GET /connectors/{connector}/config", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		cfg, ok := configs[r.PathValue("connector")]
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, cfg)
+	})
+	mux.HandleFunc("PUT /connectors/{connector}/config", func(w http.ResponseWriter, r *http.Request) {
+		var cfg map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&cfg))
+		mu.Lock()
+		defer mu.Unlock()
+		name := r.PathValue("connector")
+		configs[name] = toStringMap(cfg)
+		writeJSON(w, http.StatusOK, con.ConnectorInfo{Name: name, Config: configs[name]})
+	})
+	mux.HandleFunc("DELETE /connectors/{connector}", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		name := r.PathValue("connector")
+		if _, ok := configs[name]; !ok {
+			notFound(w)
+			return
+		}
+		delete(configs, name)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newHistoryTestService(t *testing.T) (*Service, *memoryHistoryStore) {
+	t.Helper()
+
+	srv := newFakeConnectCluster(t)
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.History.Enabled = true
+	cfg.Clusters = []config.ConnectCluster{{Name: "local", URL: srv.URL}}
+	cfg.ConnectTimeout = time.Second
+
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	store := &memoryHistoryStore{}
+	svc.History = store
+	return svc, store
+}
+
+func TestConnectorHistory(t *testing.T) {
+	svc, _ := newHistoryTestService(t)
+	ctx := ContextWithActor(context.Background(), "alice")
+
+	connectorCfg := func(tableList string, password string) map[string]any {
+		return map[string]any{
+			"connector.class":    "io.debezium.connector.postgresql.PostgresConnector",
+			"table.include.list": tableList,
+			"database.password":  password,
+			"database.user":      "${secretsManager:debezium:user}",
+		}
+	}
+
+	_, restErr := svc.PutConnectorConfig(ctx, "local", "orders-cdc", con.PutConnectorConfigOptions{Config: connectorCfg("public.orders", "v1-secret")})
+	require.Nil(t, restErr)
+	_, restErr = svc.PutConnectorConfig(ctx, "local", "orders-cdc", con.PutConnectorConfigOptions{Config: connectorCfg("public.orders,public.items", "v2-secret")})
+	require.Nil(t, restErr)
+	restErr = svc.DeleteConnector(ctx, "local", "orders-cdc")
+	require.Nil(t, restErr)
+
+	entries, restErr := svc.ListConnectorHistory(ctx, "local", "orders-cdc")
+	require.Nil(t, restErr)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, HistoryOperationCreate, entries[0].Operation)
+	assert.Nil(t, entries[0].ConfigBefore)
+	assert.Equal(t, "alice", entries[0].Actor)
+	assert.Equal(t, "local", entries[0].ClusterName)
+	assert.Equal(t, RedactedValue, entries[0].ConfigAfter["database.password"])
+	assert.Equal(t, "${secretsManager:debezium:user}", entries[0].ConfigAfter["database.user"])
+
+	assert.Equal(t, HistoryOperationUpdate, entries[1].Operation)
+	assert.Equal(t, "public.orders", entries[1].ConfigBefore["table.include.list"])
+	assert.Equal(t, "public.orders,public.items", entries[1].ConfigAfter["table.include.list"])
+
+	assert.Equal(t, HistoryOperationDelete, entries[2].Operation)
+	assert.Nil(t, entries[2].ConfigAfter)
+	for _, entry := range entries {
+		data, err := json.Marshal(entry)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "v1-secret")
+		assert.NotContains(t, string(data), "v2-secret")
+	}
+
+	t.Run("diff", func(t *testing.T) {
+		diff, restErr := svc.DiffConnectorHistory(ctx, "local", "orders-cdc", 0, 2)
+		require.Nil(t, restErr)
+		require.Len(t, diff.Changes, 1)
+		assert.Equal(t, "table.include.list", diff.Changes[0].Key)
+		assert.Equal(t, ConfigChangeTypeChanged, diff.Changes[0].Type)
+
+		diff, restErr = svc.DiffConnectorHistory(ctx, "local", "orders-cdc", 1, 3)
+		require.Nil(t, restErr)
+		assert.NotEmpty(t, diff.Changes)
+		for _, change := range diff.Changes {
+			assert.Equal(t, ConfigChangeTypeRemoved, change.Type)
+		}
+
+		_, restErr = svc.DiffConnectorHistory(ctx, "local", "orders-cdc", 0, 42)
+		require.NotNil(t, restErr)
+		assert.Equal(t, http.StatusNotFound, restErr.Status)
+	})
+
+	t.Run("rollback of deleted connector requires redacted values", func(t *testing.T) {
+		_, restErr := svc.RollbackConnector(ctx, "local", "orders-cdc", 1, nil)
+		require.NotNil(t, restErr)
+		assert.Equal(t, http.StatusBadRequest, restErr.Status)
+		assert.Contains(t, restErr.Message, "database.password")
+
+		_, restErr = svc.RollbackConnector(ctx, "local", "orders-cdc", 3, nil)
+		require.NotNil(t, restErr)
+		assert.Equal(t, http.StatusBadRequest, restErr.Status)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		_, restErr := svc.RollbackConnector(ctx, "local", "orders-cdc", 1, map[string]string{"database.password": "v1-secret"})
+		require.Nil(t, restErr)
+
+		cfg, restErr := svc.GetConnectorConfig(ctx, "local", "orders-cdc")
+		require.Nil(t, restErr)
+		assert.Equal(t, "public.orders", cfg["table.include.list"])
+		assert.Equal(t, "v1-secret", cfg["database.password"])
+
+		// Redacted values are taken from the current config if not provided.
+		_, restErr = svc.RollbackConnector(ctx, "local", "orders-cdc", 2, nil)
+		require.Nil(t, restErr)
+		cfg, restErr = svc.GetConnectorConfig(ctx, "local", "orders-cdc")
+		require.Nil(t, restErr)
+		assert.Equal(t, "public.orders,public.items", cfg["table.include.list"])
+		assert.Equal(t, "v1-secret", cfg["database.password"])
+
+		entries, restErr := svc.ListConnectorHistory(ctx, "local", "orders-cdc")
+		require.Nil(t, restErr)
+		require.Len(t, entries, 5)
+		assert.Equal(t, HistoryOperationRollback, entries[3].Operation)
+		assert.Nil(t, entries[3].ConfigBefore)
+		assert.Equal(t, 1, entries[3].RolledBackToVersion)
+		assert.Equal(t, HistoryOperationRollback, entries[4].Operation)
+		assert.Equal(t, 2, entries[4].RolledBackToVersion)
+	})
+}
+
+func TestConnectorHistoryNotEnabled(t *testing.T) {
+	svc, _ := newHistoryTestService(t)
+	svc.History = nil
+
+	_, restErr := svc.PutConnectorConfig(context.Background(), "local", "orders-cdc", con.PutConnectorConfigOptions{
+		Config: map[string]any{"connector.class": "io.debezium.connector.postgresql.PostgresConnector"},
+	})
+	require.Nil(t, restErr)
+
+	_, restErr = svc.ListConnectorHistory(context.Background(), "local", "orders-cdc")
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusBadRequest, restErr.Status)
+}
+
+func TestDiffConnectorConfigs(t *testing.T) {
+	before := map[string]string{"a": "1", "b": "2", "c": "3"}
+	after := map[string]string{"a": "1", "b": "20", "d": "4"}
+
+	changes := DiffConnectorConfigs(before, after)
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, "b", changes[0].Key)
+	assert.Equal(t, ConfigChangeTypeChanged, changes[0].Type)
+	assert.Equal(t, "2", *changes[0].OldValue)
+	assert.Equal(t, "20", *changes[0].NewValue)
+
+	assert.Equal(t, "c", changes[1].Key)
+	assert.Equal(t, ConfigChangeTypeRemoved, changes[1].Type)
+	assert.Nil(t, changes[1].NewValue)
+
+	assert.Equal(t, "d", changes[2].Key)
+	assert.Equal(t, ConfigChangeTypeAdded, changes[2].Type)
+	assert.Nil(t, changes[2].OldValue)
+
+	assert.Empty(t, DiffConnectorConfigs(nil, nil))
+}
+
+func TestActorFromLogFields(t *testing.T) {
+	actor := ActorFromLogFields([]zapcore.Field{zap.String("user", "jane"), zap.String("auth_method", "oidc")})
+	assert.Equal(t, "auth_method=oidc user=jane", actor)
+
+	ctx := ContextWithActor(context.Background(), ActorFromLogFields(nil))
+	assert.Equal(t, unknownActor, ActorFromContext(ctx))
+}
+
+func TestKafkaHistoryStoreIndex(t *testing.T) {
+	store := &KafkaHistoryStore{
+		entriesByConnector: make(map[string][]HistoryEntry),
+		versionsByRecord:   make(map[historyRecordPosition]int),
+	}
+	// Versions written by different instances may collide, the index derives
+	// them from the record order instead.
+	for offset, version := range []int{1, 1, 2} {
+		store.index(historyRecordPosition{Offset: int64(offset)},
+			HistoryEntry{ClusterName: "local", ConnectorName: "orders-cdc", Version: version, Actor: strconv.Itoa(offset)})
+	}
+	store.index(historyRecordPosition{Offset: 1}, HistoryEntry{ClusterName: "local", ConnectorName: "orders-cdc", Version: 1})
+	store.index(historyRecordPosition{Partition: 1}, HistoryEntry{ClusterName: "local", ConnectorName: "other", Version: 1})
+
+	entries, err := store.List(context.Background(), "local", "orders-cdc")
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		assert.Equal(t, i+1, entry.Version)
+		assert.Equal(t, strconv.Itoa(i), entry.Actor)
+	}
+}
+
+func TestPartitionHistoryRecord(t *testing.T) {
+	partitionOf := func(connectorName string, id string) int {
+		key, err := json.Marshal(historyRecordKey{ClusterName: "local", ConnectorName: connectorName, ID: id})
+		require.NoError(t, err)
+		return partitionHistoryRecord(&kgo.Record{Key: key}, 16)
+	}
+
+	partition := partitionOf("orders-cdc", "a")
+	for _, id := range []string{"b", "c", "d"} {
+		assert.Equal(t, partition, partitionOf("orders-cdc", id))
+	}
+}
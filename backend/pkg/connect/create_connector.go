@@ -37,8 +37,14 @@ func (s *Service) CreateConnector(ctx context.Context, clusterName string, req c
 		}
 	}
 	req.Config = s.Interceptor.ConsoleToKafkaConnect(className, req.Config)
+	var secretWrites *sensitiveConfigWrites
+	req.Config, secretWrites, restErr = s.storeSensitiveConfigs(ctx, c, req.Name, className, req.Config)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
 
 	cInfo, err := c.Client.CreateConnector(ctx, req)
+	appliedConfig := cInfo.Config
 	connectorClass := getMapValueOrString(cInfo.Config, "connector.class", "unknown")
 	cInfo = con.ConnectorInfo{
 		Name:   cInfo.Name,
@@ -48,6 +54,7 @@ func (s *Service) CreateConnector(ctx context.Context, clusterName string, req c
 	}
 
 	if err != nil {
+		s.rollbackSensitiveConfigs(ctx, secretWrites)
 		return con.ConnectorInfo{}, &rest.Error{
 			Err:          fmt.Errorf("failed to create connector: %w", err),
 			Status:       GetStatusCodeFromAPIError(err, http.StatusInternalServerError),
@@ -57,5 +64,16 @@ func (s *Service) CreateConnector(ctx context.Context, clusterName string, req c
 		}
 	}
 
+	s.recordHistory(ctx, HistoryEntry{
+		ClusterName:   clusterName,
+		ConnectorName: cInfo.Name,
+		Operation:     HistoryOperationCreate,
+		ConfigAfter:   appliedConfig,
+	})
+
+	if restErr = s.commitSensitiveConfigs(ctx, secretWrites); restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+
 	return cInfo, nil
 }
@@ -27,6 +27,7 @@ func (s *Service) DeleteConnector(ctx context.Context, clusterName string, conne
 		return restErr
 	}
 
+	configBefore, _ := s.configBeforeChange(ctx, c, connector)
 	err := c.Client.DeleteConnector(ctx, connector)
 	if err != nil {
 		return &rest.Error{
@@ -37,6 +38,14 @@ func (s *Service) DeleteConnector(ctx context.Context, clusterName string, conne
 			IsSilent:     false,
 		}
 	}
+	s.deleteSensitiveConfigs(ctx, clusterName, connector)
+
+	s.recordHistory(ctx, HistoryEntry{
+		ClusterName:   clusterName,
+		ConnectorName: connector,
+		Operation:     HistoryOperationDelete,
+		ConfigBefore:  configBefore,
+	})
 
 	return nil
 }
@@ -0,0 +1,82 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package discovery discovers the workers of a Kafka connect cluster and
+// provides an HTTP transport that sends requests to a reachable worker.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// Discoverer returns the base URLs of all workers of a Kafka connect cluster.
+type Discoverer interface {
+	Discover(ctx context.Context) ([]string, error)
+}
+
+// NewDiscoverer creates the Discoverer for the given discovery config. The
+// scheme is used for all discovered workers.
+func NewDiscoverer(cfg config.ConnectClusterDiscovery, scheme string) (Discoverer, error) {
+	switch cfg.Type {
+	case config.ConnectClusterDiscoveryTypeDNSSRV:
+		return NewDNSSRVDiscoverer(cfg.DNSSRV, scheme), nil
+	case config.ConnectClusterDiscoveryTypeKubernetes:
+		return NewKubernetesDiscoverer(cfg.Kubernetes, scheme)
+	default:
+		return nil, fmt.Errorf("unknown discovery type %q", cfg.Type)
+	}
+}
+
+// DNSSRVDiscoverer discovers workers by looking up DNS SRV records.
+type DNSSRVDiscoverer struct {
+	cfg    config.ConnectClusterDiscoveryDNSSRV
+	scheme string
+
+	// lookupSRV can be replaced in tests.
+	lookupSRV func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// NewDNSSRVDiscoverer creates a new DNSSRVDiscoverer.
+func NewDNSSRVDiscoverer(cfg config.ConnectClusterDiscoveryDNSSRV, scheme string) *DNSSRVDiscoverer {
+	return &DNSSRVDiscoverer{
+		cfg:       cfg,
+		scheme:    scheme,
+		lookupSRV: net.DefaultResolver.LookupSRV,
+	}
+}
+
+// Discover implements Discoverer.Discover. Workers are ordered by the priority
+// and weight of their SRV records.
+func (d *DNSSRVDiscoverer) Discover(ctx context.Context) ([]string, error) {
+	_, records, err := d.lookupSRV(ctx, d.cfg.Service, d.cfg.Proto, d.cfg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup srv records: %w", err)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Priority != records[j].Priority {
+			return records[i].Priority < records[j].Priority
+		}
+		return records[i].Weight > records[j].Weight
+	})
+
+	urls := make([]string, len(records))
+	for i, record := range records {
+		host := strings.TrimSuffix(record.Target, ".")
+		urls[i] = d.scheme + "://" + net.JoinHostPort(host, strconv.Itoa(int(record.Port)))
+	}
+	return urls, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package discovery
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+const (
+	defaultKubernetesAPIServerURL  = "https://kubernetes.default.svc"
+	defaultKubernetesTokenFilepath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaultKubernetesCAFilepath    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	kubernetesNamespaceFilepath    = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// KubernetesDiscoverer discovers workers via the ready endpoints of a Kubernetes
+// service.
+type KubernetesDiscoverer struct {
+	cfg           config.ConnectClusterDiscoveryKubernetes
+	scheme        string
+	baseURL       string
+	namespace     string
+	tokenFilepath string
+	httpClient    *http.Client
+}
+
+type kubernetesEndpoints struct {
+	Subsets []struct {
+		Addresses []struct {
+			IP string `json:"ip"`
+		} `json:"addresses"`
+		Ports []struct {
+			Name string `json:"name"`
+			Port int    `json:"port"`
+		} `json:"ports"`
+	} `json:"subsets"`
+}
+
+// NewKubernetesDiscoverer creates a new KubernetesDiscoverer. Unset options
+// default to the service account that is mounted into the pod.
+func NewKubernetesDiscoverer(cfg config.ConnectClusterDiscoveryKubernetes, scheme string) (*KubernetesDiscoverer, error) {
+	apiServerURL := valueOrDefault(cfg.APIServerURL, defaultKubernetesAPIServerURL)
+	tokenFilepath := valueOrDefault(cfg.TokenFilepath, defaultKubernetesTokenFilepath)
+	caFilepath := valueOrDefault(cfg.CAFilepath, defaultKubernetesCAFilepath)
+
+	namespace := cfg.Namespace
+	if namespace == "" {
+		ns, err := os.ReadFile(kubernetesNamespaceFilepath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read namespace of service account: %w", err)
+		}
+		namespace = strings.TrimSpace(string(ns))
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if strings.HasPrefix(apiServerURL, "https://") {
+		ca, err := os.ReadFile(caFilepath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kubernetes ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse kubernetes ca file %q", caFilepath)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	return &KubernetesDiscoverer{
+		cfg:           cfg,
+		scheme:        scheme,
+		baseURL:       strings.TrimSuffix(apiServerURL, "/"),
+		namespace:     namespace,
+		tokenFilepath: tokenFilepath,
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsCfg},
+		},
+	}, nil
+}
+
+// Discover implements Discoverer.Discover.
+func (d *KubernetesDiscoverer) Discover(ctx context.Context) ([]string, error) {
+	u := fmt.Sprintf("%s/api/v1/namespaces/%s/endpoints/%s", d.baseURL, url.PathEscape(d.namespace), url.PathEscape(d.cfg.Service))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	// The token is read for every request, because projected service account
+	// tokens are rotated by the kubelet.
+	if token, err := os.ReadFile(d.tokenFilepath); err == nil {
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read kubernetes token: %w", err)
+	}
+
+	res, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get endpoints: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read endpoints: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get endpoints of service %q: status code %d: %s", d.cfg.Service, res.StatusCode, string(body))
+	}
+
+	var endpoints kubernetesEndpoints
+	if err := json.Unmarshal(body, &endpoints); err != nil {
+		return nil, fmt.Errorf("failed to decode endpoints: %w", err)
+	}
+
+	var urls []string
+	for _, subset := range endpoints.Subsets {
+		port := 0
+		for _, p := range subset.Ports {
+			if d.cfg.PortName == "" || p.Name == d.cfg.PortName {
+				port = p.Port
+				break
+			}
+		}
+		if port == 0 {
+			continue
+		}
+		for _, address := range subset.Addresses {
+			urls = append(urls, d.scheme+"://"+net.JoinHostPort(address.IP, strconv.Itoa(port)))
+		}
+	}
+	return urls, nil
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,250 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultRefreshInterval is the interval in which workers are discovered if no
+// interval is configured.
+const DefaultRefreshInterval = 30 * time.Second
+
+// Worker is the last known state of a discovered Kafka connect worker.
+type Worker struct {
+	URL     string
+	Healthy bool
+	// Error is the error of the last failed request to the worker.
+	Error         string
+	LastCheckedAt time.Time
+}
+
+type workerKey struct{}
+
+// WithWorker returns a context that pins all requests that are sent through a
+// Pool to the worker with the given URL. Pinned requests do not fail over.
+func WithWorker(ctx context.Context, workerURL string) context.Context {
+	return context.WithValue(ctx, workerKey{}, workerURL)
+}
+
+// Pool holds the discovered workers of a Kafka connect cluster. It implements
+// http.RoundTripper and sends each request to a reachable worker. The pool
+// sticks to the last worker that responded and fails over to the next worker if
+// a request fails with a transport error. Workers that failed are only tried
+// after all healthy workers.
+type Pool struct {
+	logger          *zap.Logger
+	discoverer      Discoverer
+	base            http.RoundTripper
+	refreshInterval time.Duration
+
+	mu        sync.RWMutex
+	workers   []*Worker
+	preferred string
+}
+
+var _ http.RoundTripper = (*Pool)(nil)
+
+// NewPool creates a new Pool that sends requests via the base transport.
+func NewPool(discoverer Discoverer, base http.RoundTripper, refreshInterval time.Duration, logger *zap.Logger) *Pool {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	return &Pool{
+		logger:          logger,
+		discoverer:      discoverer,
+		base:            base,
+		refreshInterval: refreshInterval,
+	}
+}
+
+// Start refreshes the workers periodically until the context is cancelled.
+func (p *Pool) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(p.refreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := p.Refresh(ctx); err != nil {
+				p.logger.Warn("failed to discover Kafka connect workers, keeping previously discovered workers", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// Refresh discovers the workers. The state of workers that have been
+// discovered before is retained. The workers are not changed if the discovery
+// fails or does not return any worker.
+func (p *Pool) Refresh(ctx context.Context) error {
+	urls, err := p.discoverer.Discover(ctx)
+	if err != nil {
+		return err
+	}
+	if len(urls) == 0 {
+		return errors.New("no Kafka connect workers discovered")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	existing := make(map[string]*Worker, len(p.workers))
+	for _, w := range p.workers {
+		existing[w.URL] = w
+	}
+	workers := make([]*Worker, 0, len(urls))
+	for _, u := range urls {
+		if w, ok := existing[u]; ok {
+			workers = append(workers, w)
+			continue
+		}
+		// Workers are considered healthy until a request to them fails.
+		workers = append(workers, &Worker{URL: u, Healthy: true})
+	}
+	p.workers = workers
+	return nil
+}
+
+// Workers returns a copy of the current state of all workers.
+func (p *Pool) Workers() []Worker {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	workers := make([]Worker, len(p.workers))
+	for i, w := range p.workers {
+		workers[i] = *w
+	}
+	return workers
+}
+
+// Preferred returns the URL of the worker that requests are sent to first.
+func (p *Pool) Preferred() string {
+	candidates := p.candidates()
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[0]
+}
+
+// SetHealth records the result of a request to the given worker.
+func (p *Pool) SetHealth(workerURL string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, w := range p.workers {
+		if w.URL != workerURL {
+			continue
+		}
+		w.LastCheckedAt = time.Now()
+		w.Healthy = err == nil
+		w.Error = ""
+		if err != nil {
+			w.Error = err.Error()
+		}
+		return
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (p *Pool) RoundTrip(req *http.Request) (*http.Response, error) {
+	if pinned, ok := req.Context().Value(workerKey{}).(string); ok {
+		res, err := p.roundTripWorker(req, pinned, false)
+		p.SetHealth(pinned, err)
+		return res, err
+	}
+
+	candidates := p.candidates()
+	if len(candidates) == 0 {
+		return nil, errors.New("no Kafka connect workers discovered")
+	}
+
+	var lastErr error
+	for i, workerURL := range candidates {
+		res, err := p.roundTripWorker(req, workerURL, i > 0)
+		p.SetHealth(workerURL, err)
+		if err == nil {
+			p.mu.Lock()
+			p.preferred = workerURL
+			p.mu.Unlock()
+			return res, nil
+		}
+		lastErr = err
+
+		if req.Context().Err() != nil {
+			break
+		}
+		// Requests with a body can only be retried if the body can be read again.
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			break
+		}
+		p.logger.Debug("Kafka connect worker is not reachable, failing over to next worker",
+			zap.String("worker_url", workerURL),
+			zap.Error(err))
+	}
+	return nil, lastErr
+}
+
+// roundTripWorker sends the request to the given worker. The request body is
+// read again for retries.
+func (p *Pool) roundTripWorker(req *http.Request, workerURL string, retry bool) (*http.Response, error) {
+	target, err := url.Parse(workerURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid worker url %q: %w", workerURL, err)
+	}
+
+	attempt := req.Clone(req.Context())
+	attempt.URL.Scheme = target.Scheme
+	attempt.URL.Host = target.Host
+	attempt.Host = ""
+	if retry && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attempt.Body = body
+	}
+	return p.base.RoundTrip(attempt)
+}
+
+// candidates returns the worker URLs in the order in which they should be tried.
+// The preferred worker comes first, followed by all other healthy workers and
+// finally all unhealthy workers.
+func (p *Pool) candidates() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var healthy, unhealthy []string
+	for _, w := range p.workers {
+		switch {
+		case w.URL == p.preferred && w.Healthy:
+			healthy = append([]string{w.URL}, healthy...)
+		case w.Healthy:
+			healthy = append(healthy, w.URL)
+		default:
+			unhealthy = append(unhealthy, w.URL)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
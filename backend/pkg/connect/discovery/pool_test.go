@@ -0,0 +1,153 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+type staticDiscoverer struct {
+	urls []string
+	err  error
+}
+
+func (d *staticDiscoverer) Discover(context.Context) ([]string, error) {
+	return d.urls, d.err
+}
+
+func newWorker(t *testing.T, name string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(name + ":" + string(body)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestPoolFailover(t *testing.T) {
+	down := newWorker(t, "down")
+	down.Close()
+	up := newWorker(t, "up")
+
+	d := &staticDiscoverer{urls: []string{down.URL, up.URL}}
+	pool := NewPool(d, nil, 0, zap.NewNop())
+	require.NoError(t, pool.Refresh(context.Background()))
+	client := &http.Client{Transport: pool}
+
+	// The request body is sent again to the next worker.
+	res, err := client.Post("http://placeholder/connectors", "text/plain", strings.NewReader("payload"))
+	require.NoError(t, err)
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	assert.Equal(t, "up:payload", string(body))
+
+	workers := pool.Workers()
+	require.Len(t, workers, 2)
+	assert.False(t, workers[0].Healthy)
+	assert.NotEmpty(t, workers[0].Error)
+	assert.True(t, workers[1].Healthy)
+	assert.Equal(t, up.URL, pool.Preferred())
+
+	// Pinned requests do not fail over.
+	req, err := http.NewRequestWithContext(WithWorker(context.Background(), down.URL), http.MethodGet, "http://placeholder/", http.NoBody)
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	require.Error(t, err)
+
+	// Refreshing retains the state of known workers and keeps the workers if the
+	// discovery fails.
+	d.urls = []string{up.URL, down.URL}
+	require.NoError(t, pool.Refresh(context.Background()))
+	assert.False(t, pool.Workers()[1].Healthy)
+	d.err = errors.New("dns failure")
+	require.Error(t, pool.Refresh(context.Background()))
+	assert.Len(t, pool.Workers(), 2)
+}
+
+func TestPoolWithoutWorkers(t *testing.T) {
+	pool := NewPool(&staticDiscoverer{}, nil, 0, zap.NewNop())
+	require.Error(t, pool.Refresh(context.Background()))
+
+	_, err := (&http.Client{Transport: pool}).Get("http://placeholder/")
+	require.Error(t, err)
+}
+
+func TestDNSSRVDiscoverer(t *testing.T) {
+	d := NewDNSSRVDiscoverer(config.ConnectClusterDiscoveryDNSSRV{Service: "connect", Proto: "tcp", Name: "example.com"}, "https")
+	d.lookupSRV = func(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		assert.Equal(t, "connect", service)
+		assert.Equal(t, "tcp", proto)
+		assert.Equal(t, "example.com", name)
+		return "", []*net.SRV{
+			{Target: "backup.example.com.", Port: 8083, Priority: 20, Weight: 100},
+			{Target: "worker-1.example.com.", Port: 8083, Priority: 10, Weight: 10},
+			{Target: "worker-0.example.com.", Port: 8083, Priority: 10, Weight: 50},
+		}, nil
+	}
+
+	urls, err := d.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://worker-0.example.com:8083",
+		"https://worker-1.example.com:8083",
+		"https://backup.example.com:8083",
+	}, urls)
+}
+
+func TestKubernetesDiscoverer(t *testing.T) {
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sa-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/api/v1/namespaces/connect/endpoints/connect-workers" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"subsets":[{
+			"addresses":[{"ip":"10.0.0.1"},{"ip":"10.0.0.2"}],
+			"notReadyAddresses":[{"ip":"10.0.0.3"}],
+			"ports":[{"name":"metrics","port":9404},{"name":"rest","port":8083}]
+		}]}`))
+	}))
+	defer apiServer.Close()
+
+	tokenFilepath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFilepath, []byte("sa-token\n"), 0o600))
+
+	d, err := NewKubernetesDiscoverer(config.ConnectClusterDiscoveryKubernetes{
+		APIServerURL:  apiServer.URL,
+		Namespace:     "connect",
+		Service:       "connect-workers",
+		PortName:      "rest",
+		TokenFilepath: tokenFilepath,
+	}, "http")
+	require.NoError(t, err)
+
+	urls, err := d.Discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http://10.0.0.1:8083", "http://10.0.0.2:8083"}, urls)
+}
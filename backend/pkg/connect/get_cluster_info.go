@@ -62,7 +62,7 @@ func (s *Service) GetClusterInfo(ctx context.Context, clusterName string) (Clust
 
 	return ClusterInfo{
 		Name:           c.Cfg.Name,
-		Host:           c.Cfg.URL,
+		Host:           c.Host(),
 		Version:        rootInfo.Version,
 		Commit:         rootInfo.Commit,
 		KafkaClusterID: rootInfo.KafkaClusterID,
@@ -223,13 +223,13 @@ func (s *Service) GetClusterConnectors(ctx context.Context, clusterName string)
 	errMsg := ""
 	if err != nil {
 		s.Logger.Warn("failed to list connectors from Kafka connect cluster",
-			zap.String("cluster_name", c.Cfg.Name), zap.String("cluster_address", c.Cfg.URL), zap.Error(err))
+			zap.String("cluster_name", c.Cfg.Name), zap.String("cluster_address", c.Host()), zap.Error(err))
 		errMsg = err.Error()
 	}
 
 	return ClusterConnectors{
 		ClusterName:    c.Cfg.Name,
-		ClusterAddress: c.Cfg.URL,
+		ClusterAddress: c.Host(),
 		Connectors:     s.listConnectorsExpandedToClusterConnectorInfo(connectors),
 		Error:          errMsg,
 	}, nil
@@ -0,0 +1,128 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package gitops reconciles Kafka connect connectors that are declared as YAML
+// files in a Git repository against the configured Kafka connect clusters.
+package gitops
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// LabelConfigPrefix is the prefix of all connector config keys that carry labels.
+	// Kafka connect keeps unknown config keys, so that labels survive round trips.
+	LabelConfigPrefix = "redpanda.console.label."
+	// OwnerLabel is the label that marks a connector as managed by a reconciler.
+	OwnerLabel = "managed-by"
+)
+
+var labelNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// ConnectorDefinition is the desired state of a single connector. A YAML file
+// may contain multiple definitions separated by "---".
+//
+//	cluster: local
+//	name: orders-cdc
+//	labels:
+//	  team: payments
+//	config:
+//	  connector.class: io.debezium.connector.postgresql.PostgresConnector
+//	  tasks.max: 1
+type ConnectorDefinition struct {
+	// ClusterName is the name of the target Kafka connect cluster. It may be
+	// omitted if only one cluster is configured.
+	ClusterName string            `yaml:"cluster" json:"clusterName"`
+	Name        string            `yaml:"name" json:"name"`
+	Labels      map[string]string `yaml:"labels" json:"labels,omitempty"`
+	Config      map[string]any    `yaml:"config" json:"-"`
+
+	// Source is the path of the file in the repository that declares the connector.
+	Source string `yaml:"-" json:"source"`
+}
+
+// ParseDefinitions parses all connector definitions of a YAML file. Scalar config
+// values are converted to strings, because Kafka connect expects string values.
+func ParseDefinitions(source string, payload []byte) ([]ConnectorDefinition, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(payload))
+	decoder.KnownFields(true)
+
+	var definitions []ConnectorDefinition
+	for i := 0; ; i++ {
+		var def ConnectorDefinition
+		err := decoder.Decode(&def)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode document %d: %w", i, err)
+		}
+		if def.Name == "" && def.Config == nil {
+			// Empty document, e.g. a trailing separator.
+			continue
+		}
+		def.Source = source
+		if err := def.normalize(); err != nil {
+			return nil, fmt.Errorf("invalid connector definition %q in document %d: %w", def.Name, i, err)
+		}
+		definitions = append(definitions, def)
+	}
+	return definitions, nil
+}
+
+func (d *ConnectorDefinition) normalize() error {
+	if d.Name == "" {
+		return errors.New("name must be set")
+	}
+	if len(d.Config) == 0 {
+		return errors.New("config must be set")
+	}
+	for key, value := range d.Config {
+		switch v := value.(type) {
+		case string:
+		case int, int64, float64, bool:
+			d.Config[key] = fmt.Sprint(v)
+		default:
+			return fmt.Errorf("config value of key %q must be a scalar", key)
+		}
+	}
+	if className, _ := d.Config["connector.class"].(string); className == "" {
+		return errors.New("config must contain connector.class")
+	}
+	for name := range d.Labels {
+		if !labelNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid label name %q", name)
+		}
+		if name == OwnerLabel {
+			return fmt.Errorf("label %q is reserved", OwnerLabel)
+		}
+	}
+	return nil
+}
+
+// connectorConfig returns the config that is sent to Kafka connect, which is the
+// declared config along with all labels and the ownership label.
+func (d *ConnectorDefinition) connectorConfig(owner string) map[string]any {
+	cfg := make(map[string]any, len(d.Config)+len(d.Labels)+2)
+	for key, value := range d.Config {
+		cfg[key] = value
+	}
+	for name, value := range d.Labels {
+		cfg[LabelConfigPrefix+name] = value
+	}
+	cfg[LabelConfigPrefix+OwnerLabel] = owner
+	cfg["name"] = d.Name
+	return cfg
+}
@@ -0,0 +1,239 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gitops
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/connect/secrets"
+)
+
+// ConnectorState describes how a connector in a Kafka connect cluster relates to
+// the declared connectors.
+type ConnectorState string
+
+const (
+	// ConnectorStateInSync is used for declared connectors whose config matches.
+	ConnectorStateInSync ConnectorState = "IN_SYNC"
+	// ConnectorStateMissing is used for declared connectors that don't exist.
+	ConnectorStateMissing ConnectorState = "MISSING"
+	// ConnectorStateDrifted is used for declared connectors whose config differs.
+	ConnectorStateDrifted ConnectorState = "DRIFTED"
+	// ConnectorStateConflict is used for declared connectors that exist, but are
+	// owned by someone else.
+	ConnectorStateConflict ConnectorState = "CONFLICT"
+	// ConnectorStateOrphaned is used for connectors that are owned by this
+	// reconciler, but are no longer declared.
+	ConnectorStateOrphaned ConnectorState = "ORPHANED"
+	// ConnectorStateUnmanaged is used for connectors that are neither declared
+	// nor owned by this reconciler.
+	ConnectorStateUnmanaged ConnectorState = "UNMANAGED"
+)
+
+// Action is the change that the reconciler applies to a connector.
+type Action string
+
+const (
+	// ActionNone leaves the connector untouched.
+	ActionNone Action = "NONE"
+	// ActionCreate creates the connector.
+	ActionCreate Action = "CREATE"
+	// ActionUpdate overwrites the connector config.
+	ActionUpdate Action = "UPDATE"
+	// ActionDelete deletes the connector.
+	ActionDelete Action = "DELETE"
+)
+
+// PlanItem is the state of a single connector and the action that is required to
+// reconcile it.
+type PlanItem struct {
+	ClusterName   string         `json:"clusterName"`
+	ConnectorName string         `json:"connectorName"`
+	Owner         string         `json:"owner,omitempty"`
+	Source        string         `json:"source,omitempty"`
+	State         ConnectorState `json:"state"`
+	Action        Action         `json:"action"`
+
+	// Changes are the config changes that will be applied. Sensitive values are redacted.
+	Changes []connect.ConfigChange `json:"changes,omitempty"`
+
+	// Error is set if applying the action has failed.
+	Error string `json:"error,omitempty"`
+
+	definition *ConnectorDefinition
+}
+
+// Plan is the result of comparing the declared connectors with the connectors in
+// all Kafka connect clusters.
+type Plan struct {
+	DryRun    bool       `json:"dryRun"`
+	CreatedAt time.Time  `json:"createdAt"`
+	Items     []PlanItem `json:"items"`
+
+	// Errors contains problems that prevented parts of the plan from being computed,
+	// e.g. invalid definition files or unreachable clusters.
+	Errors []string `json:"errors"`
+}
+
+// planOptions control which actions are planned.
+type planOptions struct {
+	owner           string
+	deleteUnmanaged bool
+	deleteUnlabeled bool
+
+	// desiredConfig converts the config of a definition to the config as it is
+	// stored in Kafka connect.
+	desiredConfig func(def *ConnectorDefinition) map[string]string
+
+	// isSensitiveKey returns true for config keys whose values must be redacted.
+	isSensitiveKey func(key string) bool
+
+	// secretReference returns the config provider reference that a sensitive
+	// config is replaced with when it is moved into the secret store. It
+	// returns an empty string if sensitive configs are not rewritten.
+	secretReference func(clusterName, connectorName, key string) string
+}
+
+// computePlan compares the declared connectors with the connectors that exist in
+// each cluster. actualByCluster contains the configs of all connectors by name for
+// each cluster that could be listed. Connectors are never deleted if not all
+// definitions could be parsed, because they may be declared in an invalid file.
+func computePlan(definitions []ConnectorDefinition, actualByCluster map[string]map[string]map[string]string, parseErrors []string, opts planOptions) *Plan {
+	plan := &Plan{
+		CreatedAt: time.Now().UTC(),
+		Items:     make([]PlanItem, 0),
+		Errors:    append(make([]string, 0), parseErrors...),
+	}
+
+	declared := make(map[string]map[string]bool)
+	for i := range definitions {
+		def := &definitions[i]
+		actualConnectors, ok := actualByCluster[def.ClusterName]
+		if !ok {
+			// Unknown and unreachable clusters have already been reported.
+			continue
+		}
+		if declared[def.ClusterName] == nil {
+			declared[def.ClusterName] = make(map[string]bool)
+		}
+		if declared[def.ClusterName][def.Name] {
+			plan.Errors = append(plan.Errors, fmt.Sprintf("connector %q in cluster %q is declared more than once, ignoring the declaration in %q", def.Name, def.ClusterName, def.Source))
+			continue
+		}
+		declared[def.ClusterName][def.Name] = true
+
+		item := PlanItem{
+			ClusterName:   def.ClusterName,
+			ConnectorName: def.Name,
+			Source:        def.Source,
+			definition:    def,
+		}
+		desired := opts.desiredConfig(def)
+		actual, exists := actualConnectors[def.Name]
+		item.Owner = actual[LabelConfigPrefix+OwnerLabel]
+		switch {
+		case !exists:
+			item.State = ConnectorStateMissing
+			item.Action = ActionCreate
+			item.Owner = ""
+			item.Changes = redactChanges(connect.DiffConnectorConfigs(nil, desired), opts.isSensitiveKey)
+		case item.Owner != "" && item.Owner != opts.owner:
+			item.State = ConnectorStateConflict
+			item.Action = ActionNone
+		default:
+			desired = normalizeSecretReferences(def.ClusterName, def.Name, desired, actual, opts)
+			changes := connect.DiffConnectorConfigs(actual, desired)
+			if len(changes) == 0 {
+				item.State = ConnectorStateInSync
+				item.Action = ActionNone
+			} else {
+				item.State = ConnectorStateDrifted
+				item.Action = ActionUpdate
+				item.Changes = redactChanges(changes, opts.isSensitiveKey)
+			}
+		}
+		plan.Items = append(plan.Items, item)
+	}
+
+	for clusterName, actualConnectors := range actualByCluster {
+		for connectorName, actual := range actualConnectors {
+			if declared[clusterName][connectorName] {
+				continue
+			}
+			item := PlanItem{
+				ClusterName:   clusterName,
+				ConnectorName: connectorName,
+				Owner:         actual[LabelConfigPrefix+OwnerLabel],
+				State:         ConnectorStateUnmanaged,
+				Action:        ActionNone,
+			}
+			if item.Owner == opts.owner {
+				item.State = ConnectorStateOrphaned
+			}
+			canDelete := len(parseErrors) == 0 && opts.deleteUnmanaged &&
+				(item.Owner == opts.owner || (item.Owner == "" && opts.deleteUnlabeled))
+			if canDelete {
+				item.Action = ActionDelete
+			}
+			plan.Items = append(plan.Items, item)
+		}
+	}
+
+	sort.Slice(plan.Items, func(i, j int) bool {
+		if plan.Items[i].ClusterName != plan.Items[j].ClusterName {
+			return plan.Items[i].ClusterName < plan.Items[j].ClusterName
+		}
+		return plan.Items[i].ConnectorName < plan.Items[j].ConnectorName
+	})
+	sort.Strings(plan.Errors)
+
+	return plan
+}
+
+// normalizeSecretReferences returns the desired config where plain values are
+// replaced with the config provider references that the actual config holds
+// instead. This applies to sensitive keys and to values that have been moved into
+// the secret store when the connector was applied, as these would otherwise always
+// be reported as drift. Changes of such values in the definitions are therefore
+// not detected.
+func normalizeSecretReferences(clusterName, connectorName string, desired, actual map[string]string, opts planOptions) map[string]string {
+	normalized := make(map[string]string, len(desired))
+	for key, value := range desired {
+		normalized[key] = value
+		actualValue, exists := actual[key]
+		if !exists || actualValue == value || secrets.IsReference(value) || !secrets.IsReference(actualValue) {
+			continue
+		}
+		isStoredSecret := opts.secretReference != nil && opts.secretReference(clusterName, connectorName, key) == actualValue
+		if isStoredSecret || opts.isSensitiveKey(key) {
+			normalized[key] = actualValue
+		}
+	}
+	return normalized
+}
+
+func redactChanges(changes []connect.ConfigChange, isSensitiveKey func(key string) bool) []connect.ConfigChange {
+	redacted := connect.RedactedValue
+	for i, change := range changes {
+		if !isSensitiveKey(change.Key) {
+			continue
+		}
+		if change.OldValue != nil {
+			changes[i].OldValue = &redacted
+		}
+		if change.NewValue != nil {
+			changes[i].NewValue = &redacted
+		}
+	}
+	return changes
+}
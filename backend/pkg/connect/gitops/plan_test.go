@@ -0,0 +1,256 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gitops
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+func TestParseDefinitions(t *testing.T) {
+	payload := `
+cluster: local
+name: orders-cdc
+labels:
+  team: payments
+config:
+  connector.class: io.debezium.connector.postgresql.PostgresConnector
+  tasks.max: 2
+  snapshot.enabled: true
+---
+name: items-sink
+config:
+  connector.class: org.apache.kafka.connect.file.FileStreamSinkConnector
+---
+`
+	defs, err := ParseDefinitions("connectors/orders.yaml", []byte(payload))
+	require.NoError(t, err)
+	require.Len(t, defs, 2)
+
+	assert.Equal(t, "local", defs[0].ClusterName)
+	assert.Equal(t, "orders-cdc", defs[0].Name)
+	assert.Equal(t, "connectors/orders.yaml", defs[0].Source)
+	assert.Equal(t, "2", defs[0].Config["tasks.max"])
+	assert.Equal(t, "true", defs[0].Config["snapshot.enabled"])
+	assert.Equal(t, map[string]string{"team": "payments"}, defs[0].Labels)
+
+	assert.Equal(t, "", defs[1].ClusterName)
+	assert.Equal(t, "items-sink", defs[1].Name)
+
+	cfg := defs[0].connectorConfig("console")
+	assert.Equal(t, "payments", cfg[LabelConfigPrefix+"team"])
+	assert.Equal(t, "console", cfg[LabelConfigPrefix+OwnerLabel])
+	assert.Equal(t, "orders-cdc", cfg["name"])
+}
+
+func TestParseDefinitionsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		errMsg  string
+	}{
+		{
+			name:    "missing name",
+			payload: "config:\n  connector.class: a.B\n",
+			errMsg:  "name must be set",
+		},
+		{
+			name:    "missing connector class",
+			payload: "name: a\nconfig:\n  tasks.max: 1\n",
+			errMsg:  "connector.class",
+		},
+		{
+			name:    "nested config value",
+			payload: "name: a\nconfig:\n  connector.class: a.B\n  nested:\n    key: value\n",
+			errMsg:  "must be a scalar",
+		},
+		{
+			name:    "reserved label",
+			payload: "name: a\nlabels:\n  managed-by: me\nconfig:\n  connector.class: a.B\n",
+			errMsg:  "reserved",
+		},
+		{
+			name:    "unknown field",
+			payload: "name: a\nconfigs:\n  connector.class: a.B\n",
+			errMsg:  "not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDefinitions("a.yaml", []byte(tt.payload))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func testPlanOptions() planOptions {
+	return planOptions{
+		owner: "console",
+		desiredConfig: func(def *ConnectorDefinition) map[string]string {
+			desired := make(map[string]string)
+			for key, value := range def.connectorConfig("console") {
+				desired[key] = fmt.Sprint(value)
+			}
+			return desired
+		},
+		isSensitiveKey: func(key string) bool {
+			return strings.Contains(key, "password")
+		},
+	}
+}
+
+func testDefinition(name string, cfg map[string]any) ConnectorDefinition {
+	cfg["connector.class"] = "a.B"
+	return ConnectorDefinition{ClusterName: "local", Name: name, Config: cfg}
+}
+
+func findPlanItem(t *testing.T, plan *Plan, connectorName string) PlanItem {
+	t.Helper()
+	for _, item := range plan.Items {
+		if item.ConnectorName == connectorName {
+			return item
+		}
+	}
+	require.Failf(t, "plan item not found", "connector %q", connectorName)
+	return PlanItem{}
+}
+
+func TestComputePlan(t *testing.T) {
+	opts := testPlanOptions()
+	definitions := []ConnectorDefinition{
+		testDefinition("missing", map[string]any{}),
+		testDefinition("in-sync", map[string]any{"topics": "a"}),
+		testDefinition("drifted", map[string]any{"topics": "b", "db.password": "new"}),
+		testDefinition("adopted", map[string]any{}),
+		testDefinition("conflict", map[string]any{}),
+	}
+	actual := map[string]map[string]map[string]string{
+		"local": {
+			"in-sync":   opts.desiredConfig(&definitions[1]),
+			"drifted":   {"name": "drifted", "connector.class": "a.B", "topics": "a", "db.password": "old", LabelConfigPrefix + OwnerLabel: "console"},
+			"adopted":   {"name": "adopted", "connector.class": "a.B"},
+			"conflict":  {"name": "conflict", "connector.class": "a.B", LabelConfigPrefix + OwnerLabel: "someone-else"},
+			"orphaned":  {"name": "orphaned", LabelConfigPrefix + OwnerLabel: "console"},
+			"unmanaged": {"name": "unmanaged"},
+		},
+	}
+
+	plan := computePlan(definitions, actual, nil, opts)
+	assert.Empty(t, plan.Errors)
+	require.Len(t, plan.Items, 7)
+
+	item := findPlanItem(t, plan, "missing")
+	assert.Equal(t, ConnectorStateMissing, item.State)
+	assert.Equal(t, ActionCreate, item.Action)
+
+	item = findPlanItem(t, plan, "in-sync")
+	assert.Equal(t, ConnectorStateInSync, item.State)
+	assert.Equal(t, ActionNone, item.Action)
+	assert.Empty(t, item.Changes)
+
+	item = findPlanItem(t, plan, "drifted")
+	assert.Equal(t, ConnectorStateDrifted, item.State)
+	assert.Equal(t, ActionUpdate, item.Action)
+	require.Len(t, item.Changes, 2)
+	assert.Equal(t, "db.password", item.Changes[0].Key)
+	assert.Equal(t, connect.RedactedValue, *item.Changes[0].OldValue)
+	assert.Equal(t, connect.RedactedValue, *item.Changes[0].NewValue)
+	assert.Equal(t, "topics", item.Changes[1].Key)
+	assert.Equal(t, "b", *item.Changes[1].NewValue)
+
+	item = findPlanItem(t, plan, "adopted")
+	assert.Equal(t, ConnectorStateDrifted, item.State)
+	assert.Equal(t, ActionUpdate, item.Action)
+
+	item = findPlanItem(t, plan, "conflict")
+	assert.Equal(t, ConnectorStateConflict, item.State)
+	assert.Equal(t, ActionNone, item.Action)
+	assert.Equal(t, "someone-else", item.Owner)
+
+	item = findPlanItem(t, plan, "orphaned")
+	assert.Equal(t, ConnectorStateOrphaned, item.State)
+	assert.Equal(t, ActionNone, item.Action)
+
+	item = findPlanItem(t, plan, "unmanaged")
+	assert.Equal(t, ConnectorStateUnmanaged, item.State)
+	assert.Equal(t, ActionNone, item.Action)
+}
+
+func TestComputePlanSecretReferences(t *testing.T) {
+	opts := testPlanOptions()
+	opts.secretReference = func(clusterName, connectorName, key string) string {
+		return fmt.Sprintf("${secrets:%s:%s_%s}", clusterName, connectorName, key)
+	}
+	definitions := []ConnectorDefinition{
+		testDefinition("orders", map[string]any{"db.password": "secret", "ssl.key": "key", "api.token": "token"}),
+	}
+	actual := opts.desiredConfig(&definitions[0])
+	actual["db.password"] = "${vault:connect/orders:db.password}"
+	actual["ssl.key"] = "${secrets:local:orders_ssl.key}"
+	actual["api.token"] = "${file:/secrets/orders:api.token}"
+
+	plan := computePlan(definitions, map[string]map[string]map[string]string{"local": {"orders": actual}}, nil, opts)
+	item := findPlanItem(t, plan, "orders")
+	assert.Equal(t, ConnectorStateDrifted, item.State)
+	require.Len(t, item.Changes, 1)
+	assert.Equal(t, "api.token", item.Changes[0].Key)
+	assert.Equal(t, "token", *item.Changes[0].NewValue)
+}
+
+func TestComputePlanDeletes(t *testing.T) {
+	actual := map[string]map[string]map[string]string{
+		"local": {
+			"orphaned":  {"name": "orphaned", LabelConfigPrefix + OwnerLabel: "console"},
+			"unmanaged": {"name": "unmanaged"},
+			"foreign":   {"name": "foreign", LabelConfigPrefix + OwnerLabel: "someone-else"},
+		},
+	}
+
+	opts := testPlanOptions()
+	opts.deleteUnmanaged = true
+	plan := computePlan(nil, actual, nil, opts)
+	assert.Equal(t, ActionNone, findPlanItem(t, plan, "foreign").Action)
+	assert.Equal(t, ActionDelete, findPlanItem(t, plan, "orphaned").Action)
+	assert.Equal(t, ActionNone, findPlanItem(t, plan, "unmanaged").Action)
+
+	opts.deleteUnlabeled = true
+	plan = computePlan(nil, actual, nil, opts)
+	assert.Equal(t, ActionNone, findPlanItem(t, plan, "foreign").Action)
+	assert.Equal(t, ActionDelete, findPlanItem(t, plan, "orphaned").Action)
+	assert.Equal(t, ActionDelete, findPlanItem(t, plan, "unmanaged").Action)
+
+	// Nothing is deleted if a definition file could not be parsed.
+	plan = computePlan(nil, actual, []string{"broken.yaml: invalid"}, opts)
+	assert.Equal(t, []string{"broken.yaml: invalid"}, plan.Errors)
+	for _, item := range plan.Items {
+		assert.Equal(t, ActionNone, item.Action)
+	}
+}
+
+func TestComputePlanDuplicateDefinitions(t *testing.T) {
+	first := testDefinition("orders", map[string]any{})
+	first.Source = "a.yaml"
+	second := testDefinition("orders", map[string]any{})
+	second.Source = "b.yaml"
+	actual := map[string]map[string]map[string]string{"local": {}}
+
+	plan := computePlan([]ConnectorDefinition{first, second}, actual, nil, testPlanOptions())
+	require.Len(t, plan.Items, 1)
+	assert.Equal(t, "a.yaml", plan.Items[0].Source)
+	require.Len(t, plan.Errors, 1)
+	assert.Contains(t, plan.Errors[0], "b.yaml")
+}
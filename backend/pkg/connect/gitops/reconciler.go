@@ -0,0 +1,315 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/git"
+)
+
+// reconcileTimeout is the maximum duration of a single reconciliation.
+const reconcileTimeout = 2 * time.Minute
+
+// Reconciler reconciles the connectors that are declared in a Git repository
+// against all configured Kafka connect clusters.
+type Reconciler struct {
+	cfg        config.ConnectGitOps
+	logger     *zap.Logger
+	connectSvc *connect.Service
+	gitSvc     *git.Service
+
+	// trigger is notified when the repository contents have changed.
+	trigger chan struct{}
+
+	// reconcileMutex ensures that only one reconciliation runs at a time.
+	reconcileMutex sync.Mutex
+
+	statusMutex        sync.RWMutex
+	lastReconciliation *Plan
+}
+
+// DriftStatus is the current drift between the declared and the actual connectors.
+type DriftStatus struct {
+	Repository git.RepositoryStatus `json:"repository"`
+	Drift      *Plan                `json:"drift"`
+
+	// LastReconciliation is the result of the most recent reconciliation. It is
+	// nil if no reconciliation has run yet.
+	LastReconciliation *Plan `json:"lastReconciliation"`
+}
+
+// NewReconciler creates a new Reconciler. The Git repository is cloned on Start.
+func NewReconciler(cfg config.ConnectGitOps, logger *zap.Logger, connectSvc *connect.Service) (*Reconciler, error) {
+	r := &Reconciler{
+		cfg:        cfg,
+		logger:     logger.Named("connect_gitops"),
+		connectSvc: connectSvc,
+		trigger:    make(chan struct{}, 1),
+	}
+
+	gitSvc, err := git.NewService(cfg.Git, logger, r.onFilesUpdated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create git service: %w", err)
+	}
+	r.gitSvc = gitSvc
+
+	return r, nil
+}
+
+// Start clones the Git repository and starts reconciling in the background.
+func (r *Reconciler) Start() error {
+	if err := r.gitSvc.Start(); err != nil {
+		return fmt.Errorf("failed to start git service: %w", err)
+	}
+	go r.reconcilePeriodically()
+	return nil
+}
+
+// Refresh pulls the Git repository immediately. If the repository has changed, a
+// reconciliation is triggered.
+func (r *Reconciler) Refresh(ctx context.Context) error {
+	return r.gitSvc.Pull(ctx)
+}
+
+// RepositoryStatus returns the sync state of the Git repository.
+func (r *Reconciler) RepositoryStatus() git.RepositoryStatus {
+	return r.gitSvc.Status()
+}
+
+func (r *Reconciler) onFilesUpdated() {
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+		// A reconciliation is already pending.
+	}
+}
+
+func (r *Reconciler) reconcilePeriodically() {
+	var tick <-chan time.Time
+	if r.cfg.ReconcileInterval > 0 {
+		ticker := time.NewTicker(r.cfg.ReconcileInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+		plan := r.Reconcile(ctx, r.cfg.DryRun)
+		cancel()
+		r.logPlan(plan)
+
+		select {
+		case <-r.trigger:
+		case <-tick:
+		}
+	}
+}
+
+func (r *Reconciler) logPlan(plan *Plan) {
+	actions := make(map[Action]int)
+	failed := 0
+	for _, item := range plan.Items {
+		actions[item.Action]++
+		if item.Error != "" {
+			failed++
+		}
+	}
+	fields := []zap.Field{
+		zap.Bool("dry_run", plan.DryRun),
+		zap.Int("created", actions[ActionCreate]),
+		zap.Int("updated", actions[ActionUpdate]),
+		zap.Int("deleted", actions[ActionDelete]),
+		zap.Int("failed", failed),
+	}
+	for _, planErr := range plan.Errors {
+		r.logger.Warn("problem while reconciling connectors", zap.String("error", planErr))
+	}
+	if failed > 0 {
+		r.logger.Warn("reconciled connectors with failures", fields...)
+		return
+	}
+	r.logger.Debug("reconciled connectors", fields...)
+}
+
+// Plan computes the actions that are required to reconcile all connectors
+// without applying them.
+func (r *Reconciler) Plan(ctx context.Context) *Plan {
+	definitions, parseErrors := r.loadDefinitions()
+	actualByCluster, listErrors := r.listConnectors(ctx)
+
+	plan := computePlan(definitions, actualByCluster, parseErrors, planOptions{
+		owner:           r.cfg.Owner,
+		deleteUnmanaged: r.cfg.DeleteUnmanaged,
+		deleteUnlabeled: r.cfg.DeleteUnlabeled,
+		desiredConfig:   r.desiredConfig,
+		isSensitiveKey:  r.connectSvc.IsSensitiveKey,
+		secretReference: r.connectSvc.SensitiveConfigReference,
+	})
+	plan.DryRun = true
+	plan.Errors = append(plan.Errors, listErrors...)
+	return plan
+}
+
+// Reconcile computes the plan and applies it, unless dryRun is set. Failed actions
+// are reported in the plan items.
+func (r *Reconciler) Reconcile(ctx context.Context, dryRun bool) *Plan {
+	r.reconcileMutex.Lock()
+	defer r.reconcileMutex.Unlock()
+
+	plan := r.Plan(ctx)
+	plan.DryRun = dryRun
+	if !dryRun {
+		ctx = connect.ContextWithActor(ctx, "gitops:"+r.cfg.Owner)
+		for i := range plan.Items {
+			if err := r.apply(ctx, &plan.Items[i]); err != nil {
+				plan.Items[i].Error = err.Error()
+			}
+		}
+	}
+
+	r.statusMutex.Lock()
+	r.lastReconciliation = plan
+	r.statusMutex.Unlock()
+
+	return plan
+}
+
+// Status returns the current drift along with the result of the last reconciliation.
+func (r *Reconciler) Status(ctx context.Context) *DriftStatus {
+	r.statusMutex.RLock()
+	lastReconciliation := r.lastReconciliation
+	r.statusMutex.RUnlock()
+
+	return &DriftStatus{
+		Repository:         r.gitSvc.Status(),
+		Drift:              r.Plan(ctx),
+		LastReconciliation: lastReconciliation,
+	}
+}
+
+func (r *Reconciler) apply(ctx context.Context, item *PlanItem) error {
+	switch item.Action {
+	case ActionCreate:
+		_, restErr := r.connectSvc.CreateConnector(ctx, item.ClusterName, con.CreateConnectorRequest{
+			Name:   item.ConnectorName,
+			Config: item.definition.connectorConfig(r.cfg.Owner),
+		})
+		if restErr != nil {
+			return restErr.Err
+		}
+	case ActionUpdate:
+		_, restErr := r.connectSvc.PutConnectorConfig(ctx, item.ClusterName, item.ConnectorName, con.PutConnectorConfigOptions{
+			Config: item.definition.connectorConfig(r.cfg.Owner),
+		})
+		if restErr != nil {
+			return restErr.Err
+		}
+		// restart the instance and all the tasks
+		if restErr := r.connectSvc.RestartConnector(ctx, item.ClusterName, item.ConnectorName, true, false); restErr != nil {
+			return restErr.Err
+		}
+	case ActionDelete:
+		if restErr := r.connectSvc.DeleteConnector(ctx, item.ClusterName, item.ConnectorName); restErr != nil {
+			return restErr.Err
+		}
+	case ActionNone:
+	}
+	return nil
+}
+
+// desiredConfig returns the config of a definition as it is stored in Kafka connect.
+// The interceptor is applied, so that guides can inject their defaults.
+func (r *Reconciler) desiredConfig(def *ConnectorDefinition) map[string]string {
+	cfg := def.connectorConfig(r.cfg.Owner)
+	className, _ := cfg["connector.class"].(string)
+	converted := r.connectSvc.Interceptor.ConsoleToKafkaConnect(className, cfg)
+
+	desired := make(map[string]string, len(converted))
+	for key, value := range converted {
+		desired[key] = fmt.Sprint(value)
+	}
+	return desired
+}
+
+// loadDefinitions parses all definitions from the repository. Definitions that
+// don't specify a cluster are assigned to the only configured cluster.
+func (r *Reconciler) loadDefinitions() ([]ConnectorDefinition, []string) {
+	files := r.gitSvc.GetFilesByFilename()
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var definitions []ConnectorDefinition
+	var errs []string
+	for _, path := range paths {
+		defs, err := ParseDefinitions(path, files[path].Payload)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", path, err))
+			continue
+		}
+		for _, def := range defs {
+			if def.ClusterName == "" && len(r.connectSvc.ClientsByCluster) == 1 {
+				for clusterName := range r.connectSvc.ClientsByCluster {
+					def.ClusterName = clusterName
+				}
+			}
+			if _, exists := r.connectSvc.ClientsByCluster[def.ClusterName]; !exists {
+				errs = append(errs, fmt.Sprintf("%s: connector %q targets unknown cluster %q", path, def.Name, def.ClusterName))
+				continue
+			}
+			definitions = append(definitions, def)
+		}
+	}
+	return definitions, errs
+}
+
+// listConnectors returns the raw configs of all connectors for each cluster.
+// Clusters that can't be listed are omitted and reported as error.
+func (r *Reconciler) listConnectors(ctx context.Context) (map[string]map[string]map[string]string, []string) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	actualByCluster := make(map[string]map[string]map[string]string)
+	var errs []string
+
+	for clusterName, c := range r.connectSvc.ClientsByCluster {
+		wg.Add(1)
+		go func(clusterName string, client *con.Client) {
+			defer wg.Done()
+			connectors, err := client.ListConnectorsExpanded(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("failed to list connectors of cluster %q: %v", clusterName, err))
+				return
+			}
+			configs := make(map[string]map[string]string, len(connectors))
+			for name, connector := range connectors {
+				configs[name] = connector.Info.Config
+			}
+			actualByCluster[clusterName] = configs
+		}(clusterName, c.Client)
+	}
+	wg.Wait()
+
+	return actualByCluster, errs
+}
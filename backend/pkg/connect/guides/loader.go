@@ -0,0 +1,146 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package guides loads declarative connector guides from the filesystem or a Git
+// repository and registers them in the connector interceptor.
+package guides
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connector/declarative"
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+	"github.com/redpanda-data/console/backend/pkg/git"
+)
+
+// GuideRegistry is where loaded definitions are registered. It is implemented by
+// interceptor.Interceptor.
+type GuideRegistry interface {
+	SetDeclarativeGuides(defs []declarative.Definition)
+}
+
+// Loader loads all guide definitions from the configured file providers and
+// registers them again whenever the files change.
+type Loader struct {
+	cfg      config.ConnectGuides
+	logger   *zap.Logger
+	registry GuideRegistry
+
+	fsSvc  *filesystem.Service
+	gitSvc *git.Service
+
+	// reloadMutex ensures that concurrent reloads of both providers register
+	// the definitions in order.
+	reloadMutex sync.Mutex
+}
+
+// NewLoader creates a new Loader. Files are read on Start.
+func NewLoader(cfg config.ConnectGuides, logger *zap.Logger, registry GuideRegistry) (*Loader, error) {
+	l := &Loader{
+		cfg:      cfg,
+		logger:   logger.Named("connect_guides"),
+		registry: registry,
+	}
+
+	if cfg.FileSystem.Enabled {
+		fsSvc, err := filesystem.NewService(cfg.FileSystem, logger, func([]string) { l.Reload() })
+		if err != nil {
+			return nil, fmt.Errorf("failed to create filesystem service: %w", err)
+		}
+		l.fsSvc = fsSvc
+	}
+
+	if cfg.Git.Enabled {
+		gitSvc, err := git.NewService(cfg.Git, logger, l.Reload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create git service: %w", err)
+		}
+		l.gitSvc = gitSvc
+	}
+
+	return l, nil
+}
+
+// Start reads all files from the configured providers and registers the guides.
+// The providers keep watching for changes in the background.
+func (l *Loader) Start() error {
+	if l.fsSvc != nil {
+		if err := l.fsSvc.Start(); err != nil {
+			return fmt.Errorf("failed to start filesystem service: %w", err)
+		}
+	}
+	if l.gitSvc != nil {
+		if err := l.gitSvc.Start(); err != nil {
+			return fmt.Errorf("failed to start git service: %w", err)
+		}
+	}
+
+	l.Reload()
+	return nil
+}
+
+// Reload parses all files of the configured providers and replaces the registered
+// guides. Files that cannot be parsed are skipped, so that a single broken file
+// does not remove all other guides.
+func (l *Loader) Reload() {
+	l.reloadMutex.Lock()
+	defer l.reloadMutex.Unlock()
+
+	var files []filesystem.File
+	if l.fsSvc != nil {
+		for _, file := range l.fsSvc.GetFilesByFilename() {
+			files = append(files, file)
+		}
+	}
+	if l.gitSvc != nil {
+		for _, file := range l.gitSvc.GetFilesByFilename() {
+			files = append(files, file)
+		}
+	}
+
+	defs := l.parseFiles(files)
+	l.registry.SetDeclarativeGuides(defs)
+	l.logger.Info("registered declarative connector guides",
+		zap.Int("files", len(files)),
+		zap.Int("guides", len(defs)))
+}
+
+// parseFiles parses all files in the order of their paths. If multiple definitions
+// exist for the same class name, the last one wins.
+func (l *Loader) parseFiles(files []filesystem.File) []declarative.Definition {
+	sort.SliceStable(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	indexByClassName := make(map[string]int)
+	var defs []declarative.Definition
+	for _, file := range files {
+		parsed, err := declarative.Parse(file.Path, file.Payload)
+		if err != nil {
+			l.logger.Warn("skipping invalid connector guide file", zap.String("file", file.Path), zap.Error(err))
+			continue
+		}
+		for _, def := range parsed {
+			if i, exists := indexByClassName[def.ClassName]; exists {
+				l.logger.Warn("connector guide is declared more than once, the later declaration is used",
+					zap.String("class_name", def.ClassName),
+					zap.String("file", def.Source),
+					zap.String("overridden_file", defs[i].Source))
+				defs[i] = def
+				continue
+			}
+			indexByClassName[def.ClassName] = len(defs)
+			defs = append(defs, def)
+		}
+	}
+	return defs
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package guides
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connector/declarative"
+)
+
+type fakeRegistry struct {
+	defs []declarative.Definition
+}
+
+func (f *fakeRegistry) SetDeclarativeGuides(defs []declarative.Definition) {
+	f.defs = defs
+}
+
+func writeGuide(t *testing.T, dir, name, payload string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(payload), 0o600))
+}
+
+func TestLoaderFilesystem(t *testing.T) {
+	dir := t.TempDir()
+	writeGuide(t, dir, "a-elasticsearch.yaml", `
+className: io.confluent.connect.elasticsearch.ElasticsearchSinkConnector
+steps:
+  - groups:
+      - configKeys: [connection.url]
+`)
+	writeGuide(t, dir, "b-broken.yaml", "className: [")
+	writeGuide(t, dir, "c-override.json", `{
+  "className": "io.confluent.connect.elasticsearch.ElasticsearchSinkConnector",
+  "steps": [{"groups": [{"configKeys": ["connection.url", "connection.username"]}]}]
+}`)
+	writeGuide(t, dir, "README.md", "not a guide")
+
+	cfg := config.ConnectGuides{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.FileSystem.Enabled = true
+	cfg.FileSystem.Paths = []string{dir}
+
+	registry := &fakeRegistry{}
+	loader, err := NewLoader(cfg, zap.NewNop(), registry)
+	require.NoError(t, err)
+	require.NoError(t, loader.Start())
+
+	// The broken file is skipped and the later declaration replaces the earlier one.
+	require.Len(t, registry.defs, 1)
+	assert.Equal(t, "c-override.json", filepath.Base(registry.defs[0].Source))
+	assert.Equal(t, []string{"connection.url", "connection.username"}, registry.defs[0].Steps[0].Groups[0].ConfigKeys)
+}
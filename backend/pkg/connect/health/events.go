@@ -0,0 +1,120 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package health
+
+import (
+	"sync"
+	"time"
+)
+
+// RestartTarget is the kind of instance that has been restarted.
+type RestartTarget string
+
+const (
+	// RestartTargetConnector is used for restarts of the connector instance.
+	RestartTargetConnector RestartTarget = "CONNECTOR"
+	// RestartTargetTask is used for restarts of a single task.
+	RestartTargetTask RestartTarget = "TASK"
+)
+
+// RestartOutcome is the result of an automatic restart.
+type RestartOutcome string
+
+const (
+	// RestartOutcomeRestarted is used if the restart has been issued successfully.
+	RestartOutcomeRestarted RestartOutcome = "RESTARTED"
+	// RestartOutcomeFailed is used if the restart request has failed.
+	RestartOutcomeFailed RestartOutcome = "FAILED"
+	// RestartOutcomeExhausted is used once all restart attempts have been used up.
+	// The connector or task is not restarted again until it has been running for
+	// the configured reset duration.
+	RestartOutcomeExhausted RestartOutcome = "EXHAUSTED"
+)
+
+// RestartEvent describes a single automatic restart of a failed connector or task.
+type RestartEvent struct {
+	ClusterName   string         `json:"clusterName"`
+	ConnectorName string         `json:"connectorName"`
+	Target        RestartTarget  `json:"target"`
+	TaskID        *int           `json:"taskId,omitempty"`
+	Attempt       int            `json:"attempt"`
+	Outcome       RestartOutcome `json:"outcome"`
+	Timestamp     time.Time      `json:"timestamp"`
+
+	// Trace is the error trace of the failed connector or task.
+	Trace string `json:"trace"`
+
+	// Error is set if the restart request has failed.
+	Error string `json:"error,omitempty"`
+}
+
+// RestartEventFilter restricts the returned restart events. Empty fields match
+// all events.
+type RestartEventFilter struct {
+	ClusterName   string
+	ConnectorName string
+}
+
+func (f RestartEventFilter) matches(event *RestartEvent) bool {
+	if f.ClusterName != "" && f.ClusterName != event.ClusterName {
+		return false
+	}
+	if f.ConnectorName != "" && f.ConnectorName != event.ConnectorName {
+		return false
+	}
+	return true
+}
+
+// eventLog keeps the most recent restart events in memory.
+type eventLog struct {
+	mu      sync.RWMutex
+	events  []RestartEvent
+	next    int
+	maxSize int
+}
+
+func newEventLog(maxSize int) *eventLog {
+	return &eventLog{
+		events:  make([]RestartEvent, 0),
+		maxSize: maxSize,
+	}
+}
+
+func (l *eventLog) add(event RestartEvent) {
+	if l.maxSize == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.events) < l.maxSize {
+		l.events = append(l.events, event)
+		return
+	}
+	// The log is full, overwrite the oldest event.
+	l.events[l.next] = event
+	l.next = (l.next + 1) % l.maxSize
+}
+
+// list returns all matching events, the most recent event first.
+func (l *eventLog) list(filter RestartEventFilter) []RestartEvent {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	events := make([]RestartEvent, 0)
+	for i := len(l.events) - 1; i >= 0; i-- {
+		event := l.events[(l.next+i)%len(l.events)]
+		if filter.matches(&event) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
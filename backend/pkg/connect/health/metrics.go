@@ -0,0 +1,95 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package health
+
+import (
+	"strconv"
+	"sync"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const metricsSubsystem = "kafka_connect"
+
+var (
+	// We can only register the same Prometheus metrics in the default registry
+	// once, therefore these metrics are stored at the package level and are
+	// initialized only once.
+	promInitOnce       sync.Once
+	promConnectorState *prometheus.GaugeVec
+	promTaskState      *prometheus.GaugeVec
+	promRestarts       *prometheus.CounterVec
+	promPollErrors     *prometheus.CounterVec
+)
+
+// metrics exposes the states of all connectors and tasks as Prometheus metrics.
+type metrics struct {
+	connectorState *prometheus.GaugeVec
+	taskState      *prometheus.GaugeVec
+	restarts       *prometheus.CounterVec
+	pollErrors     *prometheus.CounterVec
+}
+
+func newMetrics(metricsNamespace string) *metrics {
+	promInitOnce.Do(func() {
+		promConnectorState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "connector_state",
+			Help:      "State of each connector. The value is 1 for the current state of the connector.",
+		}, []string{"cluster", "connector", "state"})
+		promTaskState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "task_state",
+			Help:      "State of each connector task. The value is 1 for the current state of the task.",
+		}, []string{"cluster", "connector", "task", "state"})
+		promRestarts = promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "auto_restarts_total",
+			Help:      "Number of automatic restarts of failed connectors and tasks by outcome.",
+		}, []string{"cluster", "connector", "target", "outcome"})
+		promPollErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "status_poll_errors_total",
+			Help:      "Number of failed attempts to poll the connector states of a cluster.",
+		}, []string{"cluster"})
+	})
+
+	return &metrics{
+		connectorState: promConnectorState,
+		taskState:      promTaskState,
+		restarts:       promRestarts,
+		pollErrors:     promPollErrors,
+	}
+}
+
+// setClusterStates replaces all connector and task states of a cluster, so that
+// deleted connectors and tasks are no longer reported.
+func (m *metrics) setClusterStates(clusterName string, connectors map[string]con.ListConnectorsResponseExpanded) {
+	m.deleteClusterStates(clusterName)
+	for connectorName, connector := range connectors {
+		m.connectorState.WithLabelValues(clusterName, connectorName, connector.Status.Connector.State).Set(1)
+		for _, task := range connector.Status.Tasks {
+			m.taskState.WithLabelValues(clusterName, connectorName, strconv.Itoa(task.ID), task.State).Set(1)
+		}
+	}
+}
+
+// deleteClusterStates removes all connector and task states of a cluster, e.g.
+// because the cluster is unreachable and the states are unknown.
+func (m *metrics) deleteClusterStates(clusterName string) {
+	m.connectorState.DeletePartialMatch(prometheus.Labels{"cluster": clusterName})
+	m.taskState.DeletePartialMatch(prometheus.Labels{"cluster": clusterName})
+}
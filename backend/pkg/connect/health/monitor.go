@@ -0,0 +1,313 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package health monitors the states of all connectors and tasks in the
+// configured Kafka connect clusters and restarts failed ones according to the
+// configured auto restart policy.
+package health
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/backoff"
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+const (
+	connectorStateFailed  = "FAILED"
+	connectorStateRunning = "RUNNING"
+)
+
+// Monitor periodically polls the states of all connectors and tasks.
+type Monitor struct {
+	cfg        config.ConnectHealthMonitor
+	logger     *zap.Logger
+	connectSvc *connect.Service
+	metrics    *metrics
+	events     *eventLog
+	backoff    backoff.ExponentialBackoff
+
+	tracePatterns     []*regexp.Regexp
+	connectorPatterns []*regexp.Regexp
+
+	// restartStatesByCluster tracks the restart attempts of all failed connectors
+	// and tasks. The outer map is never modified after creation, so that each
+	// cluster can be polled concurrently.
+	restartStatesByCluster map[string]map[restartKey]*restartState
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// restartKey identifies a connector or a single task of a connector.
+type restartKey struct {
+	connectorName string
+	target        RestartTarget
+	taskID        int
+}
+
+type restartState struct {
+	attempts     int
+	lastRestart  time.Time
+	runningSince time.Time
+	exhausted    bool
+}
+
+// NewMonitor creates a new health monitor for all clusters of the given connect service.
+func NewMonitor(cfg config.ConnectHealthMonitor, logger *zap.Logger, connectSvc *connect.Service, metricsNamespace string) (*Monitor, error) {
+	tracePatterns, err := compilePatterns(cfg.AutoRestart.TracePatterns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile trace patterns: %w", err)
+	}
+	connectorPatterns, err := compilePatterns(cfg.AutoRestart.ConnectorPatterns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile connector patterns: %w", err)
+	}
+
+	restartStatesByCluster := make(map[string]map[restartKey]*restartState, len(connectSvc.ClientsByCluster))
+	for clusterName := range connectSvc.ClientsByCluster {
+		restartStatesByCluster[clusterName] = make(map[restartKey]*restartState)
+	}
+
+	return &Monitor{
+		cfg:        cfg,
+		logger:     logger.Named("connect_health_monitor"),
+		connectSvc: connectSvc,
+		metrics:    newMetrics(metricsNamespace),
+		events:     newEventLog(cfg.MaxRestartEvents),
+		backoff: backoff.ExponentialBackoff{
+			BaseInterval: cfg.AutoRestart.BaseInterval,
+			MaxInterval:  cfg.AutoRestart.MaxInterval,
+			Multiplier:   cfg.AutoRestart.Multiplier,
+		},
+		tracePatterns:          tracePatterns,
+		connectorPatterns:      connectorPatterns,
+		restartStatesByCluster: restartStatesByCluster,
+		now:                    time.Now,
+	}, nil
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		r, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile pattern %q: %w", pattern, err)
+		}
+		compiled[i] = r
+	}
+	return compiled, nil
+}
+
+// Start polls the states of all connectors in the background until the
+// context is cancelled.
+func (m *Monitor) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(m.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			m.Poll(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RestartEvents returns the recorded restart events, the most recent event first.
+func (m *Monitor) RestartEvents(filter RestartEventFilter) []RestartEvent {
+	return m.events.list(filter)
+}
+
+// Poll fetches the states of all connectors in all clusters concurrently,
+// updates the metrics and restarts failed connectors and tasks.
+func (m *Monitor) Poll(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for clusterName, c := range m.connectSvc.ClientsByCluster {
+		wg.Add(1)
+		go func(clusterName string, client *con.Client) {
+			defer wg.Done()
+			m.pollCluster(ctx, clusterName, client)
+		}(clusterName, c.Client)
+	}
+	wg.Wait()
+}
+
+func (m *Monitor) pollCluster(ctx context.Context, clusterName string, client *con.Client) {
+	pollCtx, cancel := context.WithTimeout(ctx, m.connectSvc.Cfg.RequestTimeout)
+	connectors, err := client.ListConnectorsExpanded(pollCtx)
+	cancel()
+	if err != nil {
+		m.logger.Warn("failed to poll connector states",
+			zap.String("cluster_name", clusterName),
+			zap.Error(err))
+		m.metrics.pollErrors.WithLabelValues(clusterName).Inc()
+		m.metrics.deleteClusterStates(clusterName)
+		return
+	}
+	m.metrics.setClusterStates(clusterName, connectors)
+
+	if !m.cfg.AutoRestart.Enabled {
+		return
+	}
+
+	states := m.restartStatesByCluster[clusterName]
+	seen := make(map[restartKey]bool)
+	for connectorName, connector := range connectors {
+		key := restartKey{connectorName: connectorName, target: RestartTargetConnector}
+		seen[key] = true
+		m.evaluate(ctx, clusterName, key, states, connector.Status.Connector.State, connector.Status.Connector.Trace)
+
+		for _, task := range connector.Status.Tasks {
+			key := restartKey{connectorName: connectorName, target: RestartTargetTask, taskID: task.ID}
+			seen[key] = true
+			m.evaluate(ctx, clusterName, key, states, task.State, task.Trace)
+		}
+	}
+
+	// Forget connectors and tasks that no longer exist.
+	for key := range states {
+		if !seen[key] {
+			delete(states, key)
+		}
+	}
+}
+
+// evaluate applies the auto restart policy to a single connector or task.
+func (m *Monitor) evaluate(ctx context.Context, clusterName string, key restartKey, states map[restartKey]*restartState, state string, trace string) {
+	now := m.now()
+	st, tracked := states[key]
+
+	if state != connectorStateFailed {
+		if !tracked || state != connectorStateRunning {
+			return
+		}
+		if st.runningSince.IsZero() {
+			st.runningSince = now
+		}
+		if now.Sub(st.runningSince) >= m.cfg.AutoRestart.ResetAfter {
+			delete(states, key)
+		}
+		return
+	}
+
+	if !m.isRestartable(key.connectorName, trace) {
+		return
+	}
+	if !tracked {
+		st = &restartState{}
+		states[key] = st
+	}
+	st.runningSince = time.Time{}
+
+	event := RestartEvent{
+		ClusterName:   clusterName,
+		ConnectorName: key.connectorName,
+		Target:        key.target,
+		Attempt:       st.attempts + 1,
+		Timestamp:     now,
+		Trace:         trace,
+	}
+	if key.target == RestartTargetTask {
+		taskID := key.taskID
+		event.TaskID = &taskID
+	}
+
+	if st.attempts >= m.cfg.AutoRestart.MaxAttempts {
+		if !st.exhausted {
+			st.exhausted = true
+			event.Attempt = st.attempts
+			event.Outcome = RestartOutcomeExhausted
+			m.recordEvent(event)
+		}
+		return
+	}
+	if st.attempts > 0 && now.Before(st.lastRestart.Add(m.backoff.Backoff(st.attempts-1))) {
+		return
+	}
+
+	st.attempts++
+	st.lastRestart = now
+	event.Outcome = RestartOutcomeRestarted
+	if err := m.restart(ctx, clusterName, key); err != nil {
+		event.Outcome = RestartOutcomeFailed
+		event.Error = err.Error()
+	}
+	m.recordEvent(event)
+}
+
+func (m *Monitor) isRestartable(connectorName string, trace string) bool {
+	return matchesAny(m.connectorPatterns, connectorName) && matchesAny(m.tracePatterns, trace)
+}
+
+// matchesAny returns true if the value matches at least one pattern or if no
+// patterns are given.
+func matchesAny(patterns []*regexp.Regexp, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if pattern.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Monitor) restart(ctx context.Context, clusterName string, key restartKey) error {
+	ctx, cancel := context.WithTimeout(ctx, m.connectSvc.Cfg.RequestTimeout)
+	defer cancel()
+
+	if key.target == RestartTargetTask {
+		if restErr := m.connectSvc.RestartConnectorTask(ctx, clusterName, key.connectorName, key.taskID); restErr != nil {
+			return restErr.Err
+		}
+		return nil
+	}
+	// Failed tasks of a failed connector are restarted along with the connector.
+	if restErr := m.connectSvc.RestartConnector(ctx, clusterName, key.connectorName, true, true); restErr != nil {
+		return restErr.Err
+	}
+	return nil
+}
+
+func (m *Monitor) recordEvent(event RestartEvent) {
+	fields := []zap.Field{
+		zap.String("cluster_name", event.ClusterName),
+		zap.String("connector", event.ConnectorName),
+		zap.String("target", string(event.Target)),
+		zap.Int("attempt", event.Attempt),
+	}
+	if event.TaskID != nil {
+		fields = append(fields, zap.Int("task_id", *event.TaskID))
+	}
+	switch event.Outcome {
+	case RestartOutcomeRestarted:
+		m.logger.Info("restarted failed connector", fields...)
+	case RestartOutcomeFailed:
+		m.logger.Warn("failed to restart failed connector", append(fields, zap.String("error", event.Error))...)
+	case RestartOutcomeExhausted:
+		m.logger.Warn("giving up restarting failed connector, max attempts reached", fields...)
+	}
+
+	m.metrics.restarts.WithLabelValues(event.ClusterName, event.ConnectorName, string(event.Target), string(event.Outcome)).Inc()
+	m.events.add(event)
+}
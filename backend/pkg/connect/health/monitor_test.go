@@ -0,0 +1,185 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+// fakeConnectCluster serves the connector status and restart endpoints of the
+// Kafka connect REST API.
+type fakeConnectCluster struct {
+	mu           sync.Mutex
+	connectors   map[string]con.ListConnectorsResponseExpanded
+	taskRestarts map[string]int
+}
+
+func newFakeConnectCluster(t *testing.T) (*fakeConnectCluster, *httptest.Server) {
+	t.Helper()
+
+	f := &fakeConnectCluster{
+		connectors:   make(map[string]con.ListConnectorsResponseExpanded),
+		taskRestarts: make(map[string]int),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /connectors", func(w http.ResponseWriter, _ *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(f.connectors)
+	})
+	mux.HandleFunc("POST /connectors/{connector}/tasks/{taskID}/restart", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.taskRestarts[r.PathValue("connector")+"/"+r.PathValue("taskID")]++
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeConnectCluster) setTaskState(connectorName string, state string, trace string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.connectors[connectorName] = con.ListConnectorsResponseExpanded{
+		Info: con.ConnectorInfo{Name: connectorName},
+		Status: con.ConnectorStateInfo{
+			Name:      connectorName,
+			Connector: con.ConnectorState{State: connectorStateRunning},
+			Tasks:     []con.TaskState{{ID: 0, State: state, Trace: trace}},
+		},
+	}
+}
+
+func (f *fakeConnectCluster) restarts(key string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.taskRestarts[key]
+}
+
+func newTestMonitor(t *testing.T, autoRestart config.ConnectAutoRestart) (*Monitor, *fakeConnectCluster, *time.Time) {
+	t.Helper()
+
+	cluster, srv := newFakeConnectCluster(t)
+	connectCfg := config.Connect{}
+	connectCfg.SetDefaults()
+	connectCfg.Enabled = true
+	connectCfg.Clusters = []config.ConnectCluster{{Name: "local", URL: srv.URL}}
+	connectCfg.ConnectTimeout = time.Second
+	connectSvc, err := connect.NewService(connectCfg, zap.NewNop())
+	require.NoError(t, err)
+
+	cfg := connectCfg.HealthMonitor
+	cfg.Enabled = true
+	cfg.AutoRestart = autoRestart
+	require.NoError(t, cfg.Validate())
+
+	m, err := NewMonitor(cfg, zap.NewNop(), connectSvc, "console_test")
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+	return m, cluster, &now
+}
+
+func TestMonitorAutoRestart(t *testing.T) {
+	policy := config.ConnectAutoRestart{}
+	policy.SetDefaults()
+	policy.Enabled = true
+	policy.MaxAttempts = 2
+	policy.BaseInterval = time.Minute
+	policy.MaxInterval = time.Hour
+	policy.ResetAfter = 5 * time.Minute
+
+	m, cluster, now := newTestMonitor(t, policy)
+	ctx := context.Background()
+
+	cluster.setTaskState("orders", connectorStateFailed, "org.apache.kafka.common.errors.TimeoutException")
+	m.Poll(ctx)
+	assert.Equal(t, 1, cluster.restarts("orders/0"))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.metrics.taskState.WithLabelValues("local", "orders", "0", connectorStateFailed)))
+
+	// The second attempt must wait for the backoff.
+	*now = now.Add(30 * time.Second)
+	m.Poll(ctx)
+	assert.Equal(t, 1, cluster.restarts("orders/0"))
+
+	*now = now.Add(30 * time.Second)
+	m.Poll(ctx)
+	assert.Equal(t, 2, cluster.restarts("orders/0"))
+
+	// Max attempts are exhausted.
+	*now = now.Add(time.Hour)
+	m.Poll(ctx)
+	m.Poll(ctx)
+	assert.Equal(t, 2, cluster.restarts("orders/0"))
+
+	events := m.RestartEvents(RestartEventFilter{ConnectorName: "orders"})
+	require.Len(t, events, 3)
+	assert.Equal(t, RestartOutcomeExhausted, events[0].Outcome)
+	assert.Equal(t, RestartOutcomeRestarted, events[1].Outcome)
+	assert.Equal(t, 2, events[1].Attempt)
+	assert.Equal(t, RestartTargetTask, events[1].Target)
+	require.NotNil(t, events[1].TaskID)
+	assert.Equal(t, 0, *events[1].TaskID)
+	assert.Empty(t, m.RestartEvents(RestartEventFilter{ClusterName: "other"}))
+
+	// Attempts are reset once the task has been running long enough.
+	cluster.setTaskState("orders", connectorStateRunning, "")
+	m.Poll(ctx)
+	*now = now.Add(5 * time.Minute)
+	m.Poll(ctx)
+	assert.Equal(t, float64(0), testutil.ToFloat64(m.metrics.taskState.WithLabelValues("local", "orders", "0", connectorStateFailed)))
+
+	cluster.setTaskState("orders", connectorStateFailed, "TimeoutException")
+	m.Poll(ctx)
+	assert.Equal(t, 3, cluster.restarts("orders/0"))
+}
+
+func TestMonitorAutoRestartTracePatterns(t *testing.T) {
+	policy := config.ConnectAutoRestart{}
+	policy.SetDefaults()
+	policy.Enabled = true
+	policy.TracePatterns = []string{"TimeoutException"}
+
+	m, cluster, _ := newTestMonitor(t, policy)
+
+	cluster.setTaskState("orders", connectorStateFailed, "org.apache.kafka.connect.errors.DataException: invalid record")
+	m.Poll(context.Background())
+	assert.Equal(t, 0, cluster.restarts("orders/0"))
+	assert.Empty(t, m.RestartEvents(RestartEventFilter{}))
+}
+
+func TestEventLog(t *testing.T) {
+	log := newEventLog(2)
+	for i := 1; i <= 3; i++ {
+		log.add(RestartEvent{ConnectorName: "c", Attempt: i})
+	}
+	events := log.list(RestartEventFilter{})
+	require.Len(t, events, 2)
+	assert.Equal(t, 3, events[0].Attempt)
+	assert.Equal(t, 2, events[1].Attempt)
+}
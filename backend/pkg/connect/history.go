@@ -0,0 +1,210 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// HistoryOperation is the kind of change that has been recorded for a connector.
+type HistoryOperation string
+
+const (
+	// HistoryOperationCreate is recorded when a connector has been created.
+	HistoryOperationCreate HistoryOperation = "CREATE"
+	// HistoryOperationUpdate is recorded when a connector config has been overwritten.
+	HistoryOperationUpdate HistoryOperation = "UPDATE"
+	// HistoryOperationDelete is recorded when a connector has been deleted.
+	HistoryOperationDelete HistoryOperation = "DELETE"
+	// HistoryOperationRollback is recorded when a connector config has been rolled
+	// back to a previous version.
+	HistoryOperationRollback HistoryOperation = "ROLLBACK"
+)
+
+// RedactedValue replaces the values of sensitive config keys in the history.
+const RedactedValue = "[REDACTED]"
+
+// unknownActor is recorded if the request context does not carry an actor.
+const unknownActor = "unknown"
+
+// HistoryEntry is a single recorded change of a connector config. Configs are
+// stored as they were applied in Kafka connect, with sensitive values redacted.
+// ConfigBefore is nil for created connectors and ConfigAfter is nil for deleted
+// connectors.
+type HistoryEntry struct {
+	ClusterName         string            `json:"clusterName"`
+	ConnectorName       string            `json:"connectorName"`
+	Version             int               `json:"version"`
+	Operation           HistoryOperation  `json:"operation"`
+	Actor               string            `json:"actor"`
+	Timestamp           time.Time         `json:"timestamp"`
+	ConfigBefore        map[string]string `json:"configBefore"`
+	ConfigAfter         map[string]string `json:"configAfter"`
+	RolledBackToVersion int               `json:"rolledBackToVersion,omitempty"`
+}
+
+// HistoryStore persists the config history of connectors.
+type HistoryStore interface {
+	// Append assigns the next version of the connector to the entry and stores it.
+	Append(ctx context.Context, entry *HistoryEntry) error
+
+	// List returns all recorded entries of a connector ordered by version.
+	List(ctx context.Context, clusterName string, connectorName string) ([]HistoryEntry, error)
+}
+
+type actorContextKey struct{}
+
+// ContextWithActor returns a context that carries the identity of the user who
+// issues a request. The actor is recorded in the connector config history.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor that has been set with ContextWithActor.
+func ActorFromContext(ctx context.Context) string {
+	actor, ok := ctx.Value(actorContextKey{}).(string)
+	if !ok || actor == "" {
+		return unknownActor
+	}
+	return actor
+}
+
+// ActorFromLogFields builds the actor from the additional log fields of a
+// request, which identify the authenticated requester, e.g. "user=jane". The
+// fields are ordered by key. An empty string is returned if there are no
+// fields.
+func ActorFromLogFields(fields []zapcore.Field) string {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, field := range fields {
+		field.AddTo(enc)
+	}
+	keys := make([]string, 0, len(enc.Fields))
+	for key := range enc.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = fmt.Sprintf("%s=%v", key, enc.Fields[key])
+	}
+	return strings.Join(parts, " ")
+}
+
+// IsSensitiveKey returns true if the config value for the given key must not be
+// exposed, e.g. in the history.
+func (s *Service) IsSensitiveKey(key string) bool {
+	for _, pattern := range s.sensitiveKeyPatterns {
+		if pattern.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactConfig returns a copy of the config where all values of sensitive keys
+// have been replaced. Config provider placeholders such as ${secretsManager:...}
+// reference a secret rather than containing it and are therefore kept.
+func (s *Service) RedactConfig(cfg map[string]string) map[string]string {
+	if cfg == nil {
+		return nil
+	}
+	redacted := make(map[string]string, len(cfg))
+	for key, value := range cfg {
+		if s.IsSensitiveKey(key) && !isConfigProviderPlaceholder(value) {
+			value = RedactedValue
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
+
+var configProviderPlaceholder = regexp.MustCompile(`^\$\{[^}]+\}$`)
+
+func isConfigProviderPlaceholder(value string) bool {
+	return configProviderPlaceholder.MatchString(value)
+}
+
+// recordHistory appends a history entry for a change that has already been
+// applied in Kafka connect. Errors are logged only, because the change itself
+// succeeded and must not be reported as failed.
+func (s *Service) recordHistory(ctx context.Context, entry HistoryEntry) {
+	if s.History == nil {
+		return
+	}
+	entry.Actor = ActorFromContext(ctx)
+	entry.Timestamp = time.Now().UTC()
+	entry.ConfigBefore = s.RedactConfig(entry.ConfigBefore)
+	entry.ConfigAfter = s.RedactConfig(entry.ConfigAfter)
+
+	// The request context may be canceled as soon as the response has been sent.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.Cfg.RequestTimeout)
+	defer cancel()
+	if err := s.History.Append(ctx, &entry); err != nil {
+		s.Logger.Error("failed to record connector config history",
+			zap.String("cluster_name", entry.ClusterName),
+			zap.String("connector", entry.ConnectorName),
+			zap.String("operation", string(entry.Operation)),
+			zap.Error(err))
+	}
+}
+
+// ConfigChangeType describes how a single config key differs between two versions.
+type ConfigChangeType string
+
+const (
+	// ConfigChangeTypeAdded is used for keys that only exist in the newer config.
+	ConfigChangeTypeAdded ConfigChangeType = "ADDED"
+	// ConfigChangeTypeRemoved is used for keys that only exist in the older config.
+	ConfigChangeTypeRemoved ConfigChangeType = "REMOVED"
+	// ConfigChangeTypeChanged is used for keys whose value differs.
+	ConfigChangeTypeChanged ConfigChangeType = "CHANGED"
+)
+
+// ConfigChange is the difference of a single config key.
+type ConfigChange struct {
+	Key      string           `json:"key"`
+	Type     ConfigChangeType `json:"type"`
+	OldValue *string          `json:"oldValue,omitempty"`
+	NewValue *string          `json:"newValue,omitempty"`
+}
+
+// DiffConnectorConfigs compares two connector configs and returns all changes
+// sorted by key. Redacted values compare equal, even if the underlying secrets
+// differ.
+func DiffConnectorConfigs(before, after map[string]string) []ConfigChange {
+	changes := make([]ConfigChange, 0)
+	for key, oldValue := range before {
+		newValue, exists := after[key]
+		switch {
+		case !exists:
+			changes = append(changes, ConfigChange{Key: key, Type: ConfigChangeTypeRemoved, OldValue: &oldValue})
+		case newValue != oldValue:
+			changes = append(changes, ConfigChange{Key: key, Type: ConfigChangeTypeChanged, OldValue: &oldValue, NewValue: &newValue})
+		}
+	}
+	for key, newValue := range after {
+		if _, exists := before[key]; !exists {
+			changes = append(changes, ConfigChange{Key: key, Type: ConfigChangeTypeAdded, NewValue: &newValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return strings.Compare(changes[i].Key, changes[j].Key) < 0
+	})
+	return changes
+}
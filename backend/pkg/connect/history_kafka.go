@@ -0,0 +1,302 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// KafkaHistoryStore stores the connector config history in a compacted Kafka topic.
+// Each entry is written with a unique key, so that compaction never removes
+// history entries. All entries of a connector are produced to the same partition
+// and their versions are derived from the order in which they are consumed, so
+// that concurrent changes through different Console instances never share a
+// version. All entries are consumed into an in-memory index on start and the
+// topic is tailed afterwards, so that changes from other instances show up as well.
+type KafkaHistoryStore struct {
+	cfg    config.ConnectHistory
+	logger *zap.Logger
+
+	client    *kgo.Client
+	admClient *kadm.Client
+
+	indexMu sync.RWMutex
+	// entriesByConnector holds all entries sorted by version. The key is
+	// built by historyConnectorKey.
+	entriesByConnector map[string][]HistoryEntry
+	// versionsByRecord holds the assigned version of each indexed record.
+	versionsByRecord map[historyRecordPosition]int
+	// indexedOffsets holds the next offset to be indexed per partition.
+	indexedOffsets map[int32]int64
+	// indexed is closed and replaced whenever records have been indexed.
+	indexed chan struct{}
+
+	stopConsuming context.CancelFunc
+}
+
+// historyRecordKey is the key of each record in the history topic.
+type historyRecordKey struct {
+	ClusterName   string `json:"clusterName"`
+	ConnectorName string `json:"connectorName"`
+	ID            string `json:"id"`
+}
+
+// historyRecordPosition is the position of a record in the history topic.
+type historyRecordPosition struct {
+	Partition int32
+	Offset    int64
+}
+
+// NewKafkaHistoryStore creates a history store that uses a dedicated Kafka client,
+// which is created from the given client options.
+func NewKafkaHistoryStore(cfg config.ConnectHistory, logger *zap.Logger, opts []kgo.Opt) (*KafkaHistoryStore, error) {
+	opts = append(opts,
+		kgo.DefaultProduceTopic(cfg.Topic),
+		kgo.RecordPartitioner(kgo.BasicConsistentPartitioner(func(string) func(*kgo.Record, int) int {
+			return partitionHistoryRecord
+		})),
+		kgo.ConsumeTopics(cfg.Topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client: %w", err)
+	}
+
+	return &KafkaHistoryStore{
+		cfg:                cfg,
+		logger:             logger.Named("connect_history"),
+		client:             client,
+		admClient:          kadm.NewClient(client),
+		entriesByConnector: make(map[string][]HistoryEntry),
+		versionsByRecord:   make(map[historyRecordPosition]int),
+		indexedOffsets:     make(map[int32]int64),
+		indexed:            make(chan struct{}),
+	}, nil
+}
+
+// partitionHistoryRecord produces all records of a connector to the same
+// partition, so that their order in the topic is the order of the changes.
+func partitionHistoryRecord(record *kgo.Record, n int) int {
+	var key historyRecordKey
+	if err := json.Unmarshal(record.Key, &key); err != nil {
+		return 0
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(historyConnectorKey(key.ClusterName, key.ConnectorName)))
+	return int(hash.Sum32() % uint32(n))
+}
+
+// Start creates the history topic if it does not exist yet and blocks until all
+// existing entries have been consumed. Afterwards the topic is tailed in the
+// background until Close is called.
+func (s *KafkaHistoryStore) Start(ctx context.Context) error {
+	if err := s.ensureTopic(ctx); err != nil {
+		return err
+	}
+
+	endOffsets, err := s.admClient.ListEndOffsets(ctx, s.cfg.Topic)
+	if err == nil {
+		err = endOffsets.Error()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list end offsets of history topic: %w", err)
+	}
+	pending := make(map[int32]int64)
+	endOffsets.Each(func(o kadm.ListedOffset) {
+		if o.Offset > 0 {
+			pending[o.Partition] = o.Offset
+		}
+	})
+
+	consumeCtx, cancel := context.WithCancel(context.Background())
+	s.stopConsuming = cancel
+	caughtUp := make(chan struct{})
+	go s.consume(consumeCtx, pending, caughtUp)
+
+	select {
+	case <-caughtUp:
+		s.logger.Info("loaded connector config history", zap.String("topic", s.cfg.Topic))
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to load connector config history: %w", ctx.Err())
+	}
+}
+
+// Close stops tailing the history topic and closes the Kafka client.
+func (s *KafkaHistoryStore) Close() {
+	if s.stopConsuming != nil {
+		s.stopConsuming()
+	}
+	s.client.Close()
+}
+
+func (s *KafkaHistoryStore) ensureTopic(ctx context.Context) error {
+	cleanupPolicy := "compact"
+	_, err := s.admClient.CreateTopic(ctx, s.cfg.Partitions, s.cfg.ReplicationFactor,
+		map[string]*string{"cleanup.policy": &cleanupPolicy}, s.cfg.Topic)
+	if err != nil && !errors.Is(err, kerr.TopicAlreadyExists) {
+		return fmt.Errorf("failed to create history topic %q: %w", s.cfg.Topic, err)
+	}
+	return nil
+}
+
+// consume indexes all records of the history topic. The caughtUp channel is closed
+// as soon as all records up to the given end offsets have been consumed.
+func (s *KafkaHistoryStore) consume(ctx context.Context, pending map[int32]int64, caughtUp chan struct{}) {
+	isCaughtUp := false
+	markCaughtUp := func() {
+		if !isCaughtUp && len(pending) == 0 {
+			isCaughtUp = true
+			close(caughtUp)
+		}
+	}
+	markCaughtUp()
+
+	for {
+		fetches := s.client.PollFetches(ctx)
+		if ctx.Err() != nil || fetches.IsClientClosed() {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			s.logger.Warn("failed to fetch connector config history",
+				zap.String("topic", topic),
+				zap.Int32("partition", partition),
+				zap.Error(err))
+		})
+		fetches.EachRecord(func(record *kgo.Record) {
+			if endOffset, ok := pending[record.Partition]; ok && record.Offset+1 >= endOffset {
+				delete(pending, record.Partition)
+			}
+			s.indexRecord(record)
+		})
+		s.notifyIndexed()
+		markCaughtUp()
+	}
+}
+
+// indexRecord decodes a consumed record and indexes its entry.
+func (s *KafkaHistoryStore) indexRecord(record *kgo.Record) {
+	position := historyRecordPosition{Partition: record.Partition, Offset: record.Offset}
+	defer func() {
+		s.indexMu.Lock()
+		s.indexedOffsets[record.Partition] = record.Offset + 1
+		s.indexMu.Unlock()
+	}()
+
+	if record.Attrs.IsControl() || record.Value == nil {
+		return
+	}
+	var entry HistoryEntry
+	if err := json.Unmarshal(record.Value, &entry); err != nil {
+		s.logger.Warn("failed to decode connector config history record, skipping it",
+			zap.Int32("partition", record.Partition),
+			zap.Int64("offset", record.Offset),
+			zap.Error(err))
+		return
+	}
+	s.index(position, entry)
+}
+
+// index appends the entry at the given position to the entries of its connector
+// and assigns it the next version. Positions that have been indexed before are
+// ignored.
+func (s *KafkaHistoryStore) index(position historyRecordPosition, entry HistoryEntry) {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+
+	if _, ok := s.versionsByRecord[position]; ok {
+		return
+	}
+	key := historyConnectorKey(entry.ClusterName, entry.ConnectorName)
+	entry.Version = len(s.entriesByConnector[key]) + 1
+	s.entriesByConnector[key] = append(s.entriesByConnector[key], entry)
+	s.versionsByRecord[position] = entry.Version
+}
+
+// notifyIndexed wakes up all appends that wait for their record to be indexed.
+func (s *KafkaHistoryStore) notifyIndexed() {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+
+	close(s.indexed)
+	s.indexed = make(chan struct{})
+}
+
+// Append produces the entry to the history topic and waits until it has been
+// consumed again, so that the entry gets the version that all Console instances
+// derive from its position in the topic.
+func (s *KafkaHistoryStore) Append(ctx context.Context, entry *HistoryEntry) error {
+	key, err := json.Marshal(historyRecordKey{
+		ClusterName:   entry.ClusterName,
+		ConnectorName: entry.ConnectorName,
+		ID:            uuid.NewString(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode history record key: %w", err)
+	}
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode history record: %w", err)
+	}
+
+	record, err := s.client.ProduceSync(ctx, &kgo.Record{Key: key, Value: value}).First()
+	if err != nil {
+		return fmt.Errorf("failed to produce history record: %w", err)
+	}
+
+	position := historyRecordPosition{Partition: record.Partition, Offset: record.Offset}
+	for {
+		s.indexMu.RLock()
+		version, ok := s.versionsByRecord[position]
+		isIndexed := s.indexedOffsets[position.Partition] > position.Offset
+		indexed := s.indexed
+		s.indexMu.RUnlock()
+
+		if ok {
+			entry.Version = version
+			return nil
+		}
+		if isIndexed {
+			return fmt.Errorf("failed to index history record at partition %d offset %d", position.Partition, position.Offset)
+		}
+		select {
+		case <-indexed:
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait for history record to be indexed: %w", ctx.Err())
+		}
+	}
+}
+
+// List returns all recorded entries of a connector ordered by version.
+func (s *KafkaHistoryStore) List(_ context.Context, clusterName string, connectorName string) ([]HistoryEntry, error) {
+	s.indexMu.RLock()
+	defer s.indexMu.RUnlock()
+
+	entries := s.entriesByConnector[historyConnectorKey(clusterName, connectorName)]
+	return append([]HistoryEntry(nil), entries...), nil
+}
+
+func historyConnectorKey(clusterName string, connectorName string) string {
+	return clusterName + "\x00" + connectorName
+}
@@ -0,0 +1,457 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// DefaultLoggerLevelTTL is the time after which a logger level that has
+	// been set without TTL is reverted.
+	DefaultLoggerLevelTTL = 30 * time.Minute
+	// MaxLoggerLevelTTL is the maximum time a logger level can be set for.
+	MaxLoggerLevelTTL = 24 * time.Hour
+
+	rootLoggerName = "root"
+)
+
+// loggerLevels are the levels that Kafka connect accepts, from least to most verbose.
+var loggerLevels = []string{"OFF", "FATAL", "ERROR", "WARN", "INFO", "DEBUG", "TRACE"}
+
+// LoggerLevel is the level of a logger of the Kafka connect workers.
+type LoggerLevel struct {
+	// Name is the name of the logger, usually a class or package name. The
+	// root logger is named "root".
+	Name  string `json:"name"`
+	Level string `json:"level"`
+	// LastModified is the time the level has last been changed. It is nil if
+	// the level has not been changed since the worker started or if the Kafka
+	// connect version does not report it (before Kafka 3.7).
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	// RevertAt and RevertLevel are set if the level has been set via
+	// SetLoggerLevel and the revert to the previous level is pending.
+	RevertAt    *time.Time `json:"revertAt,omitempty"`
+	RevertLevel string     `json:"revertLevel,omitempty"`
+}
+
+// connectLoggerLevel is a logger level as returned by the admin/loggers endpoints.
+type connectLoggerLevel struct {
+	Level string `json:"level"`
+	// LastModified is the time of the last change in milliseconds.
+	LastModified *int64 `json:"last_modified,omitempty"`
+}
+
+// PendingLoggerRevert is a scheduled revert of a logger level that has been set
+// via SetLoggerLevel.
+type PendingLoggerRevert struct {
+	ClusterName string `json:"clusterName"`
+	LoggerName  string `json:"loggerName"`
+	// Level is the level before the first change, which the logger is
+	// reverted to.
+	Level    string    `json:"level"`
+	RevertAt time.Time `json:"revertAt"`
+	// AuditFields are the additional log fields of the request that has set
+	// the level, e.g. the requester. They are logged along with the revert.
+	AuditFields map[string]any `json:"auditFields,omitempty"`
+}
+
+// LoggerRevertStore persists pending logger level reverts, so that they are
+// carried out even if the Console instance that has set the level restarts.
+type LoggerRevertStore interface {
+	// Put stores or replaces the pending revert of a logger.
+	Put(ctx context.Context, revert PendingLoggerRevert) error
+
+	// Delete removes the pending revert of a logger after it has been carried out.
+	Delete(ctx context.Context, clusterName string, loggerName string) error
+}
+
+// loggerRevertRetryInterval is the time after which a failed revert is retried.
+const loggerRevertRetryInterval = time.Minute
+
+type loggerRevertKey struct {
+	clusterName string
+	loggerName  string
+}
+
+// loggerRevert is a scheduled revert of a logger level.
+type loggerRevert struct {
+	pending PendingLoggerRevert
+	timer   *time.Timer
+}
+
+// ListLoggers returns the levels of all loggers of the Kafka connect cluster,
+// ordered by name.
+func (s *Service) ListLoggers(ctx context.Context, clusterName string) ([]LoggerLevel, *rest.Error) {
+	loggers, restErr := s.listConnectLoggers(ctx, clusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	res := make([]LoggerLevel, 0, len(loggers))
+	for name, logger := range loggers {
+		res = append(res, s.newLoggerLevel(clusterName, name, logger))
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
+
+// GetLogger returns the level of a single logger of the Kafka connect cluster.
+func (s *Service) GetLogger(ctx context.Context, clusterName string, loggerName string) (LoggerLevel, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return LoggerLevel{}, restErr
+	}
+
+	var logger connectLoggerLevel
+	err := c.doRequest(ctx, http.MethodGet, loggerPath(loggerName), nil, &logger)
+	if err != nil {
+		return LoggerLevel{}, &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get logger: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("logger", loggerName)},
+			IsSilent:     false,
+		}
+	}
+	return s.newLoggerLevel(clusterName, loggerName, logger), nil
+}
+
+// SetLoggerLevel sets the level of a logger on all workers of the Kafka connect
+// cluster (cluster scope is available since Kafka 3.7). The level is reverted
+// to the level before the first change after the given TTL. Setting the level
+// again before the revert extends the TTL. A TTL of 0 uses the default TTL.
+// The audit fields, e.g. the requester, are logged along with the revert.
+func (s *Service) SetLoggerLevel(ctx context.Context, clusterName string, loggerName string, level string, ttl time.Duration, auditFields []zapcore.Field) (LoggerLevel, *rest.Error) {
+	level = strings.ToUpper(level)
+	if !slices.Contains(loggerLevels, level) {
+		return LoggerLevel{}, &rest.Error{
+			Err:      fmt.Errorf("invalid logger level %q", level),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Invalid logger level %q, must be one of %s", level, strings.Join(loggerLevels, ", ")),
+			IsSilent: false,
+		}
+	}
+	if ttl == 0 {
+		ttl = DefaultLoggerLevelTTL
+	}
+	if ttl < 0 || ttl > MaxLoggerLevelTTL {
+		return LoggerLevel{}, &rest.Error{
+			Err:      fmt.Errorf("invalid logger level ttl %v", ttl),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Invalid TTL, must be greater than 0 and at most %v", MaxLoggerLevelTTL),
+			IsSilent: false,
+		}
+	}
+
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return LoggerLevel{}, restErr
+	}
+
+	// 1. Determine the level to revert to. If a revert is already pending, the
+	// level before the first change is kept.
+	key := loggerRevertKey{clusterName: clusterName, loggerName: loggerName}
+	revertLevel := ""
+	s.loggerRevertsMu.Lock()
+	if pending, exists := s.loggerReverts[key]; exists {
+		revertLevel = pending.pending.Level
+	}
+	s.loggerRevertsMu.Unlock()
+	if revertLevel == "" {
+		loggers, restErr := s.listConnectLoggers(ctx, clusterName)
+		if restErr != nil {
+			return LoggerLevel{}, restErr
+		}
+		revertLevel = effectiveLoggerLevel(loggers, loggerName)
+		if revertLevel == "" {
+			return LoggerLevel{}, &rest.Error{
+				Err:          fmt.Errorf("failed to determine level of logger %q", loggerName),
+				Status:       http.StatusInternalServerError,
+				Message:      "Failed to determine the current level of the logger to revert to",
+				InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("logger", loggerName)},
+				IsSilent:     false,
+			}
+		}
+	}
+
+	// 2. Persist the revert before the level is changed, so that a change is
+	// never left without revert.
+	pending := PendingLoggerRevert{
+		ClusterName: clusterName,
+		LoggerName:  loggerName,
+		Level:       revertLevel,
+		RevertAt:    time.Now().Add(ttl),
+		AuditFields: encodeAuditFields(auditFields),
+	}
+	if s.LoggerReverts != nil {
+		if err := s.LoggerReverts.Put(ctx, pending); err != nil {
+			return LoggerLevel{}, &rest.Error{
+				Err:          err,
+				Status:       http.StatusServiceUnavailable,
+				Message:      fmt.Sprintf("Failed to persist the logger level revert: %v", err.Error()),
+				InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("logger", loggerName)},
+				IsSilent:     false,
+			}
+		}
+	}
+
+	// 3. Set the new level and schedule the revert. The revert is scheduled even
+	// if setting the level fails, as the level may have been set on some workers.
+	restErr = s.putLoggerLevel(ctx, c, loggerName, level)
+	s.scheduleLoggerRevert(pending)
+	if restErr != nil {
+		return LoggerLevel{}, restErr
+	}
+
+	now := time.Now()
+	return LoggerLevel{
+		Name:         loggerName,
+		Level:        level,
+		LastModified: &now,
+		RevertAt:     &pending.RevertAt,
+		RevertLevel:  revertLevel,
+	}, nil
+}
+
+// RestoreLoggerRevert schedules a pending revert that has been loaded from the
+// LoggerRevertStore, e.g. after a restart or because another Console instance
+// has set the level. Reverts that are overdue are carried out immediately.
+func (s *Service) RestoreLoggerRevert(pending PendingLoggerRevert) {
+	key := loggerRevertKey{clusterName: pending.ClusterName, loggerName: pending.LoggerName}
+	s.loggerRevertsMu.Lock()
+	existing, exists := s.loggerReverts[key]
+	s.loggerRevertsMu.Unlock()
+	if exists && existing.pending.RevertAt.Equal(pending.RevertAt) && existing.pending.Level == pending.Level {
+		return
+	}
+	s.scheduleLoggerRevert(pending)
+}
+
+// ForgetLoggerRevert cancels the scheduled revert of a logger, because it has
+// been carried out by another Console instance.
+func (s *Service) ForgetLoggerRevert(clusterName string, loggerName string) {
+	s.loggerRevertsMu.Lock()
+	defer s.loggerRevertsMu.Unlock()
+
+	key := loggerRevertKey{clusterName: clusterName, loggerName: loggerName}
+	if existing, exists := s.loggerReverts[key]; exists {
+		existing.timer.Stop()
+		delete(s.loggerReverts, key)
+	}
+}
+
+// Stop cancels all scheduled logger level reverts. Reverts that are not
+// persisted in a LoggerRevertStore would be lost and are therefore carried out
+// immediately.
+func (s *Service) Stop(ctx context.Context) {
+	s.loggerRevertsMu.Lock()
+	reverts := make([]PendingLoggerRevert, 0, len(s.loggerReverts))
+	for _, revert := range s.loggerReverts {
+		revert.timer.Stop()
+		reverts = append(reverts, revert.pending)
+	}
+	s.loggerReverts = nil
+	s.loggerRevertsMu.Unlock()
+
+	if s.LoggerReverts != nil {
+		return
+	}
+	for _, pending := range reverts {
+		s.applyLoggerRevert(ctx, pending)
+	}
+}
+
+// scheduleLoggerRevert schedules the revert and replaces a revert that is
+// already scheduled for the same logger.
+func (s *Service) scheduleLoggerRevert(pending PendingLoggerRevert) {
+	key := loggerRevertKey{clusterName: pending.ClusterName, loggerName: pending.LoggerName}
+	s.loggerRevertsMu.Lock()
+	defer s.loggerRevertsMu.Unlock()
+
+	if s.loggerReverts == nil {
+		s.loggerReverts = make(map[loggerRevertKey]*loggerRevert)
+	}
+	if existing, exists := s.loggerReverts[key]; exists {
+		existing.timer.Stop()
+	}
+	revert := &loggerRevert{pending: pending}
+	revert.timer = time.AfterFunc(time.Until(pending.RevertAt), func() { s.revertLoggerLevel(key, revert) })
+	s.loggerReverts[key] = revert
+}
+
+// revertLoggerLevel sets the level of a logger back to the level before it has
+// been changed via SetLoggerLevel. Failed reverts are retried.
+func (s *Service) revertLoggerLevel(key loggerRevertKey, revert *loggerRevert) {
+	s.loggerRevertsMu.Lock()
+	if s.loggerReverts[key] != revert {
+		// The level has been set again in the meantime and the revert rescheduled.
+		s.loggerRevertsMu.Unlock()
+		return
+	}
+	s.loggerRevertsMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.Cfg.RequestTimeout)
+	defer cancel()
+	if !s.applyLoggerRevert(ctx, revert.pending) {
+		s.loggerRevertsMu.Lock()
+		if s.loggerReverts[key] == revert {
+			revert.timer.Reset(loggerRevertRetryInterval)
+		}
+		s.loggerRevertsMu.Unlock()
+		return
+	}
+
+	s.loggerRevertsMu.Lock()
+	if s.loggerReverts[key] == revert {
+		delete(s.loggerReverts, key)
+	}
+	s.loggerRevertsMu.Unlock()
+}
+
+// applyLoggerRevert sets the level of the logger back and removes the revert
+// from the LoggerRevertStore. It returns false if the level could not be set.
+func (s *Service) applyLoggerRevert(ctx context.Context, pending PendingLoggerRevert) bool {
+	fields := append([]zapcore.Field{
+		zap.String("cluster_name", pending.ClusterName),
+		zap.String("logger", pending.LoggerName),
+		zap.String("level", pending.Level),
+	}, decodeAuditFields(pending.AuditFields)...)
+
+	c, restErr := s.getConnectClusterByName(pending.ClusterName)
+	if restErr != nil {
+		s.Logger.Error("failed to revert kafka connect logger level", append(fields, zap.Error(restErr.Err))...)
+		return false
+	}
+	if restErr := s.putLoggerLevel(ctx, c, pending.LoggerName, pending.Level); restErr != nil {
+		s.Logger.Error("failed to revert kafka connect logger level", append(fields, zap.Error(restErr.Err))...)
+		return false
+	}
+	s.Logger.Info("reverted kafka connect logger level after ttl", fields...)
+
+	if s.LoggerReverts != nil {
+		if err := s.LoggerReverts.Delete(ctx, pending.ClusterName, pending.LoggerName); err != nil {
+			s.Logger.Error("failed to remove reverted kafka connect logger level", append(fields, zap.Error(err))...)
+		}
+	}
+	return true
+}
+
+// encodeAuditFields converts the audit fields into a map that can be persisted.
+func encodeAuditFields(fields []zapcore.Field) map[string]any {
+	if len(fields) == 0 {
+		return nil
+	}
+	enc := zapcore.NewMapObjectEncoder()
+	for _, field := range fields {
+		field.AddTo(enc)
+	}
+	return enc.Fields
+}
+
+// decodeAuditFields converts persisted audit fields back to log fields, ordered
+// by key.
+func decodeAuditFields(fields map[string]any) []zapcore.Field {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	res := make([]zapcore.Field, len(keys))
+	for i, key := range keys {
+		res[i] = zap.Any(key, fields[key])
+	}
+	return res
+}
+
+func (s *Service) listConnectLoggers(ctx context.Context, clusterName string) (map[string]connectLoggerLevel, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	var loggers map[string]connectLoggerLevel
+	err := c.doRequest(ctx, http.MethodGet, "/admin/loggers", nil, &loggers)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to list loggers: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+			IsSilent:     false,
+		}
+	}
+	return loggers, nil
+}
+
+func (*Service) putLoggerLevel(ctx context.Context, c *ClientWithConfig, loggerName string, level string) *rest.Error {
+	err := c.doRequest(ctx, http.MethodPut, loggerPath(loggerName)+"?scope=cluster", connectLoggerLevel{Level: level}, nil)
+	if err != nil {
+		return &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to set logger level: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", c.Cfg.Name), zap.String("logger", loggerName), zap.String("level", level)},
+			IsSilent:     false,
+		}
+	}
+	return nil
+}
+
+// newLoggerLevel converts the logger level of Kafka connect and adds the
+// pending revert, if any.
+func (s *Service) newLoggerLevel(clusterName string, loggerName string, logger connectLoggerLevel) LoggerLevel {
+	res := LoggerLevel{Name: loggerName, Level: logger.Level}
+	if logger.LastModified != nil {
+		lastModified := time.UnixMilli(*logger.LastModified)
+		res.LastModified = &lastModified
+	}
+
+	s.loggerRevertsMu.Lock()
+	defer s.loggerRevertsMu.Unlock()
+	if revert, exists := s.loggerReverts[loggerRevertKey{clusterName: clusterName, loggerName: loggerName}]; exists {
+		revertAt := revert.pending.RevertAt
+		res.RevertAt = &revertAt
+		res.RevertLevel = revert.pending.Level
+	}
+	return res
+}
+
+// effectiveLoggerLevel returns the level of the logger. Loggers without their
+// own level inherit the level of their closest ancestor, e.g.
+// org.apache.kafka.connect inherits from org.apache.kafka, or the root logger.
+func effectiveLoggerLevel(loggers map[string]connectLoggerLevel, loggerName string) string {
+	for name := loggerName; name != ""; {
+		if logger, exists := loggers[name]; exists && logger.Level != "" {
+			return logger.Level
+		}
+		i := strings.LastIndex(name, ".")
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+	return loggers[rootLoggerName].Level
+}
+
+func loggerPath(loggerName string) string {
+	return "/admin/loggers/" + url.PathEscape(loggerName)
+}
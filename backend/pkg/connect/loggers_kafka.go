@@ -0,0 +1,208 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// KafkaLoggerRevertStore stores the pending logger level reverts in a compacted
+// Kafka topic. Each logger is written with its own key and carried out reverts
+// are deleted with a tombstone. All records are consumed on start and the topic
+// is tailed afterwards, so that every Console instance schedules the reverts of
+// all instances. Reverts may therefore be carried out by multiple instances,
+// which is harmless as they all set the same level.
+type KafkaLoggerRevertStore struct {
+	cfg    config.ConnectLoggerReverts
+	logger *zap.Logger
+
+	client    *kgo.Client
+	admClient *kadm.Client
+
+	stopConsuming context.CancelFunc
+}
+
+// loggerRevertRecordKey is the key of each record in the logger reverts topic.
+type loggerRevertRecordKey struct {
+	ClusterName string `json:"clusterName"`
+	LoggerName  string `json:"loggerName"`
+}
+
+// NewKafkaLoggerRevertStore creates a logger revert store that uses a dedicated
+// Kafka client, which is created from the given client options.
+func NewKafkaLoggerRevertStore(cfg config.ConnectLoggerReverts, logger *zap.Logger, opts []kgo.Opt) (*KafkaLoggerRevertStore, error) {
+	opts = append(opts,
+		kgo.DefaultProduceTopic(cfg.Topic),
+		kgo.ConsumeTopics(cfg.Topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client: %w", err)
+	}
+
+	return &KafkaLoggerRevertStore{
+		cfg:       cfg,
+		logger:    logger.Named("connect_logger_reverts"),
+		client:    client,
+		admClient: kadm.NewClient(client),
+	}, nil
+}
+
+// Start creates the logger reverts topic if it does not exist yet and blocks
+// until all pending reverts have been consumed and scheduled in the given
+// service. Afterwards the topic is tailed in the background until Close is
+// called.
+func (s *KafkaLoggerRevertStore) Start(ctx context.Context, svc *Service) error {
+	cleanupPolicy := "compact"
+	_, err := s.admClient.CreateTopic(ctx, s.cfg.Partitions, s.cfg.ReplicationFactor,
+		map[string]*string{"cleanup.policy": &cleanupPolicy}, s.cfg.Topic)
+	if err != nil && !errors.Is(err, kerr.TopicAlreadyExists) {
+		return fmt.Errorf("failed to create logger reverts topic %q: %w", s.cfg.Topic, err)
+	}
+
+	endOffsets, err := s.admClient.ListEndOffsets(ctx, s.cfg.Topic)
+	if err == nil {
+		err = endOffsets.Error()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list end offsets of logger reverts topic: %w", err)
+	}
+	pending := make(map[int32]int64)
+	endOffsets.Each(func(o kadm.ListedOffset) {
+		if o.Offset > 0 {
+			pending[o.Partition] = o.Offset
+		}
+	})
+
+	consumeCtx, cancel := context.WithCancel(context.Background())
+	s.stopConsuming = cancel
+	caughtUp := make(chan struct{})
+	go s.consume(consumeCtx, svc, pending, caughtUp)
+
+	select {
+	case <-caughtUp:
+		s.logger.Info("loaded pending logger level reverts", zap.String("topic", s.cfg.Topic))
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to load pending logger level reverts: %w", ctx.Err())
+	}
+}
+
+// Close stops tailing the logger reverts topic and closes the Kafka client.
+func (s *KafkaLoggerRevertStore) Close() {
+	if s.stopConsuming != nil {
+		s.stopConsuming()
+	}
+	s.client.Close()
+}
+
+// consume schedules or cancels the reverts of all records in the logger reverts
+// topic. The caughtUp channel is closed as soon as all records up to the given
+// end offsets have been consumed.
+func (s *KafkaLoggerRevertStore) consume(ctx context.Context, svc *Service, pending map[int32]int64, caughtUp chan struct{}) {
+	isCaughtUp := false
+	markCaughtUp := func() {
+		if !isCaughtUp && len(pending) == 0 {
+			isCaughtUp = true
+			close(caughtUp)
+		}
+	}
+	markCaughtUp()
+
+	for {
+		fetches := s.client.PollFetches(ctx)
+		if ctx.Err() != nil || fetches.IsClientClosed() {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			s.logger.Warn("failed to fetch pending logger level reverts",
+				zap.String("topic", topic),
+				zap.Int32("partition", partition),
+				zap.Error(err))
+		})
+		fetches.EachRecord(func(record *kgo.Record) {
+			if endOffset, ok := pending[record.Partition]; ok && record.Offset+1 >= endOffset {
+				delete(pending, record.Partition)
+			}
+			if record.Attrs.IsControl() {
+				return
+			}
+			if record.Value == nil {
+				var key loggerRevertRecordKey
+				if err := json.Unmarshal(record.Key, &key); err != nil {
+					s.logger.Warn("failed to decode logger level revert record key, skipping it",
+						zap.Int32("partition", record.Partition),
+						zap.Int64("offset", record.Offset),
+						zap.Error(err))
+					return
+				}
+				svc.ForgetLoggerRevert(key.ClusterName, key.LoggerName)
+				return
+			}
+			var revert PendingLoggerRevert
+			if err := json.Unmarshal(record.Value, &revert); err != nil {
+				s.logger.Warn("failed to decode logger level revert record, skipping it",
+					zap.Int32("partition", record.Partition),
+					zap.Int64("offset", record.Offset),
+					zap.Error(err))
+				return
+			}
+			svc.RestoreLoggerRevert(revert)
+		})
+		markCaughtUp()
+	}
+}
+
+// Put produces the pending revert to the logger reverts topic.
+func (s *KafkaLoggerRevertStore) Put(ctx context.Context, revert PendingLoggerRevert) error {
+	key, err := loggerRevertRecordKeyBytes(revert.ClusterName, revert.LoggerName)
+	if err != nil {
+		return err
+	}
+	value, err := json.Marshal(revert)
+	if err != nil {
+		return fmt.Errorf("failed to encode logger level revert record: %w", err)
+	}
+	if err := s.client.ProduceSync(ctx, &kgo.Record{Key: key, Value: value}).FirstErr(); err != nil {
+		return fmt.Errorf("failed to produce logger level revert record: %w", err)
+	}
+	return nil
+}
+
+// Delete produces a tombstone for the pending revert of the logger.
+func (s *KafkaLoggerRevertStore) Delete(ctx context.Context, clusterName string, loggerName string) error {
+	key, err := loggerRevertRecordKeyBytes(clusterName, loggerName)
+	if err != nil {
+		return err
+	}
+	if err := s.client.ProduceSync(ctx, &kgo.Record{Key: key}).FirstErr(); err != nil {
+		return fmt.Errorf("failed to produce logger level revert tombstone: %w", err)
+	}
+	return nil
+}
+
+func loggerRevertRecordKeyBytes(clusterName string, loggerName string) ([]byte, error) {
+	key, err := json.Marshal(loggerRevertRecordKey{ClusterName: clusterName, LoggerName: loggerName})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode logger level revert record key: %w", err)
+	}
+	return key, nil
+}
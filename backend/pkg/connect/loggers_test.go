@@ -0,0 +1,152 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestSetLoggerLevelReverts(t *testing.T) {
+	svc, mock := newOffsetsTestService(t)
+	ctx := context.Background()
+	mock.SetLoggerLevel("org.apache.kafka.connect", "WARN")
+	const loggerName = "org.apache.kafka.connect.runtime.WorkerSinkTask"
+
+	res, restErr := svc.SetLoggerLevel(ctx, "local", loggerName, "debug", 200*time.Millisecond, nil)
+	require.Nil(t, restErr)
+	assert.Equal(t, "DEBUG", res.Level)
+	assert.Equal(t, "WARN", res.RevertLevel)
+	require.NotNil(t, res.RevertAt)
+	level, _ := mock.LoggerLevel(loggerName)
+	assert.Equal(t, "DEBUG", level)
+
+	// Setting the level again keeps the level to revert to.
+	res, restErr = svc.SetLoggerLevel(ctx, "local", loggerName, "TRACE", 200*time.Millisecond, nil)
+	require.Nil(t, restErr)
+	assert.Equal(t, "WARN", res.RevertLevel)
+
+	logger, restErr := svc.GetLogger(ctx, "local", loggerName)
+	require.Nil(t, restErr)
+	assert.Equal(t, "TRACE", logger.Level)
+	assert.Equal(t, "WARN", logger.RevertLevel)
+
+	assert.Eventually(t, func() bool {
+		level, _ := mock.LoggerLevel(loggerName)
+		return level == "WARN"
+	}, 5*time.Second, 20*time.Millisecond)
+
+	loggers, restErr := svc.ListLoggers(ctx, "local")
+	require.Nil(t, restErr)
+	require.Len(t, loggers, 3)
+	assert.Equal(t, loggerName, loggers[1].Name)
+	assert.Nil(t, loggers[1].RevertAt)
+	assert.Equal(t, "root", loggers[2].Name)
+}
+
+type memoryLoggerRevertStore struct {
+	mu      sync.Mutex
+	reverts map[string]PendingLoggerRevert
+}
+
+func (s *memoryLoggerRevertStore) Put(_ context.Context, revert PendingLoggerRevert) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reverts[revert.ClusterName+"/"+revert.LoggerName] = revert
+	return nil
+}
+
+func (s *memoryLoggerRevertStore) Delete(_ context.Context, clusterName string, loggerName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.reverts, clusterName+"/"+loggerName)
+	return nil
+}
+
+func (s *memoryLoggerRevertStore) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.reverts)
+}
+
+func TestSetLoggerLevelPersistsRevert(t *testing.T) {
+	svc, mock := newOffsetsTestService(t)
+	store := &memoryLoggerRevertStore{reverts: make(map[string]PendingLoggerRevert)}
+	svc.LoggerReverts = store
+	ctx := context.Background()
+
+	_, restErr := svc.SetLoggerLevel(ctx, "local", "root", "DEBUG", time.Hour, []zapcore.Field{zap.String("user", "jane")})
+	require.Nil(t, restErr)
+	revert := store.reverts["local/root"]
+	assert.Equal(t, "INFO", revert.Level)
+	assert.Equal(t, map[string]any{"user": "jane"}, revert.AuditFields)
+
+	// Persisted reverts are left to the store on shutdown.
+	svc.Stop(ctx)
+	level, _ := mock.LoggerLevel("root")
+	assert.Equal(t, "DEBUG", level)
+
+	// Overdue reverts that are restored, e.g. after a restart, are carried out
+	// immediately and removed from the store.
+	revert.RevertAt = time.Now().Add(-time.Minute)
+	svc.RestoreLoggerRevert(revert)
+	assert.Eventually(t, func() bool {
+		level, _ := mock.LoggerLevel("root")
+		return level == "INFO" && store.len() == 0
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestStopRevertsPendingLoggerLevels(t *testing.T) {
+	svc, mock := newOffsetsTestService(t)
+	ctx := context.Background()
+
+	_, restErr := svc.SetLoggerLevel(ctx, "local", "root", "TRACE", time.Hour, nil)
+	require.Nil(t, restErr)
+	svc.Stop(ctx)
+
+	level, _ := mock.LoggerLevel("root")
+	assert.Equal(t, "INFO", level)
+}
+
+func TestSetLoggerLevelValidation(t *testing.T) {
+	svc, _ := newOffsetsTestService(t)
+	ctx := context.Background()
+
+	_, restErr := svc.SetLoggerLevel(ctx, "local", "root", "VERBOSE", time.Minute, nil)
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusBadRequest, restErr.Status)
+
+	_, restErr = svc.SetLoggerLevel(ctx, "local", "root", "DEBUG", MaxLoggerLevelTTL+time.Second, nil)
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusBadRequest, restErr.Status)
+
+	_, restErr = svc.GetLogger(ctx, "local", "org.example.Missing")
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusNotFound, restErr.Status)
+}
+
+func TestEffectiveLoggerLevel(t *testing.T) {
+	loggers := map[string]connectLoggerLevel{
+		"root":                     {Level: "INFO"},
+		"org.apache.kafka.connect": {Level: "WARN"},
+	}
+	assert.Equal(t, "WARN", effectiveLoggerLevel(loggers, "org.apache.kafka.connect"))
+	assert.Equal(t, "WARN", effectiveLoggerLevel(loggers, "org.apache.kafka.connect.runtime.Worker"))
+	assert.Equal(t, "INFO", effectiveLoggerLevel(loggers, "org.apache.kafka.clients"))
+	assert.Equal(t, "INFO", effectiveLoggerLevel(loggers, "root"))
+}
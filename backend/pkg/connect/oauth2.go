@@ -0,0 +1,169 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// oauth2TokenExpiryDelta is the time before the expiry of an access token at
+// which a new token is requested.
+const oauth2TokenExpiryDelta = 30 * time.Second
+
+// oauth2TokenSource requests access tokens using the OAuth2 client credentials
+// grant and caches them until shortly before they expire.
+type oauth2TokenSource struct {
+	cfg        config.ConnectClusterOAuth2
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func newOAuth2TokenSource(cfg config.ConnectClusterOAuth2) *oauth2TokenSource {
+	return &oauth2TokenSource{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		now:        time.Now,
+	}
+}
+
+// Token returns a cached access token or requests a new one if there is no
+// valid token.
+func (t *oauth2TokenSource) Token(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.accessToken != "" && (t.expiresAt.IsZero() || t.now().Before(t.expiresAt.Add(-oauth2TokenExpiryDelta))) {
+		return t.accessToken, nil
+	}
+
+	accessToken, expiresIn, err := t.requestToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	t.accessToken = accessToken
+	t.expiresAt = time.Time{}
+	if expiresIn > 0 {
+		t.expiresAt = t.now().Add(expiresIn)
+	}
+	return t.accessToken, nil
+}
+
+// Invalidate drops the cached token, so that the next call to Token requests a
+// new one.
+func (t *oauth2TokenSource) Invalidate(accessToken string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.accessToken == accessToken {
+		t.accessToken = ""
+	}
+}
+
+func (t *oauth2TokenSource) requestToken(ctx context.Context) (string, time.Duration, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if len(t.cfg.Scopes) > 0 {
+		form.Set("scope", strings.Join(t.cfg.Scopes, " "))
+	}
+	if t.cfg.Audience != "" {
+		form.Set("audience", t.cfg.Audience)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.cfg.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.SetBasicAuth(t.cfg.ClientID, t.cfg.ClientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to request oauth2 token: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read oauth2 token response: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("oauth2 token request failed with status code %d: %s", res.StatusCode, string(body))
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return "", 0, fmt.Errorf("failed to parse oauth2 token response: %w", err)
+	}
+	if tokenResponse.AccessToken == "" {
+		return "", 0, errors.New("access_token not found in oauth2 token response")
+	}
+	return tokenResponse.AccessToken, time.Duration(tokenResponse.ExpiresIn) * time.Second, nil
+}
+
+// oauth2Transport sets the access token of the token source on all requests.
+// Requests that are rejected with status 401 are retried once with a new token,
+// because tokens may be revoked before they expire.
+type oauth2Transport struct {
+	tokenSource *oauth2TokenSource
+	base        http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *oauth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, accessToken, err := t.roundTrip(req, req.Body)
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return res, nil
+	}
+
+	t.tokenSource.Invalidate(accessToken)
+	body := req.Body
+	if req.GetBody != nil {
+		if body, err = req.GetBody(); err != nil {
+			return res, nil
+		}
+	}
+	res.Body.Close()
+	res, _, err = t.roundTrip(req, body)
+	return res, err
+}
+
+func (t *oauth2Transport) roundTrip(req *http.Request, body io.ReadCloser) (*http.Response, string, error) {
+	accessToken, err := t.tokenSource.Token(req.Context())
+	if err != nil {
+		return nil, "", err
+	}
+	authReq := req.Clone(req.Context())
+	authReq.Body = body
+	authReq.Header.Set("Authorization", "Bearer "+accessToken)
+	res, err := t.base.RoundTrip(authReq)
+	return res, accessToken, err
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestOAuth2ClientCredentials(t *testing.T) {
+	var issued atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, _ := r.BasicAuth()
+		if clientID != "console" || clientSecret != "secret" || r.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "connect:read connect:write", r.FormValue("scope"))
+		n := issued.Add(1)
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":300}`, n)
+	}))
+	defer tokenServer.Close()
+
+	// The gateway only accepts the most recently issued token.
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != fmt.Sprintf("Bearer token-%d", issued.Load()) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"version":"3.7.0","commit":"abc","kafka_cluster_id":"cluster"}`))
+	}))
+	defer gateway.Close()
+
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.Clusters = []config.ConnectCluster{{
+		Name: "gateway",
+		URL:  gateway.URL,
+		OAuth2: config.ConnectClusterOAuth2{
+			Enabled:       true,
+			TokenEndpoint: tokenServer.URL,
+			ClientID:      "console",
+			ClientSecret:  "secret",
+			Scopes:        []string{"connect:read", "connect:write"},
+		},
+	}}
+	require.NoError(t, cfg.Validate())
+
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	c := svc.ClientsByCluster["gateway"]
+	tokenSource := c.Client.GetClient().Transport.(*oauth2Transport).tokenSource
+	now := time.Now()
+	tokenSource.now = func() time.Time { return now }
+
+	// The token is cached across requests.
+	_, err = c.Client.GetRoot(context.Background())
+	require.NoError(t, err)
+	_, err = c.Client.GetRoot(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, issued.Load())
+
+	// A new token is requested shortly before the token expires.
+	now = now.Add(280 * time.Second)
+	_, err = c.Client.GetRoot(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, issued.Load())
+
+	// Rejected tokens are replaced once.
+	issued.Add(1)
+	_, err = c.Client.GetRoot(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, issued.Load())
+}
+
+func TestConnectClusterAuthValidation(t *testing.T) {
+	cluster := config.ConnectCluster{
+		Name:     "local",
+		URL:      "http://localhost:8083",
+		Username: "user",
+		Password: "password",
+		OAuth2:   config.ConnectClusterOAuth2{Enabled: true, TokenEndpoint: "http://idp", ClientID: "id", ClientSecret: "secret"},
+	}
+	require.Error(t, cluster.Validate())
+
+	cluster.Username = ""
+	require.NoError(t, cluster.Validate())
+
+	cluster.URL = ""
+	require.Error(t, cluster.Validate())
+	cluster.Discovery = config.ConnectClusterDiscovery{
+		Enabled: true,
+		Type:    config.ConnectClusterDiscoveryTypeDNSSRV,
+		DNSSRV:  config.ConnectClusterDiscoveryDNSSRV{Name: "_connect._tcp.example.com"},
+	}
+	require.NoError(t, cluster.Validate())
+
+	cluster.TLS = config.TLS{Enabled: true, CertFilepath: "client.crt"}
+	require.ErrorContains(t, cluster.Validate(), "keyFilepath")
+}
@@ -0,0 +1,328 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	connectorTypeSink = "sink"
+
+	// Keys that Kafka connect uses for the partitions and offsets of sink connectors.
+	sinkOffsetTopicKey     = "kafka_topic"
+	sinkOffsetPartitionKey = "kafka_partition"
+	sinkOffsetOffsetKey    = "kafka_offset"
+
+	sinkConsumerGroupConfigKey = "consumer.override.group.id"
+)
+
+// ConnectorOffset is a single offset as it is returned and accepted by the
+// offsets endpoints of Kafka connect (available since Kafka 3.6). The structure
+// of partition and offset is defined by the connector. A nil offset resets the
+// offset of the partition.
+type ConnectorOffset struct {
+	Partition map[string]any `json:"partition"`
+	Offset    map[string]any `json:"offset"`
+}
+
+// ConnectorOffsets is the request and response body of the offsets endpoints.
+type ConnectorOffsets struct {
+	Offsets []ConnectorOffset `json:"offsets"`
+}
+
+// SinkConnectorOffset is the offset of a sink connector as consumer group offset.
+type SinkConnectorOffset struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	// Offset is the next offset to consume. A nil offset resets the offset of the partition.
+	Offset *int64 `json:"offset"`
+}
+
+// ConnectorOffsetsResponse contains the offsets of a connector. The offsets of
+// sink connectors are additionally translated to consumer group offsets.
+type ConnectorOffsetsResponse struct {
+	ClusterName   string            `json:"clusterName"`
+	ConnectorName string            `json:"connectorName"`
+	Type          string            `json:"type"`
+	Offsets       []ConnectorOffset `json:"offsets"`
+
+	// ConsumerGroupID and SinkOffsets are only set for sink connectors.
+	ConsumerGroupID string                `json:"consumerGroupId,omitempty"`
+	SinkOffsets     []SinkConnectorOffset `json:"sinkOffsets,omitempty"`
+}
+
+// GetConnectorOffsets returns the current offsets of a connector.
+func (s *Service) GetConnectorOffsets(ctx context.Context, clusterName string, connector string) (ConnectorOffsetsResponse, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return ConnectorOffsetsResponse{}, restErr
+	}
+
+	stateInfo, err := c.Client.GetConnectorStatus(ctx, connector)
+	if err != nil {
+		return ConnectorOffsetsResponse{}, &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get connector state: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	var offsets ConnectorOffsets
+	err = c.doRequest(ctx, http.MethodGet, connectorOffsetsPath(connector), nil, &offsets)
+	if err != nil {
+		return ConnectorOffsetsResponse{}, &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get connector offsets: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	res := ConnectorOffsetsResponse{
+		ClusterName:   clusterName,
+		ConnectorName: connector,
+		Type:          stateInfo.Type,
+		Offsets:       offsets.Offsets,
+	}
+	if res.Offsets == nil {
+		res.Offsets = make([]ConnectorOffset, 0)
+	}
+	if stateInfo.Type != connectorTypeSink {
+		return res, nil
+	}
+
+	cfg, err := c.Client.GetConnectorConfig(ctx, connector)
+	if err != nil {
+		return ConnectorOffsetsResponse{}, &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get connector config: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+	res.ConsumerGroupID = sinkConsumerGroupID(connector, cfg)
+	res.SinkOffsets, err = connectorOffsetsToSinkOffsets(res.Offsets)
+	if err != nil {
+		return ConnectorOffsetsResponse{}, &rest.Error{
+			Err:          err,
+			Status:       http.StatusInternalServerError,
+			Message:      fmt.Sprintf("Failed to translate sink connector offsets: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	return res, nil
+}
+
+// AlterConnectorOffsets alters the offsets of the given partitions. Partitions that
+// are not specified are left untouched. The connector must be stopped.
+func (s *Service) AlterConnectorOffsets(ctx context.Context, clusterName string, connector string, offsets []ConnectorOffset) (string, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return "", restErr
+	}
+	if len(offsets) == 0 {
+		return "", &rest.Error{
+			Err:      errors.New("no offsets given"),
+			Status:   http.StatusBadRequest,
+			Message:  "At least one offset must be given",
+			IsSilent: false,
+		}
+	}
+	if _, restErr := s.ensureConnectorStopped(ctx, c, clusterName, connector); restErr != nil {
+		return "", restErr
+	}
+
+	var res connectorOffsetsMessage
+	err := c.doRequest(ctx, http.MethodPatch, connectorOffsetsPath(connector), ConnectorOffsets{Offsets: offsets}, &res)
+	if err != nil {
+		return "", &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to alter connector offsets: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	return res.Message, nil
+}
+
+// AlterSinkConnectorOffsets alters the offsets of a sink connector, which are given
+// as consumer group offsets. The connector must be stopped.
+func (s *Service) AlterSinkConnectorOffsets(ctx context.Context, clusterName string, connector string, offsets []SinkConnectorOffset) (string, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return "", restErr
+	}
+	connectorType, restErr := s.ensureConnectorStopped(ctx, c, clusterName, connector)
+	if restErr != nil {
+		return "", restErr
+	}
+	if connectorType != connectorTypeSink {
+		return "", &rest.Error{
+			Err:          fmt.Errorf("connector is of type %q", connectorType),
+			Status:       http.StatusBadRequest,
+			Message:      "Consumer group offsets can only be set for sink connectors",
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	return s.AlterConnectorOffsets(ctx, clusterName, connector, sinkOffsetsToConnectorOffsets(offsets))
+}
+
+// ResetConnectorOffsets resets all offsets of a connector. The connector must be stopped.
+func (s *Service) ResetConnectorOffsets(ctx context.Context, clusterName string, connector string) (string, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return "", restErr
+	}
+	if _, restErr := s.ensureConnectorStopped(ctx, c, clusterName, connector); restErr != nil {
+		return "", restErr
+	}
+
+	var res connectorOffsetsMessage
+	err := c.doRequest(ctx, http.MethodDelete, connectorOffsetsPath(connector), nil, &res)
+	if err != nil {
+		return "", &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to reset connector offsets: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	return res.Message, nil
+}
+
+func connectorOffsetsPath(connector string) string {
+	return "/connectors/" + url.PathEscape(connector) + "/offsets"
+}
+
+// connectorOffsetsMessage is returned by Kafka connect after offsets have been
+// altered or reset.
+type connectorOffsetsMessage struct {
+	Message string `json:"message"`
+}
+
+// ensureConnectorStopped returns an error if the connector is not in the STOPPED
+// state, because Kafka connect only allows to modify offsets of stopped connectors.
+// The connector type is returned, so that callers can avoid another request.
+func (*Service) ensureConnectorStopped(ctx context.Context, c *ClientWithConfig, clusterName string, connector string) (string, *rest.Error) {
+	stateInfo, err := c.Client.GetConnectorStatus(ctx, connector)
+	if err != nil {
+		return "", &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get connector state: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+	if stateInfo.Connector.State != connectorStateStopped {
+		return "", &rest.Error{
+			Err:      fmt.Errorf("connector is in state %q", stateInfo.Connector.State),
+			Status:   http.StatusConflict,
+			Message:  fmt.Sprintf("Connector must be stopped before its offsets can be modified, current state is %v", stateInfo.Connector.State),
+			IsSilent: false,
+		}
+	}
+	return stateInfo.Type, nil
+}
+
+// sinkConsumerGroupID returns the consumer group that Kafka connect uses for a
+// sink connector.
+func sinkConsumerGroupID(connector string, cfg map[string]string) string {
+	if groupID := cfg[sinkConsumerGroupConfigKey]; groupID != "" {
+		return groupID
+	}
+	return "connect-" + connector
+}
+
+func connectorOffsetsToSinkOffsets(offsets []ConnectorOffset) ([]SinkConnectorOffset, error) {
+	sinkOffsets := make([]SinkConnectorOffset, 0, len(offsets))
+	for _, o := range offsets {
+		topic, ok := o.Partition[sinkOffsetTopicKey].(string)
+		if !ok {
+			return nil, fmt.Errorf("partition is missing the %q key", sinkOffsetTopicKey)
+		}
+		partition, err := int64FromJSONValue(o.Partition[sinkOffsetPartitionKey])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %q of topic %q: %w", sinkOffsetPartitionKey, topic, err)
+		}
+		sinkOffset := SinkConnectorOffset{Topic: topic, Partition: int32(partition)}
+		if o.Offset != nil {
+			offset, err := int64FromJSONValue(o.Offset[sinkOffsetOffsetKey])
+			if err != nil {
+				return nil, fmt.Errorf("invalid %q of topic %q: %w", sinkOffsetOffsetKey, topic, err)
+			}
+			sinkOffset.Offset = &offset
+		}
+		sinkOffsets = append(sinkOffsets, sinkOffset)
+	}
+
+	sort.Slice(sinkOffsets, func(i, j int) bool {
+		if sinkOffsets[i].Topic != sinkOffsets[j].Topic {
+			return sinkOffsets[i].Topic < sinkOffsets[j].Topic
+		}
+		return sinkOffsets[i].Partition < sinkOffsets[j].Partition
+	})
+	return sinkOffsets, nil
+}
+
+func sinkOffsetsToConnectorOffsets(sinkOffsets []SinkConnectorOffset) []ConnectorOffset {
+	offsets := make([]ConnectorOffset, len(sinkOffsets))
+	for i, o := range sinkOffsets {
+		offsets[i] = ConnectorOffset{
+			Partition: map[string]any{
+				sinkOffsetTopicKey:     o.Topic,
+				sinkOffsetPartitionKey: o.Partition,
+			},
+		}
+		if o.Offset != nil {
+			offsets[i].Offset = map[string]any{sinkOffsetOffsetKey: *o.Offset}
+		}
+	}
+	return offsets
+}
+
+// int64FromJSONValue converts a decoded JSON number to int64. Kafka connect may
+// encode numbers as strings, hence these are accepted as well.
+func int64FromJSONValue(v any) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Int64()
+	case float64:
+		return int64(n), nil
+	case string:
+		return strconv.ParseInt(n, 10, 64)
+	default:
+		return 0, fmt.Errorf("expected a number, but got %T", v)
+	}
+}
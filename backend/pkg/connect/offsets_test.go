@@ -0,0 +1,130 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/testutil"
+)
+
+func newOffsetsTestService(t *testing.T) (*Service, *testutil.MockKafkaConnect) {
+	t.Helper()
+
+	mock := testutil.NewMockKafkaConnect(t)
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.Clusters = []config.ConnectCluster{{Name: "local", URL: mock.URL}}
+	cfg.ConnectTimeout = time.Second
+
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	return svc, mock
+}
+
+func TestSourceConnectorOffsets(t *testing.T) {
+	svc, mock := newOffsetsTestService(t)
+	ctx := context.Background()
+	mock.AddConnector(testutil.MockConnector{
+		Name:  "jdbc-source",
+		Type:  "source",
+		State: connectorStateRunning,
+		Offsets: []testutil.MockConnectorOffset{
+			{Partition: map[string]any{"table": "orders"}, Offset: map[string]any{"incrementing": 42}},
+		},
+	})
+
+	res, restErr := svc.GetConnectorOffsets(ctx, "local", "jdbc-source")
+	require.Nil(t, restErr)
+	assert.Equal(t, "source", res.Type)
+	require.Len(t, res.Offsets, 1)
+	assert.Equal(t, "orders", res.Offsets[0].Partition["table"])
+	assert.Empty(t, res.SinkOffsets)
+
+	// Offsets can only be modified for stopped connectors.
+	rewind := []ConnectorOffset{{Partition: map[string]any{"table": "orders"}, Offset: map[string]any{"incrementing": 0}}}
+	_, restErr = svc.AlterConnectorOffsets(ctx, "local", "jdbc-source", rewind)
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusConflict, restErr.Status)
+	_, restErr = svc.ResetConnectorOffsets(ctx, "local", "jdbc-source")
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusConflict, restErr.Status)
+
+	require.Nil(t, svc.StopConnector(ctx, "local", "jdbc-source"))
+	msg, restErr := svc.AlterConnectorOffsets(ctx, "local", "jdbc-source", rewind)
+	require.Nil(t, restErr)
+	assert.NotEmpty(t, msg)
+	connector, _ := mock.Connector("jdbc-source")
+	require.Len(t, connector.Offsets, 1)
+	assert.EqualValues(t, 0, connector.Offsets[0].Offset["incrementing"])
+
+	_, restErr = svc.ResetConnectorOffsets(ctx, "local", "jdbc-source")
+	require.Nil(t, restErr)
+	connector, _ = mock.Connector("jdbc-source")
+	assert.Empty(t, connector.Offsets)
+
+	_, restErr = svc.GetConnectorOffsets(ctx, "local", "unknown")
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusNotFound, restErr.Status)
+}
+
+func TestSinkConnectorOffsets(t *testing.T) {
+	svc, mock := newOffsetsTestService(t)
+	ctx := context.Background()
+	sinkOffset := func(topic string, partition int, offset int64) testutil.MockConnectorOffset {
+		return testutil.MockConnectorOffset{
+			Partition: map[string]any{"kafka_topic": topic, "kafka_partition": partition},
+			Offset:    map[string]any{"kafka_offset": offset},
+		}
+	}
+	mock.AddConnector(testutil.MockConnector{
+		Name:    "s3-sink",
+		Type:    "sink",
+		State:   connectorStateStopped,
+		Config:  map[string]string{"consumer.override.group.id": "s3-sink-group"},
+		Offsets: []testutil.MockConnectorOffset{sinkOffset("orders", 1, 200), sinkOffset("orders", 0, 100)},
+	})
+
+	res, restErr := svc.GetConnectorOffsets(ctx, "local", "s3-sink")
+	require.Nil(t, restErr)
+	assert.Equal(t, "s3-sink-group", res.ConsumerGroupID)
+	require.Len(t, res.SinkOffsets, 2)
+	assert.Equal(t, "orders", res.SinkOffsets[0].Topic)
+	assert.Equal(t, int32(0), res.SinkOffsets[0].Partition)
+	require.NotNil(t, res.SinkOffsets[0].Offset)
+	assert.Equal(t, int64(100), *res.SinkOffsets[0].Offset)
+
+	// Skip a poison record in partition 0 and reset partition 1.
+	skipTo := int64(101)
+	_, restErr = svc.AlterSinkConnectorOffsets(ctx, "local", "s3-sink", []SinkConnectorOffset{
+		{Topic: "orders", Partition: 0, Offset: &skipTo},
+		{Topic: "orders", Partition: 1},
+	})
+	require.Nil(t, restErr)
+
+	res, restErr = svc.GetConnectorOffsets(ctx, "local", "s3-sink")
+	require.Nil(t, restErr)
+	require.Len(t, res.SinkOffsets, 1)
+	assert.Equal(t, skipTo, *res.SinkOffsets[0].Offset)
+}
+
+func TestSinkConsumerGroupID(t *testing.T) {
+	assert.Equal(t, "connect-s3-sink", sinkConsumerGroupID("s3-sink", map[string]string{}))
+	assert.Equal(t, "custom", sinkConsumerGroupID("s3-sink", map[string]string{"consumer.override.group.id": "custom"}))
+}
@@ -0,0 +1,388 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// PluginCatalog lists the installed connector plugins of all Kafka connect
+// clusters, both by cluster and by plugin class.
+type PluginCatalog struct {
+	Clusters []PluginCatalogCluster `json:"clusters"`
+	Plugins  []PluginCatalogEntry   `json:"plugins"`
+}
+
+// PluginCatalogCluster is the list of plugins that are installed in a single
+// Kafka connect cluster.
+type PluginCatalogCluster struct {
+	ClusterName string                    `json:"clusterName"`
+	Plugins     []con.ConnectorPluginInfo `json:"plugins"`
+	// Error is set if the plugins of the cluster could not be retrieved.
+	Error string `json:"error,omitempty"`
+}
+
+// PluginCatalogEntry is a single plugin class along with the version that is
+// installed in each cluster.
+type PluginCatalogEntry struct {
+	Class string `json:"class"`
+	Type  string `json:"type"`
+	// VersionsByCluster has an entry for each cluster the plugin is installed in.
+	VersionsByCluster map[string]string `json:"versionsByCluster"`
+	// VersionMismatch is true if the clusters run different versions of the plugin.
+	VersionMismatch bool `json:"versionMismatch"`
+}
+
+// NewPluginCatalog creates the plugin catalog from the cluster infos. The
+// plugins are sorted by class.
+func NewPluginCatalog(clusterInfos []ClusterInfoWithError) PluginCatalog {
+	sort.Slice(clusterInfos, func(i, j int) bool { return clusterInfos[i].Name < clusterInfos[j].Name })
+
+	catalog := PluginCatalog{
+		Clusters: make([]PluginCatalogCluster, 0, len(clusterInfos)),
+		Plugins:  make([]PluginCatalogEntry, 0),
+	}
+	entriesByClass := make(map[string]*PluginCatalogEntry)
+	for _, info := range clusterInfos {
+		cluster := PluginCatalogCluster{ClusterName: info.Name, Plugins: info.Plugins}
+		if info.RequestError != nil {
+			cluster.Error = info.RequestError.Error()
+		}
+		if cluster.Plugins == nil {
+			cluster.Plugins = make([]con.ConnectorPluginInfo, 0)
+		}
+		catalog.Clusters = append(catalog.Clusters, cluster)
+
+		for _, plugin := range info.Plugins {
+			entry, exists := entriesByClass[plugin.Class]
+			if !exists {
+				entry = &PluginCatalogEntry{Class: plugin.Class, Type: plugin.Type, VersionsByCluster: make(map[string]string)}
+				entriesByClass[plugin.Class] = entry
+			}
+			for _, version := range entry.VersionsByCluster {
+				if version != plugin.Version {
+					entry.VersionMismatch = true
+				}
+			}
+			entry.VersionsByCluster[info.Name] = plugin.Version
+		}
+	}
+
+	for _, entry := range entriesByClass {
+		catalog.Plugins = append(catalog.Plugins, *entry)
+	}
+	sort.Slice(catalog.Plugins, func(i, j int) bool { return catalog.Plugins[i].Class < catalog.Plugins[j].Class })
+	return catalog
+}
+
+// PluginConfigRef identifies the config definitions of a plugin version in a
+// Kafka connect cluster.
+type PluginConfigRef struct {
+	ClusterName string `json:"clusterName"`
+	Class       string `json:"class"`
+	// Version is the installed version if empty.
+	Version string `json:"version"`
+}
+
+// PluginConfigSnapshot is the set of config definitions of a plugin version, as
+// reported by the Kafka connect cluster at FetchedAt.
+type PluginConfigSnapshot struct {
+	PluginConfigRef
+	FetchedAt   time.Time                `json:"fetchedAt"`
+	Definitions []model.ConfigDefinition `json:"definitions"`
+}
+
+// pluginSnapshotCache holds the config definitions of all plugin versions that
+// have been requested. Snapshots of versions that are no longer installed are
+// retained, so that they can be compared with the installed version.
+type pluginSnapshotCache struct {
+	mu        sync.RWMutex
+	snapshots map[PluginConfigRef]PluginConfigSnapshot
+
+	// snapshotting is set while the installed plugins are snapshotted.
+	snapshotting atomic.Bool
+}
+
+func newPluginSnapshotCache() *pluginSnapshotCache {
+	return &pluginSnapshotCache{snapshots: make(map[PluginConfigRef]PluginConfigSnapshot)}
+}
+
+func (c *pluginSnapshotCache) get(ref PluginConfigRef) (PluginConfigSnapshot, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	snapshot, exists := c.snapshots[ref]
+	return snapshot, exists
+}
+
+func (c *pluginSnapshotCache) put(snapshot PluginConfigSnapshot) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.snapshots[snapshot.PluginConfigRef] = snapshot
+}
+
+// versions returns all cached versions of a plugin in a cluster.
+func (c *pluginSnapshotCache) versions(clusterName, class string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	versions := make([]string, 0)
+	for ref := range c.snapshots {
+		if ref.ClusterName == clusterName && ref.Class == class {
+			versions = append(versions, ref.Version)
+		}
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// GetPluginConfigSnapshot returns the config definitions of a plugin version. The
+// definitions of the installed version are requested from Kafka connect and
+// cached, older versions can only be returned if they have been cached before.
+func (s *Service) GetPluginConfigSnapshot(ctx context.Context, ref PluginConfigRef) (PluginConfigSnapshot, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(ref.ClusterName)
+	if restErr != nil {
+		return PluginConfigSnapshot{}, restErr
+	}
+	if ref.Version != "" {
+		if snapshot, exists := s.pluginSnapshots.get(ref); exists {
+			return snapshot, nil
+		}
+	}
+
+	plugins, err := c.Client.GetConnectorPlugins(ctx)
+	if err != nil {
+		return PluginConfigSnapshot{}, &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get cluster plugins: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", ref.ClusterName)},
+			IsSilent:     false,
+		}
+	}
+	installedVersion, installed := "", false
+	for _, plugin := range plugins {
+		if plugin.Class == ref.Class {
+			installedVersion, installed = plugin.Version, true
+			break
+		}
+	}
+
+	switch {
+	case ref.Version == "" && !installed:
+		return PluginConfigSnapshot{}, &rest.Error{
+			Err:          fmt.Errorf("plugin is not installed"),
+			Status:       http.StatusNotFound,
+			Message:      fmt.Sprintf("The plugin %q is not installed in the Kafka connect cluster", ref.Class),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", ref.ClusterName), zap.String("plugin_class", ref.Class)},
+			IsSilent:     false,
+		}
+	case ref.Version != "" && ref.Version != installedVersion:
+		return PluginConfigSnapshot{}, &rest.Error{
+			Err:      fmt.Errorf("no snapshot for plugin version"),
+			Status:   http.StatusNotFound,
+			Message:  fmt.Sprintf("There is no snapshot of version %q of the plugin %q. Cached versions: %v", ref.Version, ref.Class, s.pluginSnapshots.versions(ref.ClusterName, ref.Class)),
+			IsSilent: false,
+		}
+	}
+	ref.Version = installedVersion
+	if snapshot, exists := s.pluginSnapshots.get(ref); exists {
+		return snapshot, nil
+	}
+
+	return s.fetchPluginConfigSnapshot(ctx, c, ref)
+}
+
+// SnapshotInstalledPlugins caches the config definitions of all plugins that are
+// installed in the given clusters, so that a plugin version can still be compared
+// after it has been upgraded. Versions that are cached already are skipped. It
+// returns immediately if another call is still in progress.
+func (s *Service) SnapshotInstalledPlugins(ctx context.Context, clusterInfos []ClusterInfoWithError) {
+	if !s.pluginSnapshots.snapshotting.CompareAndSwap(false, true) {
+		return
+	}
+	defer s.pluginSnapshots.snapshotting.Store(false)
+
+	for _, info := range clusterInfos {
+		if info.RequestError != nil {
+			continue
+		}
+		c, restErr := s.getConnectClusterByName(info.Name)
+		if restErr != nil {
+			continue
+		}
+		for _, plugin := range info.Plugins {
+			ref := PluginConfigRef{ClusterName: info.Name, Class: plugin.Class, Version: plugin.Version}
+			if _, exists := s.pluginSnapshots.get(ref); exists {
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+
+			fetchCtx, cancel := context.WithTimeout(ctx, s.Cfg.RequestTimeout)
+			_, restErr := s.fetchPluginConfigSnapshot(fetchCtx, c, ref)
+			cancel()
+			if restErr != nil {
+				s.Logger.Debug("failed to snapshot config definitions of installed plugin",
+					zap.String("cluster_name", info.Name),
+					zap.String("plugin_class", plugin.Class),
+					zap.Error(restErr.Err))
+			}
+		}
+	}
+}
+
+// fetchPluginConfigSnapshot requests the config definitions of the installed
+// plugin version from Kafka connect and caches them.
+func (s *Service) fetchPluginConfigSnapshot(ctx context.Context, c *ClientWithConfig, ref PluginConfigRef) (PluginConfigSnapshot, *rest.Error) {
+	// The definitions are only returned by the validate endpoint, which also
+	// works with a config that only contains the connector class.
+	validation, err := c.Client.PutValidateConnectorConfig(ctx, ref.Class, con.ValidateConnectorConfigOptions{
+		Config: map[string]any{"connector.class": ref.Class},
+	})
+	if err != nil {
+		return PluginConfigSnapshot{}, &rest.Error{
+			Err:          fmt.Errorf("failed to validate connector config: %w", err),
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get config definitions of plugin %q: %v", ref.Class, err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", ref.ClusterName), zap.String("plugin_class", ref.Class)},
+			IsSilent:     false,
+		}
+	}
+
+	snapshot := PluginConfigSnapshot{
+		PluginConfigRef: ref,
+		FetchedAt:       time.Now(),
+		Definitions:     make([]model.ConfigDefinition, len(validation.Configs)),
+	}
+	for i, result := range validation.Configs {
+		snapshot.Definitions[i] = model.NewConfigDefinitionFromValidationResult(result)
+	}
+	sort.Slice(snapshot.Definitions, func(i, j int) bool {
+		return snapshot.Definitions[i].Definition.Name < snapshot.Definitions[j].Definition.Name
+	})
+	s.pluginSnapshots.put(snapshot)
+
+	return snapshot, nil
+}
+
+// PluginConfigDiff is the difference between the config definitions of two
+// plugin versions, which may be installed in different clusters.
+type PluginConfigDiff struct {
+	Base    PluginConfigRef          `json:"base"`
+	Target  PluginConfigRef          `json:"target"`
+	Changes []ConfigDefinitionChange `json:"changes"`
+}
+
+// ConfigDefinitionChange is the difference of a single config definition.
+type ConfigDefinitionChange struct {
+	Name string           `json:"name"`
+	Type ConfigChangeType `json:"type"`
+	// Fields lists the changed properties of the definition, if Type is CHANGED.
+	Fields []ConfigDefinitionFieldChange `json:"fields,omitempty"`
+}
+
+// ConfigDefinitionFieldChange is the difference of a single property of a config
+// definition.
+type ConfigDefinitionFieldChange struct {
+	Field       string `json:"field"`
+	BaseValue   string `json:"baseValue"`
+	TargetValue string `json:"targetValue"`
+}
+
+// DiffPluginConfigSnapshots compares the config definitions of two plugin versions.
+func (s *Service) DiffPluginConfigSnapshots(ctx context.Context, base, target PluginConfigRef) (PluginConfigDiff, *rest.Error) {
+	baseSnapshot, restErr := s.GetPluginConfigSnapshot(ctx, base)
+	if restErr != nil {
+		return PluginConfigDiff{}, restErr
+	}
+	targetSnapshot, restErr := s.GetPluginConfigSnapshot(ctx, target)
+	if restErr != nil {
+		return PluginConfigDiff{}, restErr
+	}
+
+	return PluginConfigDiff{
+		Base:    baseSnapshot.PluginConfigRef,
+		Target:  targetSnapshot.PluginConfigRef,
+		Changes: DiffConfigDefinitions(baseSnapshot.Definitions, targetSnapshot.Definitions),
+	}, nil
+}
+
+// DiffConfigDefinitions compares two sets of config definitions and returns all
+// changes sorted by config name. Only properties that affect the configuration
+// of a connector are compared, hence documentation changes are ignored.
+func DiffConfigDefinitions(base, target []model.ConfigDefinition) []ConfigDefinitionChange {
+	baseByName := make(map[string]model.ConfigDefinitionKey, len(base))
+	for _, def := range base {
+		baseByName[def.Definition.Name] = def.Definition
+	}
+	targetByName := make(map[string]model.ConfigDefinitionKey, len(target))
+	for _, def := range target {
+		targetByName[def.Definition.Name] = def.Definition
+	}
+
+	changes := make([]ConfigDefinitionChange, 0)
+	for name, baseDef := range baseByName {
+		targetDef, exists := targetByName[name]
+		if !exists {
+			changes = append(changes, ConfigDefinitionChange{Name: name, Type: ConfigChangeTypeRemoved})
+			continue
+		}
+		if fields := diffConfigDefinitionKeys(baseDef, targetDef); len(fields) > 0 {
+			changes = append(changes, ConfigDefinitionChange{Name: name, Type: ConfigChangeTypeChanged, Fields: fields})
+		}
+	}
+	for name := range targetByName {
+		if _, exists := baseByName[name]; !exists {
+			changes = append(changes, ConfigDefinitionChange{Name: name, Type: ConfigChangeTypeAdded})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes
+}
+
+func diffConfigDefinitionKeys(base, target model.ConfigDefinitionKey) []ConfigDefinitionFieldChange {
+	group := func(g *string) string {
+		if g == nil {
+			return ""
+		}
+		return *g
+	}
+	fields := []ConfigDefinitionFieldChange{
+		{Field: "type", BaseValue: base.Type, TargetValue: target.Type},
+		{Field: "default_value", BaseValue: base.DefaultValue, TargetValue: target.DefaultValue},
+		{Field: "importance", BaseValue: base.Importance, TargetValue: target.Importance},
+		{Field: "required", BaseValue: strconv.FormatBool(base.Required), TargetValue: strconv.FormatBool(target.Required)},
+		{Field: "group", BaseValue: group(base.Group), TargetValue: group(target.Group)},
+		{Field: "display_name", BaseValue: base.DisplayName, TargetValue: target.DisplayName},
+	}
+
+	changed := make([]ConfigDefinitionFieldChange, 0)
+	for _, field := range fields {
+		if field.BaseValue != field.TargetValue {
+			changed = append(changed, field)
+		}
+	}
+	return changed
+}
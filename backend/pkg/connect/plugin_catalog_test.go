@@ -0,0 +1,150 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/testutil"
+)
+
+const debeziumPostgres = "io.debezium.connector.postgresql.PostgresConnector"
+
+func TestNewPluginCatalog(t *testing.T) {
+	catalog := NewPluginCatalog([]ClusterInfoWithError{
+		{ClusterInfo: ClusterInfo{Name: "staging", Plugins: []con.ConnectorPluginInfo{
+			{Class: debeziumPostgres, Type: "source", Version: "2.7.0"},
+			{Class: "io.confluent.connect.s3.S3SinkConnector", Type: "sink", Version: "10.5.0"},
+		}}},
+		{ClusterInfo: ClusterInfo{Name: "prod", Plugins: []con.ConnectorPluginInfo{
+			{Class: debeziumPostgres, Type: "source", Version: "2.4.0"},
+			{Class: "io.confluent.connect.s3.S3SinkConnector", Type: "sink", Version: "10.5.0"},
+		}}},
+		{ClusterInfo: ClusterInfo{Name: "dr"}, RequestError: errors.New("connection refused")},
+	})
+
+	require.Len(t, catalog.Clusters, 3)
+	assert.Equal(t, "dr", catalog.Clusters[0].ClusterName)
+	assert.Equal(t, "connection refused", catalog.Clusters[0].Error)
+	assert.NotNil(t, catalog.Clusters[0].Plugins)
+
+	require.Len(t, catalog.Plugins, 2)
+	assert.Equal(t, "io.confluent.connect.s3.S3SinkConnector", catalog.Plugins[0].Class)
+	assert.False(t, catalog.Plugins[0].VersionMismatch)
+	assert.Equal(t, debeziumPostgres, catalog.Plugins[1].Class)
+	assert.True(t, catalog.Plugins[1].VersionMismatch)
+	assert.Equal(t, map[string]string{"prod": "2.4.0", "staging": "2.7.0"}, catalog.Plugins[1].VersionsByCluster)
+}
+
+func TestPluginConfigSnapshots(t *testing.T) {
+	prod := testutil.NewMockKafkaConnect(t)
+	prod.SetPluginVersion(debeziumPostgres, "2.4.0")
+	prod.SetPluginConfigTypes(debeziumPostgres, map[string]string{
+		"database.hostname": "STRING",
+		"database.password": "PASSWORD",
+		"snapshot.mode":     "STRING",
+	})
+	staging := testutil.NewMockKafkaConnect(t)
+	staging.SetPluginVersion(debeziumPostgres, "2.7.0")
+	staging.SetPluginConfigTypes(debeziumPostgres, map[string]string{
+		"database.hostname": "STRING",
+		"database.password": "PASSWORD",
+		"snapshot.mode":     "LIST",
+		"publication.name":  "STRING",
+	})
+
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.ConnectTimeout = time.Second
+	cfg.Clusters = []config.ConnectCluster{{Name: "prod", URL: prod.URL}, {Name: "staging", URL: staging.URL}}
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	snapshot, restErr := svc.GetPluginConfigSnapshot(ctx, PluginConfigRef{ClusterName: "prod", Class: debeziumPostgres})
+	require.Nil(t, restErr)
+	assert.Equal(t, "2.4.0", snapshot.Version)
+	require.Len(t, snapshot.Definitions, 3)
+	assert.Equal(t, "database.hostname", snapshot.Definitions[0].Definition.Name)
+
+	diff, restErr := svc.DiffPluginConfigSnapshots(ctx,
+		PluginConfigRef{ClusterName: "prod", Class: debeziumPostgres},
+		PluginConfigRef{ClusterName: "staging", Class: debeziumPostgres})
+	require.Nil(t, restErr)
+	assert.Equal(t, "2.7.0", diff.Target.Version)
+	assert.Equal(t, []ConfigDefinitionChange{
+		{Name: "publication.name", Type: ConfigChangeTypeAdded},
+		{Name: "snapshot.mode", Type: ConfigChangeTypeChanged, Fields: []ConfigDefinitionFieldChange{
+			{Field: "type", BaseValue: "STRING", TargetValue: "LIST"},
+		}},
+	}, diff.Changes)
+
+	// Snapshots of versions that are no longer installed are retained.
+	prod.SetPluginVersion(debeziumPostgres, "2.7.0")
+	snapshot, restErr = svc.GetPluginConfigSnapshot(ctx, PluginConfigRef{ClusterName: "prod", Class: debeziumPostgres, Version: "2.4.0"})
+	require.Nil(t, restErr)
+	assert.Len(t, snapshot.Definitions, 3)
+
+	_, restErr = svc.GetPluginConfigSnapshot(ctx, PluginConfigRef{ClusterName: "prod", Class: debeziumPostgres, Version: "1.9.0"})
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusNotFound, restErr.Status)
+
+	_, restErr = svc.GetPluginConfigSnapshot(ctx, PluginConfigRef{ClusterName: "prod", Class: "com.example.Unknown"})
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusNotFound, restErr.Status)
+}
+
+func TestSnapshotInstalledPlugins(t *testing.T) {
+	mock := testutil.NewMockKafkaConnect(t)
+	mock.SetPluginVersion(debeziumPostgres, "2.4.0")
+	mock.SetPluginConfigTypes(debeziumPostgres, map[string]string{
+		"database.hostname": "STRING",
+		"snapshot.mode":     "STRING",
+	})
+
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.ConnectTimeout = time.Second
+	cfg.Clusters = []config.ConnectCluster{{Name: "prod", URL: mock.URL}}
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Listing the catalog snapshots the installed version before it is upgraded.
+	svc.SnapshotInstalledPlugins(ctx, svc.GetAllClusterInfo(ctx))
+	mock.SetPluginVersion(debeziumPostgres, "2.7.0")
+	mock.SetPluginConfigTypes(debeziumPostgres, map[string]string{
+		"database.hostname": "STRING",
+		"snapshot.mode":     "LIST",
+	})
+
+	diff, restErr := svc.DiffPluginConfigSnapshots(ctx,
+		PluginConfigRef{ClusterName: "prod", Class: debeziumPostgres, Version: "2.4.0"},
+		PluginConfigRef{ClusterName: "prod", Class: debeziumPostgres})
+	require.Nil(t, restErr)
+	assert.Equal(t, "2.7.0", diff.Target.Version)
+	assert.Equal(t, []ConfigDefinitionChange{
+		{Name: "snapshot.mode", Type: ConfigChangeTypeChanged, Fields: []ConfigDefinitionFieldChange{
+			{Field: "type", BaseValue: "STRING", TargetValue: "LIST"},
+		}},
+	}, diff.Changes)
+}
@@ -0,0 +1,284 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// PromoteConnectorRequest describes how a connector is copied from one Kafka
+// connect cluster to another.
+type PromoteConnectorRequest struct {
+	SourceClusterName   string `json:"sourceClusterName"`
+	SourceConnectorName string `json:"sourceConnectorName"`
+	TargetClusterName   string `json:"targetClusterName"`
+	// TargetConnectorName defaults to the name of the source connector.
+	TargetConnectorName string `json:"targetConnectorName"`
+
+	// Replacements are applied to all config values in the given order, e.g. to
+	// replace the topic prefix "staging." with "prod.".
+	Replacements []PromotionReplacement `json:"replacements"`
+	// Overrides set config values after the replacements have been applied. The
+	// values are Go templates, see PromotionTemplateData for the available data.
+	Overrides map[string]string `json:"overrides"`
+	// ExcludeKeys are removed from the promoted config.
+	ExcludeKeys []string `json:"excludeKeys"`
+
+	// DryRun only validates the promoted config and reports the diff.
+	DryRun bool `json:"dryRun"`
+}
+
+// PromotionReplacement replaces all occurrences of From with To.
+type PromotionReplacement struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// PromotionTemplateData is the data that override templates are executed with,
+// e.g. {{ index .Config "database.hostname" | replace "staging" "prod" }}.
+type PromotionTemplateData struct {
+	// Config is the config of the source connector.
+	Config              map[string]string
+	SourceClusterName   string
+	SourceConnectorName string
+	TargetClusterName   string
+	TargetConnectorName string
+}
+
+// promotionTemplateFuncs are the functions that can be used in override
+// templates. The string to modify is the last argument, so that the functions
+// can be used in pipelines.
+var promotionTemplateFuncs = template.FuncMap{
+	"replace":    func(old, replacement, s string) string { return strings.ReplaceAll(s, old, replacement) },
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+	"lower":      strings.ToLower,
+	"upper":      strings.ToUpper,
+}
+
+// PromoteConnectorResult reports the promoted config and whether it has been
+// applied in the target cluster.
+type PromoteConnectorResult struct {
+	SourceClusterName   string `json:"sourceClusterName"`
+	SourceConnectorName string `json:"sourceConnectorName"`
+	TargetClusterName   string `json:"targetClusterName"`
+	TargetConnectorName string `json:"targetConnectorName"`
+	ConnectorClass      string `json:"connectorClass"`
+	// SourcePluginVersion and TargetPluginVersion are the installed versions of
+	// the connector plugin. They are empty if the plugin is not installed.
+	SourcePluginVersion string `json:"sourcePluginVersion"`
+	TargetPluginVersion string `json:"targetPluginVersion"`
+
+	// TargetExists is true if the connector already exists in the target cluster.
+	TargetExists bool `json:"targetExists"`
+	// Config is the promoted config with sensitive values redacted.
+	Config map[string]string `json:"config"`
+	// Changes compares the config of the target connector with the promoted config.
+	Changes []ConfigChange `json:"changes"`
+	// ValidationErrors are the errors by config key, as reported by the target cluster.
+	ValidationErrors map[string][]string `json:"validationErrors"`
+
+	DryRun  bool `json:"dryRun"`
+	Applied bool `json:"applied"`
+}
+
+// PromoteConnector copies a connector from one Kafka connect cluster to another.
+// The environment specific values are mapped via replacements and templated
+// overrides, before the promoted config is validated by the target cluster. The
+// config is only applied if it is valid and the request is not a dry run.
+func (s *Service) PromoteConnector(ctx context.Context, req PromoteConnectorRequest) (PromoteConnectorResult, *rest.Error) {
+	if req.TargetConnectorName == "" {
+		req.TargetConnectorName = req.SourceConnectorName
+	}
+	logFields := []zapcore.Field{
+		zap.String("source_cluster_name", req.SourceClusterName),
+		zap.String("source_connector_name", req.SourceConnectorName),
+		zap.String("target_cluster_name", req.TargetClusterName),
+		zap.String("target_connector_name", req.TargetConnectorName),
+	}
+	if req.SourceClusterName == req.TargetClusterName && req.SourceConnectorName == req.TargetConnectorName {
+		return PromoteConnectorResult{}, &rest.Error{
+			Err:          fmt.Errorf("source and target connector are the same"),
+			Status:       http.StatusBadRequest,
+			Message:      "The connector cannot be promoted to itself. Choose a different target cluster or connector name.",
+			InternalLogs: logFields,
+			IsSilent:     false,
+		}
+	}
+
+	source, restErr := s.getConnectClusterByName(req.SourceClusterName)
+	if restErr != nil {
+		return PromoteConnectorResult{}, restErr
+	}
+	target, restErr := s.getConnectClusterByName(req.TargetClusterName)
+	if restErr != nil {
+		return PromoteConnectorResult{}, restErr
+	}
+
+	sourceConfig, err := source.Client.GetConnectorConfig(ctx, req.SourceConnectorName)
+	if err != nil {
+		return PromoteConnectorResult{}, &rest.Error{
+			Err:          fmt.Errorf("failed to get source connector config: %w", err),
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get config of the source connector: %v", err.Error()),
+			InternalLogs: logFields,
+			IsSilent:     false,
+		}
+	}
+	className := sourceConfig["connector.class"]
+
+	promotedConfig, err := promoteConnectorConfig(sourceConfig, req)
+	if err != nil {
+		return PromoteConnectorResult{}, &rest.Error{
+			Err:          err,
+			Status:       http.StatusBadRequest,
+			Message:      fmt.Sprintf("Failed to map the connector config: %v", err.Error()),
+			InternalLogs: logFields,
+			IsSilent:     false,
+		}
+	}
+
+	targetConfig, targetExists, err := getRawConnectorConfig(ctx, target, req.TargetConnectorName)
+	if err != nil {
+		return PromoteConnectorResult{}, &rest.Error{
+			Err:          fmt.Errorf("failed to get target connector config: %w", err),
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get config of the target connector: %v", err.Error()),
+			InternalLogs: logFields,
+			IsSilent:     false,
+		}
+	}
+
+	result := PromoteConnectorResult{
+		SourceClusterName:   req.SourceClusterName,
+		SourceConnectorName: req.SourceConnectorName,
+		TargetClusterName:   req.TargetClusterName,
+		TargetConnectorName: req.TargetConnectorName,
+		ConnectorClass:      className,
+		SourcePluginVersion: installedPluginVersion(ctx, source, className),
+		TargetPluginVersion: installedPluginVersion(ctx, target, className),
+		TargetExists:        targetExists,
+		Config:              s.RedactConfig(promotedConfig),
+		Changes:             DiffConnectorConfigs(s.RedactConfig(targetConfig), s.RedactConfig(promotedConfig)),
+		ValidationErrors:    make(map[string][]string),
+		DryRun:              req.DryRun,
+	}
+
+	// Validate against the plugin of the target cluster first, because the
+	// plugin may be missing or be installed with a different version.
+	validationConfig := make(map[string]any, len(promotedConfig))
+	for key, value := range promotedConfig {
+		validationConfig[key] = value
+	}
+	validation, err := target.Client.PutValidateConnectorConfig(ctx, className, con.ValidateConnectorConfigOptions{Config: validationConfig})
+	if err != nil {
+		return PromoteConnectorResult{}, &rest.Error{
+			Err:          fmt.Errorf("failed to validate connector config in target cluster: %w", err),
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to validate the connector config in the target cluster, the plugin %q may not be installed: %v", className, err.Error()),
+			InternalLogs: logFields,
+			IsSilent:     false,
+		}
+	}
+	for _, cfg := range validation.Configs {
+		def := model.NewConfigDefinitionFromValidationResult(cfg)
+		if len(def.Value.Errors) > 0 {
+			result.ValidationErrors[def.Definition.Name] = def.Value.Errors
+		}
+	}
+
+	if req.DryRun || len(result.ValidationErrors) > 0 {
+		return result, nil
+	}
+
+	putConfig := make(map[string]any, len(promotedConfig))
+	for key, value := range promotedConfig {
+		putConfig[key] = value
+	}
+	if _, restErr := s.PutConnectorConfig(ctx, req.TargetClusterName, req.TargetConnectorName, con.PutConnectorConfigOptions{Config: putConfig}); restErr != nil {
+		return PromoteConnectorResult{}, restErr
+	}
+	result.Applied = true
+	s.Logger.Info("promoted connector", logFields...)
+
+	return result, nil
+}
+
+// promoteConnectorConfig maps the source connector config to the config of the
+// target connector.
+func promoteConnectorConfig(sourceConfig map[string]string, req PromoteConnectorRequest) (map[string]string, error) {
+	promoted := make(map[string]string, len(sourceConfig))
+	for key, value := range sourceConfig {
+		for _, r := range req.Replacements {
+			if r.From != "" {
+				value = strings.ReplaceAll(value, r.From, r.To)
+			}
+		}
+		promoted[key] = value
+	}
+	for _, key := range req.ExcludeKeys {
+		delete(promoted, key)
+	}
+
+	data := PromotionTemplateData{
+		Config:              sourceConfig,
+		SourceClusterName:   req.SourceClusterName,
+		SourceConnectorName: req.SourceConnectorName,
+		TargetClusterName:   req.TargetClusterName,
+		TargetConnectorName: req.TargetConnectorName,
+	}
+	keys := make([]string, 0, len(req.Overrides))
+	for key := range req.Overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		tmpl, err := template.New(key).Funcs(promotionTemplateFuncs).Option("missingkey=error").Parse(req.Overrides[key])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse override for %q: %w", key, err)
+		}
+		var value strings.Builder
+		if err := tmpl.Execute(&value, data); err != nil {
+			return nil, fmt.Errorf("failed to render override for %q: %w", key, err)
+		}
+		promoted[key] = value.String()
+	}
+
+	promoted["name"] = req.TargetConnectorName
+	return promoted, nil
+}
+
+// installedPluginVersion returns the version of the plugin in the cluster. It
+// returns an empty string if the plugin is not installed or the plugins cannot
+// be retrieved.
+func installedPluginVersion(ctx context.Context, c *ClientWithConfig, className string) string {
+	plugins, err := c.Client.GetConnectorPlugins(ctx)
+	if err != nil {
+		return ""
+	}
+	for _, plugin := range plugins {
+		if plugin.Class == className {
+			return plugin.Version
+		}
+	}
+	return ""
+}
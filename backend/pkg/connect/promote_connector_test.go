@@ -0,0 +1,130 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/testutil"
+)
+
+func TestPromoteConnector(t *testing.T) {
+	staging := testutil.NewMockKafkaConnect(t)
+	staging.SetPluginVersion(debeziumPostgres, "2.7.0")
+	staging.AddConnector(testutil.MockConnector{
+		Name:  "orders-cdc",
+		Type:  "source",
+		State: "RUNNING",
+		Config: map[string]string{
+			"connector.class":   debeziumPostgres,
+			"database.hostname": "postgres.staging.svc",
+			"database.password": "staging-secret",
+			"topic.prefix":      "staging.orders",
+			"tasks.max":         "1",
+		},
+	})
+
+	prod := testutil.NewMockKafkaConnect(t)
+	prod.SetPluginVersion(debeziumPostgres, "2.4.0")
+	prod.SetPluginConfigTypes(debeziumPostgres, map[string]string{
+		"database.hostname": "STRING",
+		"database.password": "PASSWORD",
+		"topic.prefix":      "STRING",
+	})
+	prod.SetPluginRequiredConfigs(debeziumPostgres, "database.hostname")
+
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.ConnectTimeout = time.Second
+	cfg.Clusters = []config.ConnectCluster{{Name: "staging", URL: staging.URL}, {Name: "prod", URL: prod.URL}}
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	req := PromoteConnectorRequest{
+		SourceClusterName:   "staging",
+		SourceConnectorName: "orders-cdc",
+		TargetClusterName:   "prod",
+		Replacements:        []PromotionReplacement{{From: "staging", To: "prod"}},
+		Overrides: map[string]string{
+			"database.password": "${vault:secret/prod/{{ .TargetConnectorName }}:password}",
+			"slot.name":         `{{ .TargetClusterName }}_{{ index .Config "topic.prefix" | replace "." "_" }}`,
+		},
+		DryRun: true,
+	}
+
+	// Dry run
+	result, restErr := svc.PromoteConnector(ctx, req)
+	require.Nil(t, restErr)
+	assert.False(t, result.Applied)
+	assert.False(t, result.TargetExists)
+	assert.Equal(t, "2.7.0", result.SourcePluginVersion)
+	assert.Equal(t, "2.4.0", result.TargetPluginVersion)
+	assert.Empty(t, result.ValidationErrors)
+	assert.Equal(t, map[string]string{
+		"connector.class":   debeziumPostgres,
+		"database.hostname": "postgres.prod.svc",
+		"database.password": "${vault:secret/prod/orders-cdc:password}",
+		"topic.prefix":      "prod.orders",
+		"tasks.max":         "1",
+		"slot.name":         "prod_staging_orders",
+		"name":              "orders-cdc",
+	}, result.Config)
+	assert.Len(t, result.Changes, 7)
+	_, exists := prod.Connector("orders-cdc")
+	assert.False(t, exists)
+
+	// Apply
+	req.DryRun = false
+	result, restErr = svc.PromoteConnector(ctx, req)
+	require.Nil(t, restErr)
+	assert.True(t, result.Applied)
+	promoted, exists := prod.Connector("orders-cdc")
+	require.True(t, exists)
+	assert.Equal(t, "prod.orders", promoted.Config["topic.prefix"])
+
+	// Promoting again shows no changes.
+	req.DryRun = true
+	result, restErr = svc.PromoteConnector(ctx, req)
+	require.Nil(t, restErr)
+	assert.True(t, result.TargetExists)
+	assert.Empty(t, result.Changes)
+
+	// Invalid configs are not applied.
+	req.DryRun = false
+	req.TargetConnectorName = "orders-cdc-v2"
+	req.ExcludeKeys = []string{"database.hostname"}
+	result, restErr = svc.PromoteConnector(ctx, req)
+	require.Nil(t, restErr)
+	assert.False(t, result.Applied)
+	assert.Contains(t, result.ValidationErrors, "database.hostname")
+	_, exists = prod.Connector("orders-cdc-v2")
+	assert.False(t, exists)
+
+	// Invalid templates are rejected.
+	req.Overrides = map[string]string{"slot.name": "{{ .Unknown }}"}
+	_, restErr = svc.PromoteConnector(ctx, req)
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusBadRequest, restErr.Status)
+
+	// The connector cannot be promoted to itself.
+	_, restErr = svc.PromoteConnector(ctx, PromoteConnectorRequest{SourceClusterName: "prod", SourceConnectorName: "orders-cdc", TargetClusterName: "prod"})
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusBadRequest, restErr.Status)
+}
@@ -37,8 +37,15 @@ func (s *Service) PutConnectorConfig(ctx context.Context, clusterName string, co
 		}
 	}
 	req.Config = s.Interceptor.ConsoleToKafkaConnect(className, req.Config)
+	var secretWrites *sensitiveConfigWrites
+	req.Config, secretWrites, restErr = s.storeSensitiveConfigs(ctx, c, connectorName, className, req.Config)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
 
+	configBefore, exists := s.configBeforeChange(ctx, c, connectorName)
 	cInfo, err := c.Client.PutConnectorConfig(ctx, connectorName, req)
+	appliedConfig := cInfo.Config
 	connectorClass := getMapValueOrString(cInfo.Config, "connector.class", "unknown")
 	cInfo = con.ConnectorInfo{
 		Name:   cInfo.Name,
@@ -48,6 +55,7 @@ func (s *Service) PutConnectorConfig(ctx context.Context, clusterName string, co
 	}
 
 	if err != nil {
+		s.rollbackSensitiveConfigs(ctx, secretWrites)
 		return con.ConnectorInfo{}, &rest.Error{
 			Err:          fmt.Errorf("failed to patch connector config: %w", err),
 			Status:       GetStatusCodeFromAPIError(err, http.StatusInternalServerError),
@@ -57,5 +65,21 @@ func (s *Service) PutConnectorConfig(ctx context.Context, clusterName string, co
 		}
 	}
 
+	operation := HistoryOperationUpdate
+	if !exists {
+		operation = HistoryOperationCreate
+	}
+	s.recordHistory(ctx, HistoryEntry{
+		ClusterName:   clusterName,
+		ConnectorName: connectorName,
+		Operation:     operation,
+		ConfigBefore:  configBefore,
+		ConfigAfter:   appliedConfig,
+	})
+
+	if restErr = s.commitSensitiveConfigs(ctx, secretWrites); restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+
 	return cInfo, nil
 }
@@ -0,0 +1,75 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	con "github.com/cloudhut/connect-client"
+)
+
+// doRequest sends a request to the Kafka connect REST API of the cluster. It is
+// used for endpoints that are not supported by the connect client. The HTTP
+// client of the connect client is reused, so that timeouts and TLS settings
+// apply. Error responses are returned as con.ApiError.
+func (c *ClientWithConfig) doRequest(ctx context.Context, method string, path string, body any, result any) error {
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.baseURL(), "/")+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Redpanda Console")
+	if c.Cfg.Username != "" {
+		req.SetBasicAuth(c.Cfg.Username, c.Cfg.Password)
+	}
+	if c.Cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Cfg.Token)
+	}
+
+	res, err := c.Client.GetClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+	decoder.UseNumber()
+	if res.StatusCode >= http.StatusBadRequest {
+		apiErr := con.ApiError{}
+		if err := decoder.Decode(&apiErr); err != nil || apiErr.ErrorCode == 0 {
+			return con.ApiError{ErrorCode: res.StatusCode, Message: http.StatusText(res.StatusCode)}
+		}
+		return apiErr
+	}
+
+	if result == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := decoder.Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+	return nil
+}
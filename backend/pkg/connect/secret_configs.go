@@ -0,0 +1,223 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/secrets"
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// sensitiveConfigWrites are the secret store writes of storeSensitiveConfigs
+// that depend on whether the connector config is applied by Kafka connect.
+type sensitiveConfigWrites struct {
+	clusterName   string
+	connectorName string
+	// created are the secrets that have been created for the connector config and
+	// are deleted again if the config is not applied.
+	created []secrets.Secret
+	// updates are the new values of already existing secrets. They are written
+	// once the config has been applied, because the previous values cannot be
+	// restored.
+	updates []sensitiveConfigUpdate
+}
+
+type sensitiveConfigUpdate struct {
+	key    string
+	secret secrets.Secret
+	value  []byte
+}
+
+// storeSensitiveConfigs moves the values of all configs of type PASSWORD into the
+// secret store and replaces them with references to the stored secrets. Values
+// that already are config provider references are not modified.
+//
+// Secrets that do not exist yet are created right away, so that Kafka connect
+// can resolve the references when applying the config. The returned writes must
+// be finished with commitSensitiveConfigs once the config has been applied, or
+// with rollbackSensitiveConfigs if Kafka connect rejected it.
+func (s *Service) storeSensitiveConfigs(ctx context.Context, c *ClientWithConfig, connectorName, className string, configs map[string]any) (map[string]any, *sensitiveConfigWrites, *rest.Error) {
+	if s.SecretStore == nil || !s.Cfg.SecretStore.RewriteSensitiveConfigs {
+		return configs, nil, nil
+	}
+
+	// The config types are only known to the connector plugin, hence we ask Kafka connect.
+	validation, err := c.Client.PutValidateConnectorConfig(ctx, className, con.ValidateConnectorConfigOptions{Config: configs})
+	if err != nil {
+		return nil, nil, &rest.Error{
+			Err:          fmt.Errorf("failed to validate connector config: %w", err),
+			Status:       GetStatusCodeFromAPIError(err, http.StatusInternalServerError),
+			Message:      fmt.Sprintf("Failed to determine sensitive connector configs: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", c.Cfg.Name), zap.String("connector_name", connectorName)},
+			IsSilent:     false,
+		}
+	}
+
+	writes := &sensitiveConfigWrites{clusterName: c.Cfg.Name, connectorName: connectorName}
+	for _, result := range validation.Configs {
+		def := model.NewConfigDefinitionFromValidationResult(result)
+		if def.Definition.Type != model.ConfigDefinitionTypePassword {
+			continue
+		}
+		key := def.Definition.Name
+		value, ok := configs[key].(string)
+		if !ok || value == "" || secrets.IsReference(value) {
+			continue
+		}
+
+		secret := secrets.Secret{
+			ID:     connectorSecretID(connectorName, key),
+			Labels: map[string]string{"connector": connectorName, "config": key},
+		}
+		_, err := s.SecretStore.Get(ctx, c.Cfg.Name, secret.ID)
+		switch {
+		case err == nil:
+			writes.updates = append(writes.updates, sensitiveConfigUpdate{key: key, secret: secret, value: []byte(value)})
+		case errors.Is(err, secrets.ErrSecretNotFound):
+			err = s.SecretStore.Create(ctx, c.Cfg.Name, secret, []byte(value))
+			if err == nil {
+				writes.created = append(writes.created, secret)
+			}
+		}
+		if err != nil {
+			s.rollbackSensitiveConfigs(ctx, writes)
+			return nil, nil, &rest.Error{
+				Err:          fmt.Errorf("failed to store sensitive config %q: %w", key, err),
+				Status:       http.StatusInternalServerError,
+				Message:      fmt.Sprintf("Failed to store the sensitive config %q in the secret store: %v", key, err.Error()),
+				InternalLogs: []zapcore.Field{zap.String("cluster_name", c.Cfg.Name), zap.String("connector_name", connectorName)},
+				IsSilent:     false,
+			}
+		}
+		configs[key] = s.SecretStore.Reference(c.Cfg.Name, secret.ID)
+	}
+
+	return configs, writes, nil
+}
+
+// commitSensitiveConfigs writes the new values of already existing secrets after
+// the connector config has been applied. The connector and its tasks are
+// restarted afterwards, because Kafka connect resolves the references only when
+// starting them.
+func (s *Service) commitSensitiveConfigs(ctx context.Context, writes *sensitiveConfigWrites) *rest.Error {
+	if writes == nil || len(writes.updates) == 0 {
+		return nil
+	}
+
+	for _, update := range writes.updates {
+		if err := s.SecretStore.Update(ctx, writes.clusterName, update.secret, update.value); err != nil {
+			return &rest.Error{
+				Err:     fmt.Errorf("failed to update sensitive config %q: %w", update.key, err),
+				Status:  http.StatusInternalServerError,
+				Message: fmt.Sprintf("The connector config has been applied, but the sensitive config %q could not be updated in the secret store: %v", update.key, err.Error()),
+				InternalLogs: []zapcore.Field{
+					zap.String("cluster_name", writes.clusterName),
+					zap.String("connector_name", writes.connectorName),
+				},
+				IsSilent: false,
+			}
+		}
+	}
+
+	return s.RestartConnector(ctx, writes.clusterName, writes.connectorName, true, false)
+}
+
+// rollbackSensitiveConfigs deletes the secrets that have been created for a
+// connector config that has not been applied. Existing secrets have not been
+// modified yet and are kept as is.
+func (s *Service) rollbackSensitiveConfigs(ctx context.Context, writes *sensitiveConfigWrites) {
+	if writes == nil {
+		return
+	}
+
+	for _, secret := range writes.created {
+		if err := s.SecretStore.Delete(ctx, writes.clusterName, secret.ID); err != nil {
+			s.Logger.Warn("failed to delete secret of a connector config that has not been applied",
+				zap.String("cluster_name", writes.clusterName),
+				zap.String("connector_name", writes.connectorName),
+				zap.String("secret_id", secret.ID),
+				zap.Error(err))
+		}
+	}
+}
+
+// deleteSensitiveConfigs deletes the secrets that storeSensitiveConfigs created for
+// a connector that has been deleted. Failures are only logged, because the connector
+// itself is gone already.
+func (s *Service) deleteSensitiveConfigs(ctx context.Context, clusterName, connectorName string) {
+	if s.SecretStore == nil {
+		return
+	}
+
+	storedSecrets, err := s.SecretStore.List(ctx, clusterName)
+	if err != nil {
+		s.Logger.Warn("failed to list secrets of a deleted connector",
+			zap.String("cluster_name", clusterName),
+			zap.String("connector_name", connectorName),
+			zap.Error(err))
+		return
+	}
+	idPrefix := connectorSecretID(connectorName, "")
+	for _, secret := range storedSecrets {
+		if secret.Labels["connector"] != connectorName || !strings.HasPrefix(secret.ID, idPrefix) {
+			continue
+		}
+		if err := s.SecretStore.Delete(ctx, clusterName, secret.ID); err != nil && !errors.Is(err, secrets.ErrSecretNotFound) {
+			s.Logger.Warn("failed to delete secret of a deleted connector",
+				zap.String("cluster_name", clusterName),
+				zap.String("connector_name", connectorName),
+				zap.String("secret_id", secret.ID),
+				zap.Error(err))
+		}
+	}
+}
+
+// SensitiveConfigReference returns the config provider reference that the value
+// of a sensitive connector config is replaced with by storeSensitiveConfigs. It
+// returns an empty string if sensitive configs are not rewritten.
+func (s *Service) SensitiveConfigReference(clusterName, connectorName, key string) string {
+	if s.SecretStore == nil || !s.Cfg.SecretStore.RewriteSensitiveConfigs {
+		return ""
+	}
+	return s.SecretStore.Reference(clusterName, connectorSecretID(connectorName, key))
+}
+
+// connectorSecretID returns the ID of the secret that holds the value of the given
+// connector config. Both names are escaped and joined with a double underscore,
+// so that distinct connector configs never share a secret ID.
+func connectorSecretID(connectorName, configKey string) string {
+	return escapeSecretIDSegment(connectorName) + "__" + escapeSecretIDSegment(configKey)
+}
+
+// escapeSecretIDSegment keeps letters, digits and dashes and replaces all other
+// bytes with an underscore followed by their hex value.
+func escapeSecretIDSegment(segment string) string {
+	var sb strings.Builder
+	for i := 0; i < len(segment); i++ {
+		c := segment[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-':
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "_%02X", c)
+		}
+	}
+	return sb.String()
+}
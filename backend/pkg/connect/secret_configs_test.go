@@ -0,0 +1,145 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/base64"
+	"path/filepath"
+	"testing"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect/secrets"
+	"github.com/redpanda-data/console/backend/pkg/testutil"
+)
+
+func TestStoreSensitiveConfigs(t *testing.T) {
+	const className = "io.debezium.connector.postgresql.PostgresConnector"
+
+	mock := testutil.NewMockKafkaConnect(t)
+	mock.SetPluginConfigTypes(className, map[string]string{
+		"database.hostname": "STRING",
+		"database.password": "PASSWORD",
+		"ssl.key.password":  "PASSWORD",
+	})
+
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.Clusters = []config.ConnectCluster{{Name: "local", URL: mock.URL}}
+	cfg.ConnectTimeout = time.Second
+	cfg.SecretStore.Enabled = true
+	cfg.SecretStore.Type = config.ConnectSecretStoreTypeFile
+	cfg.SecretStore.File.Path = filepath.Join(t.TempDir(), "secrets.enc")
+	cfg.SecretStore.File.EncryptionKey = base64.StdEncoding.EncodeToString(make([]byte, 32))
+	require.Error(t, cfg.Validate(), "the file store requires a config provider")
+	cfg.SecretStore.ConfigProvider = "encryptedfile"
+	require.NoError(t, cfg.Validate())
+
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, restErr := svc.CreateConnector(ctx, "local", con.CreateConnectorRequest{
+		Name: "orders-cdc",
+		Config: map[string]any{
+			"connector.class":   className,
+			"database.hostname": "postgres",
+			"database.password": "hunter2",
+			"ssl.key.password":  "${vault:secret/tls:password}",
+		},
+	})
+	require.Nil(t, restErr)
+
+	connector, _ := mock.Connector("orders-cdc")
+	assert.Equal(t, "postgres", connector.Config["database.hostname"])
+	assert.Equal(t, "${encryptedfile:"+cfg.SecretStore.File.Path+":local/orders-cdc__database_2Epassword}", connector.Config["database.password"])
+	assert.Equal(t, "${vault:secret/tls:password}", connector.Config["ssl.key.password"], "references must not be rewritten")
+
+	secret, err := svc.SecretStore.Get(ctx, "local", "orders-cdc__database_2Epassword")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"connector": "orders-cdc", "config": "database.password"}, secret.Labels)
+
+	// Updating the config updates the existing secret.
+	_, restErr = svc.PutConnectorConfig(ctx, "local", "orders-cdc", con.PutConnectorConfigOptions{
+		Config: map[string]any{
+			"connector.class":   className,
+			"database.password": "hunter3",
+		},
+	})
+	require.Nil(t, restErr)
+	storedSecrets, err := svc.SecretStore.List(ctx, "local")
+	require.NoError(t, err)
+	assert.Len(t, storedSecrets, 1)
+	connector, _ = mock.Connector("orders-cdc")
+	assert.Equal(t, 1, connector.Restarts, "the connector must be restarted to resolve the updated secret")
+
+	// Secrets created for a connector that Kafka connect rejects are deleted again.
+	_, restErr = svc.CreateConnector(ctx, "local", con.CreateConnectorRequest{
+		Name: "orders-cdc",
+		Config: map[string]any{
+			"connector.class":  className,
+			"ssl.key.password": "changeit",
+		},
+	})
+	require.NotNil(t, restErr)
+	_, err = svc.SecretStore.Get(ctx, "local", "orders-cdc__ssl_2Ekey_2Epassword")
+	assert.ErrorIs(t, err, secrets.ErrSecretNotFound)
+	_, err = svc.SecretStore.Get(ctx, "local", "orders-cdc__database_2Epassword")
+	assert.NoError(t, err, "existing secrets must be kept")
+
+	// Deleting the connector deletes its secrets, but keeps secrets that have not
+	// been created for its configs.
+	_, restErr = svc.CreateConnector(ctx, "local", con.CreateConnectorRequest{
+		Name: "orders-cdc-2",
+		Config: map[string]any{
+			"connector.class":   className,
+			"database.password": "hunter2",
+		},
+	})
+	require.Nil(t, restErr)
+	manual := secrets.Secret{ID: "manual", Labels: map[string]string{"connector": "orders-cdc"}}
+	require.NoError(t, svc.SecretStore.Create(ctx, "local", manual, []byte("value")))
+
+	require.Nil(t, svc.DeleteConnector(ctx, "local", "orders-cdc"))
+	_, err = svc.SecretStore.Get(ctx, "local", "orders-cdc__database_2Epassword")
+	assert.ErrorIs(t, err, secrets.ErrSecretNotFound)
+	_, err = svc.SecretStore.Get(ctx, "local", "orders-cdc-2__database_2Epassword")
+	assert.NoError(t, err, "secrets of other connectors must be kept")
+	_, err = svc.SecretStore.Get(ctx, "local", "manual")
+	assert.NoError(t, err, "secrets that have not been created for the connector configs must be kept")
+}
+
+func TestConnectorSecretID(t *testing.T) {
+	assert.Equal(t, "orders-cdc__database_2Epassword", connectorSecretID("orders-cdc", "database.password"))
+
+	ids := make(map[string][2]string)
+	for _, pair := range [][2]string{
+		{"a", "b_c"},
+		{"a_b", "c"},
+		{"a_", "_b"},
+		{"a__b", ""},
+		{"my.conn", "password"},
+		{"my_conn", "password"},
+		{"my_2Econn", "password"},
+	} {
+		id := connectorSecretID(pair[0], pair[1])
+		if other, ok := ids[id]; ok {
+			t.Errorf("secret ID %q of %v collides with %v", id, pair, other)
+		}
+		ids[id] = pair
+	}
+}
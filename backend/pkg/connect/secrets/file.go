@@ -0,0 +1,241 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// FileStore stores all secrets in a single local file that is encrypted with
+// AES-256-GCM. The file consists of the nonce followed by the encrypted JSON
+// document. References have the format ${provider:path:cluster/id}, where the
+// provider is a custom config provider on the Kafka connect workers that can
+// decrypt the file.
+type FileStore struct {
+	cfg      config.ConnectSecretStoreFile
+	provider string
+	logger   *zap.Logger
+	aead     cipher.AEAD
+
+	mu      sync.Mutex
+	content fileStoreContent
+}
+
+var _ Store = (*FileStore)(nil)
+
+type fileStoreContent struct {
+	// Clusters holds all secrets by cluster name and secret ID.
+	Clusters map[string]map[string]fileStoreSecret `json:"clusters"`
+}
+
+type fileStoreSecret struct {
+	Labels map[string]string `json:"labels"`
+	Value  []byte            `json:"value"`
+}
+
+// NewFileStore creates a new FileStore and reads the existing secrets file, if
+// there is one.
+func NewFileStore(cfg config.ConnectSecretStoreFile, provider string, logger *zap.Logger) (*FileStore, error) {
+	key, err := base64.StdEncoding.DecodeString(cfg.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode encryption key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm cipher: %w", err)
+	}
+
+	f := &FileStore{
+		cfg:      cfg,
+		provider: provider,
+		logger:   logger.Named("connect_secret_file_store"),
+		aead:     aead,
+		content:  fileStoreContent{Clusters: make(map[string]map[string]fileStoreSecret)},
+	}
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Get implements Store.Get.
+func (f *FileStore) Get(_ context.Context, clusterName, id string) (Secret, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	s, exists := f.content.Clusters[clusterName][id]
+	if !exists {
+		return Secret{}, ErrSecretNotFound
+	}
+	return Secret{ID: id, Labels: copyLabels(s.Labels)}, nil
+}
+
+// List implements Store.List.
+func (f *FileStore) List(_ context.Context, clusterName string) ([]Secret, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets := make([]Secret, 0, len(f.content.Clusters[clusterName]))
+	for id, s := range f.content.Clusters[clusterName] {
+		secrets = append(secrets, Secret{ID: id, Labels: copyLabels(s.Labels)})
+	}
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].ID < secrets[j].ID })
+	return secrets, nil
+}
+
+// Create implements Store.Create.
+func (f *FileStore) Create(_ context.Context, clusterName string, secret Secret, value []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, exists := f.content.Clusters[clusterName][secret.ID]; exists {
+		return ErrSecretAlreadyExists
+	}
+	return f.set(clusterName, secret, value)
+}
+
+// Update implements Store.Update.
+func (f *FileStore) Update(_ context.Context, clusterName string, secret Secret, value []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, exists := f.content.Clusters[clusterName][secret.ID]; !exists {
+		return ErrSecretNotFound
+	}
+	return f.set(clusterName, secret, value)
+}
+
+// Delete implements Store.Delete.
+func (f *FileStore) Delete(_ context.Context, clusterName, id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	secrets := f.content.Clusters[clusterName]
+	previous, exists := secrets[id]
+	if !exists {
+		return ErrSecretNotFound
+	}
+	delete(secrets, id)
+	if err := f.save(); err != nil {
+		secrets[id] = previous
+		return err
+	}
+	return nil
+}
+
+// Reference implements Store.Reference.
+func (f *FileStore) Reference(clusterName, id string) string {
+	return reference(f.provider, f.cfg.Path, clusterName+"/"+id)
+}
+
+// set stores the secret and persists the file. The in-memory state is rolled back
+// if the file cannot be written. The caller must hold the lock.
+func (f *FileStore) set(clusterName string, secret Secret, value []byte) error {
+	secrets, exists := f.content.Clusters[clusterName]
+	if !exists {
+		secrets = make(map[string]fileStoreSecret)
+		f.content.Clusters[clusterName] = secrets
+	}
+
+	previous, existed := secrets[secret.ID]
+	secrets[secret.ID] = fileStoreSecret{Labels: copyLabels(secret.Labels), Value: value}
+	if err := f.save(); err != nil {
+		if existed {
+			secrets[secret.ID] = previous
+		} else {
+			delete(secrets, secret.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (f *FileStore) load() error {
+	encrypted, err := os.ReadFile(f.cfg.Path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			f.logger.Info("secrets file does not exist yet, it will be created with the first secret", zap.String("path", f.cfg.Path))
+			return nil
+		}
+		return fmt.Errorf("failed to read secrets file: %w", err)
+	}
+
+	nonceSize := f.aead.NonceSize()
+	if len(encrypted) < nonceSize {
+		return errors.New("secrets file is too short")
+	}
+	plaintext, err := f.aead.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], nil)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt secrets file: %w", err)
+	}
+
+	var content fileStoreContent
+	if err := json.Unmarshal(plaintext, &content); err != nil {
+		return fmt.Errorf("failed to decode secrets file: %w", err)
+	}
+	if content.Clusters == nil {
+		content.Clusters = make(map[string]map[string]fileStoreSecret)
+	}
+	f.content = content
+	return nil
+}
+
+// save encrypts and writes the secrets to a temporary file, which then replaces
+// the secrets file, so that the file is never partially written.
+func (f *FileStore) save() error {
+	plaintext, err := json.Marshal(f.content)
+	if err != nil {
+		return fmt.Errorf("failed to encode secrets: %w", err)
+	}
+	nonce := make([]byte, f.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	encrypted := f.aead.Seal(nonce, nonce, plaintext, nil)
+
+	tmp, err := os.CreateTemp(filepath.Dir(f.cfg.Path), filepath.Base(f.cfg.Path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary secrets file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(encrypted); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write secrets file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write secrets file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.cfg.Path); err != nil {
+		return fmt.Errorf("failed to replace secrets file: %w", err)
+	}
+	return nil
+}
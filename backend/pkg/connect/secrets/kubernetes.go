@@ -0,0 +1,208 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secrets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+const (
+	kubernetesManagedByLabel      = "app.kubernetes.io/managed-by"
+	kubernetesManagedByValue      = "redpanda-console"
+	kubernetesClusterAnnotation   = "console.redpanda.com/connect-cluster"
+	kubernetesSecretIDAnnotation  = "console.redpanda.com/secret-id"
+	kubernetesLabelsAnnotation    = "console.redpanda.com/labels"
+	kubernetesMaxSecretNameLength = 253
+)
+
+// KubernetesSecret is the subset of a Kubernetes secret that is used by the
+// KubernetesStore.
+type KubernetesSecret struct {
+	Name        string
+	Labels      map[string]string
+	Annotations map[string]string
+	Data        map[string][]byte
+}
+
+// KubernetesClient manages Kubernetes secrets. GetSecret, UpdateSecret and
+// DeleteSecret return ErrSecretNotFound if the secret does not exist, CreateSecret
+// returns ErrSecretAlreadyExists if it exists.
+type KubernetesClient interface {
+	GetSecret(ctx context.Context, namespace, name string) (KubernetesSecret, error)
+	ListSecrets(ctx context.Context, namespace, labelSelector string) ([]KubernetesSecret, error)
+	CreateSecret(ctx context.Context, namespace string, secret KubernetesSecret) error
+	UpdateSecret(ctx context.Context, namespace string, secret KubernetesSecret) error
+	DeleteSecret(ctx context.Context, namespace, name string) error
+}
+
+// KubernetesStore stores each connect secret as a Kubernetes secret. References
+// use the format of the Strimzi KubernetesSecretConfigProvider, e.g.
+// ${secrets:namespace/name:value}.
+type KubernetesStore struct {
+	cfg      config.ConnectSecretStoreKubernetes
+	provider string
+	client   KubernetesClient
+}
+
+var _ Store = (*KubernetesStore)(nil)
+
+// NewKubernetesStore creates a new KubernetesStore.
+func NewKubernetesStore(cfg config.ConnectSecretStoreKubernetes, provider string, client KubernetesClient) (*KubernetesStore, error) {
+	if cfg.Namespace == "" {
+		return nil, errors.New("kubernetes namespace must be set")
+	}
+	return &KubernetesStore{
+		cfg:      cfg,
+		provider: provider,
+		client:   client,
+	}, nil
+}
+
+// Get implements Store.Get.
+func (k *KubernetesStore) Get(ctx context.Context, clusterName, id string) (Secret, error) {
+	s, err := k.client.GetSecret(ctx, k.cfg.Namespace, k.secretName(clusterName, id))
+	if err != nil {
+		return Secret{}, err
+	}
+	if !k.isOwned(s, clusterName, id) {
+		return Secret{}, ErrSecretNotFound
+	}
+	return kubernetesToSecret(s)
+}
+
+// List implements Store.List.
+func (k *KubernetesStore) List(ctx context.Context, clusterName string) ([]Secret, error) {
+	list, err := k.client.ListSecrets(ctx, k.cfg.Namespace, kubernetesManagedByLabel+"="+kubernetesManagedByValue)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make([]Secret, 0, len(list))
+	for _, s := range list {
+		if s.Annotations[kubernetesClusterAnnotation] != clusterName {
+			continue
+		}
+		secret, err := kubernetesToSecret(s)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].ID < secrets[j].ID })
+	return secrets, nil
+}
+
+// Create implements Store.Create.
+func (k *KubernetesStore) Create(ctx context.Context, clusterName string, secret Secret, value []byte) error {
+	s, err := k.toKubernetes(clusterName, secret, value)
+	if err != nil {
+		return err
+	}
+	return k.client.CreateSecret(ctx, k.cfg.Namespace, s)
+}
+
+// Update implements Store.Update.
+func (k *KubernetesStore) Update(ctx context.Context, clusterName string, secret Secret, value []byte) error {
+	if _, err := k.Get(ctx, clusterName, secret.ID); err != nil {
+		return err
+	}
+	s, err := k.toKubernetes(clusterName, secret, value)
+	if err != nil {
+		return err
+	}
+	return k.client.UpdateSecret(ctx, k.cfg.Namespace, s)
+}
+
+// Delete implements Store.Delete.
+func (k *KubernetesStore) Delete(ctx context.Context, clusterName, id string) error {
+	if _, err := k.Get(ctx, clusterName, id); err != nil {
+		return err
+	}
+	return k.client.DeleteSecret(ctx, k.cfg.Namespace, k.secretName(clusterName, id))
+}
+
+// Reference implements Store.Reference.
+func (k *KubernetesStore) Reference(clusterName, id string) string {
+	return reference(k.provider, k.cfg.Namespace+"/"+k.secretName(clusterName, id), k.cfg.DataKey)
+}
+
+// secretName returns a valid Kubernetes secret name. Names are lowercased and
+// invalid characters are replaced, so a hash of the cluster name and ID is
+// appended to keep the names unique.
+func (k *KubernetesStore) secretName(clusterName, id string) string {
+	hash := sha256.Sum256([]byte(clusterName + "/" + id))
+	suffix := "-" + hex.EncodeToString(hash[:4])
+
+	name := sanitizeKubernetesName(k.cfg.NamePrefix + "-" + clusterName + "-" + id)
+	if maxLen := kubernetesMaxSecretNameLength - len(suffix); len(name) > maxLen {
+		name = name[:maxLen]
+	}
+	return name + suffix
+}
+
+func (*KubernetesStore) isOwned(s KubernetesSecret, clusterName, id string) bool {
+	return s.Labels[kubernetesManagedByLabel] == kubernetesManagedByValue &&
+		s.Annotations[kubernetesClusterAnnotation] == clusterName &&
+		s.Annotations[kubernetesSecretIDAnnotation] == id
+}
+
+func (k *KubernetesStore) toKubernetes(clusterName string, secret Secret, value []byte) (KubernetesSecret, error) {
+	labels, err := json.Marshal(copyLabels(secret.Labels))
+	if err != nil {
+		return KubernetesSecret{}, fmt.Errorf("failed to encode labels: %w", err)
+	}
+	return KubernetesSecret{
+		Name:   k.secretName(clusterName, secret.ID),
+		Labels: map[string]string{kubernetesManagedByLabel: kubernetesManagedByValue},
+		Annotations: map[string]string{
+			kubernetesClusterAnnotation:  clusterName,
+			kubernetesSecretIDAnnotation: secret.ID,
+			kubernetesLabelsAnnotation:   string(labels),
+		},
+		Data: map[string][]byte{k.cfg.DataKey: value},
+	}, nil
+}
+
+func kubernetesToSecret(s KubernetesSecret) (Secret, error) {
+	labels := make(map[string]string)
+	if encoded := s.Annotations[kubernetesLabelsAnnotation]; encoded != "" {
+		if err := json.Unmarshal([]byte(encoded), &labels); err != nil {
+			return Secret{}, fmt.Errorf("failed to decode labels of kubernetes secret %q: %w", s.Name, err)
+		}
+	}
+	return Secret{
+		ID:     s.Annotations[kubernetesSecretIDAnnotation],
+		Labels: labels,
+	}, nil
+}
+
+// sanitizeKubernetesName lowercases the name and replaces all characters that are
+// not allowed in Kubernetes object names with a dash.
+func sanitizeKubernetesName(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '.':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('-')
+		}
+	}
+	return strings.Trim(sb.String(), "-.")
+}
@@ -0,0 +1,213 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// kubernetesHTTPClient implements KubernetesClient against the Kubernetes REST
+// API using the service account token.
+type kubernetesHTTPClient struct {
+	baseURL       string
+	tokenFilepath string
+	httpClient    *http.Client
+}
+
+var _ KubernetesClient = (*kubernetesHTTPClient)(nil)
+
+type kubernetesSecretResource struct {
+	APIVersion string                   `json:"apiVersion"`
+	Kind       string                   `json:"kind"`
+	Metadata   kubernetesObjectMetadata `json:"metadata"`
+	Type       string                   `json:"type,omitempty"`
+	Data       map[string][]byte        `json:"data,omitempty"`
+}
+
+type kubernetesObjectMetadata struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type kubernetesSecretList struct {
+	Items []kubernetesSecretResource `json:"items"`
+}
+
+type kubernetesStatus struct {
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
+}
+
+func newKubernetesHTTPClient(cfg config.ConnectSecretStoreKubernetes) (*kubernetesHTTPClient, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CAFilepath != "" {
+		ca, err := os.ReadFile(cfg.CAFilepath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kubernetes ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse kubernetes ca file %q", cfg.CAFilepath)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	return &kubernetesHTTPClient{
+		baseURL:       strings.TrimSuffix(cfg.APIServerURL, "/"),
+		tokenFilepath: cfg.TokenFilepath,
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsCfg},
+		},
+	}, nil
+}
+
+// kubernetesNamespace returns the namespace of the service account that Console
+// runs with.
+func kubernetesNamespace() (string, error) {
+	namespace, err := os.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/namespace")
+	if err != nil {
+		return "", fmt.Errorf("failed to read namespace of service account: %w", err)
+	}
+	return strings.TrimSpace(string(namespace)), nil
+}
+
+func (c *kubernetesHTTPClient) GetSecret(ctx context.Context, namespace, name string) (KubernetesSecret, error) {
+	var res kubernetesSecretResource
+	if err := c.do(ctx, http.MethodGet, c.secretPath(namespace, name), nil, &res); err != nil {
+		return KubernetesSecret{}, err
+	}
+	return resourceToKubernetesSecret(res), nil
+}
+
+func (c *kubernetesHTTPClient) ListSecrets(ctx context.Context, namespace, labelSelector string) ([]KubernetesSecret, error) {
+	path := c.secretPath(namespace, "") + "?labelSelector=" + url.QueryEscape(labelSelector)
+	var res kubernetesSecretList
+	if err := c.do(ctx, http.MethodGet, path, nil, &res); err != nil {
+		return nil, err
+	}
+	secrets := make([]KubernetesSecret, len(res.Items))
+	for i, item := range res.Items {
+		secrets[i] = resourceToKubernetesSecret(item)
+	}
+	return secrets, nil
+}
+
+func (c *kubernetesHTTPClient) CreateSecret(ctx context.Context, namespace string, secret KubernetesSecret) error {
+	return c.do(ctx, http.MethodPost, c.secretPath(namespace, ""), kubernetesSecretToResource(namespace, secret), nil)
+}
+
+func (c *kubernetesHTTPClient) UpdateSecret(ctx context.Context, namespace string, secret KubernetesSecret) error {
+	return c.do(ctx, http.MethodPut, c.secretPath(namespace, secret.Name), kubernetesSecretToResource(namespace, secret), nil)
+}
+
+func (c *kubernetesHTTPClient) DeleteSecret(ctx context.Context, namespace, name string) error {
+	return c.do(ctx, http.MethodDelete, c.secretPath(namespace, name), nil, nil)
+}
+
+func (c *kubernetesHTTPClient) secretPath(namespace, name string) string {
+	path := "/api/v1/namespaces/" + url.PathEscape(namespace) + "/secrets"
+	if name != "" {
+		path += "/" + url.PathEscape(name)
+	}
+	return path
+}
+
+func (c *kubernetesHTTPClient) do(ctx context.Context, method, path string, body, result any) error {
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.tokenFilepath != "" {
+		// The token is read on each request, because service account tokens are rotated.
+		token, err := os.ReadFile(c.tokenFilepath)
+		if err != nil {
+			return fmt.Errorf("failed to read service account token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request to kubernetes: %w", err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return ErrSecretNotFound
+	case res.StatusCode == http.StatusConflict:
+		return ErrSecretAlreadyExists
+	case res.StatusCode >= 300:
+		var status kubernetesStatus
+		_ = json.NewDecoder(res.Body).Decode(&status)
+		return fmt.Errorf("kubernetes returned status %d: %s", res.StatusCode, status.Message)
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode kubernetes response: %w", err)
+	}
+	return nil
+}
+
+func resourceToKubernetesSecret(res kubernetesSecretResource) KubernetesSecret {
+	return KubernetesSecret{
+		Name:        res.Metadata.Name,
+		Labels:      res.Metadata.Labels,
+		Annotations: res.Metadata.Annotations,
+		Data:        res.Data,
+	}
+}
+
+func kubernetesSecretToResource(namespace string, secret KubernetesSecret) kubernetesSecretResource {
+	return kubernetesSecretResource{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata: kubernetesObjectMetadata{
+			Name:        secret.Name,
+			Namespace:   namespace,
+			Labels:      secret.Labels,
+			Annotations: secret.Annotations,
+		},
+		Type: "Opaque",
+		Data: secret.Data,
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package secrets implements stores for the secrets of Kafka connect connectors.
+// Connectors refer to stored secrets via config provider references such as
+// ${vault:secret/path:key}, which are resolved by the Kafka connect workers.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+var (
+	// ErrSecretNotFound is returned if a secret does not exist.
+	ErrSecretNotFound = errors.New("secret not found")
+	// ErrSecretAlreadyExists is returned when creating a secret that already exists.
+	ErrSecretAlreadyExists = errors.New("secret already exists")
+)
+
+// Secret is a secret of a Kafka connect cluster. The value of a secret is never
+// returned by a store.
+type Secret struct {
+	ID     string
+	Labels map[string]string
+}
+
+// Store manages the secrets of Kafka connect clusters. Secrets of different
+// clusters are kept apart, so that the same ID can be used in each cluster.
+type Store interface {
+	Get(ctx context.Context, clusterName, id string) (Secret, error)
+	List(ctx context.Context, clusterName string) ([]Secret, error)
+	// Create stores a new secret and returns ErrSecretAlreadyExists if a secret
+	// with the same ID exists.
+	Create(ctx context.Context, clusterName string, secret Secret, value []byte) error
+	// Update replaces the value and labels of an existing secret and returns
+	// ErrSecretNotFound if the secret does not exist.
+	Update(ctx context.Context, clusterName string, secret Secret, value []byte) error
+	Delete(ctx context.Context, clusterName, id string) error
+
+	// Reference returns the config provider reference that resolves to the value
+	// of the secret on the Kafka connect workers.
+	Reference(clusterName, id string) string
+}
+
+// NewStore creates the secret store of the configured type.
+func NewStore(cfg config.ConnectSecretStore, logger *zap.Logger) (Store, error) {
+	switch cfg.Type {
+	case config.ConnectSecretStoreTypeKubernetes:
+		if cfg.Kubernetes.Namespace == "" {
+			namespace, err := kubernetesNamespace()
+			if err != nil {
+				return nil, err
+			}
+			cfg.Kubernetes.Namespace = namespace
+		}
+		client, err := newKubernetesHTTPClient(cfg.Kubernetes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+		}
+		return NewKubernetesStore(cfg.Kubernetes, providerOrDefault(cfg.ConfigProvider, "secrets"), client)
+	case config.ConnectSecretStoreTypeVault:
+		return NewVaultStore(cfg.Vault, providerOrDefault(cfg.ConfigProvider, "vault"))
+	case config.ConnectSecretStoreTypeFile:
+		return NewFileStore(cfg.File, cfg.ConfigProvider, logger)
+	default:
+		return nil, fmt.Errorf("unknown secret store type %q", cfg.Type)
+	}
+}
+
+func providerOrDefault(provider, defaultProvider string) string {
+	if provider == "" {
+		return defaultProvider
+	}
+	return provider
+}
+
+// reference formats a config provider reference.
+func reference(provider, path, key string) string {
+	return fmt.Sprintf("${%s:%s:%s}", provider, path, key)
+}
+
+// IsReference returns whether the given config value is a config provider reference.
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, "${") && strings.HasSuffix(value, "}")
+}
+
+// copyLabels returns a copy of the given labels that is never nil.
+func copyLabels(labels map[string]string) map[string]string {
+	copied := make(map[string]string, len(labels))
+	for k, v := range labels {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,293 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// testStore runs the behavior that all stores must implement.
+func testStore(t *testing.T, store Store) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := store.Get(ctx, "local", "db-password")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+	require.ErrorIs(t, store.Update(ctx, "local", Secret{ID: "db-password"}, []byte("x")), ErrSecretNotFound)
+	require.ErrorIs(t, store.Delete(ctx, "local", "db-password"), ErrSecretNotFound)
+
+	require.NoError(t, store.Create(ctx, "local", Secret{ID: "db-password", Labels: map[string]string{"team": "payments"}}, []byte("hunter2")))
+	require.NoError(t, store.Create(ctx, "local", Secret{ID: "api-key"}, []byte("key")))
+	require.NoError(t, store.Create(ctx, "other", Secret{ID: "db-password"}, []byte("other")))
+	require.ErrorIs(t, store.Create(ctx, "local", Secret{ID: "db-password"}, []byte("x")), ErrSecretAlreadyExists)
+
+	secret, err := store.Get(ctx, "local", "db-password")
+	require.NoError(t, err)
+	assert.Equal(t, Secret{ID: "db-password", Labels: map[string]string{"team": "payments"}}, secret)
+
+	list, err := store.List(ctx, "local")
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "api-key", list[0].ID)
+	assert.Equal(t, "db-password", list[1].ID)
+
+	require.NoError(t, store.Update(ctx, "local", Secret{ID: "db-password", Labels: map[string]string{"team": "orders"}}, []byte("hunter3")))
+	secret, err = store.Get(ctx, "local", "db-password")
+	require.NoError(t, err)
+	assert.Equal(t, "orders", secret.Labels["team"])
+
+	require.NoError(t, store.Delete(ctx, "local", "db-password"))
+	_, err = store.Get(ctx, "local", "db-password")
+	require.ErrorIs(t, err, ErrSecretNotFound)
+
+	// Secrets of other clusters are not affected.
+	_, err = store.Get(ctx, "other", "db-password")
+	require.NoError(t, err)
+
+	assert.True(t, IsReference(store.Reference("local", "api-key")))
+}
+
+type fakeKubernetesClient struct {
+	mu      sync.Mutex
+	secrets map[string]KubernetesSecret
+}
+
+func (f *fakeKubernetesClient) GetSecret(_ context.Context, namespace, name string) (KubernetesSecret, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s, exists := f.secrets[namespace+"/"+name]
+	if !exists {
+		return KubernetesSecret{}, ErrSecretNotFound
+	}
+	return s, nil
+}
+
+func (f *fakeKubernetesClient) ListSecrets(_ context.Context, namespace, labelSelector string) ([]KubernetesSecret, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key, value, _ := strings.Cut(labelSelector, "=")
+	var list []KubernetesSecret
+	for name, s := range f.secrets {
+		if strings.HasPrefix(name, namespace+"/") && s.Labels[key] == value {
+			list = append(list, s)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeKubernetesClient) CreateSecret(_ context.Context, namespace string, secret KubernetesSecret) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, exists := f.secrets[namespace+"/"+secret.Name]; exists {
+		return ErrSecretAlreadyExists
+	}
+	f.secrets[namespace+"/"+secret.Name] = secret
+	return nil
+}
+
+func (f *fakeKubernetesClient) UpdateSecret(_ context.Context, namespace string, secret KubernetesSecret) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, exists := f.secrets[namespace+"/"+secret.Name]; !exists {
+		return ErrSecretNotFound
+	}
+	f.secrets[namespace+"/"+secret.Name] = secret
+	return nil
+}
+
+func (f *fakeKubernetesClient) DeleteSecret(_ context.Context, namespace, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.secrets, namespace+"/"+name)
+	return nil
+}
+
+func TestKubernetesStore(t *testing.T) {
+	cfg := config.ConnectSecretStore{}
+	cfg.SetDefaults()
+	cfg.Kubernetes.Namespace = "connect"
+	client := &fakeKubernetesClient{secrets: make(map[string]KubernetesSecret)}
+
+	store, err := NewKubernetesStore(cfg.Kubernetes, "secrets", client)
+	require.NoError(t, err)
+	testStore(t, store)
+
+	// The value is stored under the data key and the reference points to it.
+	ref := store.Reference("local", "api-key")
+	assert.Regexp(t, `^\$\{secrets:connect/console-connect-local-api-key-[0-9a-f]{8}:value\}$`, ref)
+	name := strings.TrimSuffix(strings.TrimPrefix(ref, "${secrets:connect/"), ":value}")
+	s, err := client.GetSecret(context.Background(), "connect", name)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key"), s.Data["value"])
+
+	// IDs that only differ in case result in different secret names.
+	assert.NotEqual(t, store.secretName("local", "Key"), store.secretName("local", "key"))
+}
+
+// fakeVault implements the subset of the KV version 2 API that is used by the VaultStore.
+type fakeVault struct {
+	mu       sync.Mutex
+	data     map[string]map[string]string
+	metadata map[string]map[string]string
+}
+
+func newFakeVault(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	v := &fakeVault{data: make(map[string]map[string]string), metadata: make(map[string]map[string]string)}
+	srv := httptest.NewServer(http.HandlerFunc(v.handle))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func (v *fakeVault) handle(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != "root" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	writeData := func(data any) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{}})
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+		p := strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")
+		var body struct {
+			Data    map[string]string `json:"data"`
+			Options map[string]int    `json:"options"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if cas, exists := body.Options["cas"]; exists && cas == 0 && v.data[p] != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		v.data[p] = body.Data
+		if v.metadata[p] == nil {
+			v.metadata[p] = map[string]string{}
+		}
+		writeData(map[string]any{"version": 1})
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/"):
+		p := strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/")
+		switch r.Method {
+		case "LIST":
+			var keys []string
+			seen := make(map[string]bool)
+			for existing := range v.data {
+				if rest, ok := strings.CutPrefix(existing, p); ok {
+					if dir, _, nested := strings.Cut(rest, "/"); nested {
+						rest = dir + "/"
+					}
+					if !seen[rest] {
+						seen[rest] = true
+						keys = append(keys, rest)
+					}
+				}
+			}
+			if len(keys) == 0 {
+				notFound()
+				return
+			}
+			sort.Strings(keys)
+			writeData(map[string]any{"keys": keys})
+		case http.MethodGet:
+			if v.data[p] == nil {
+				notFound()
+				return
+			}
+			writeData(map[string]any{"custom_metadata": v.metadata[p]})
+		case http.MethodPost:
+			var body struct {
+				CustomMetadata map[string]string `json:"custom_metadata"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			v.metadata[p] = body.CustomMetadata
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			delete(v.data, p)
+			delete(v.metadata, p)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		notFound()
+	}
+}
+
+func TestVaultStore(t *testing.T) {
+	srv := newFakeVault(t)
+	cfg := config.ConnectSecretStore{}
+	cfg.SetDefaults()
+	cfg.Vault.Address = srv.URL
+	cfg.Vault.Token = "root"
+
+	store, err := NewVaultStore(cfg.Vault, "vault")
+	require.NoError(t, err)
+	testStore(t, store)
+
+	assert.Equal(t, "${vault:secret/redpanda-console/connect/local/api-key:value}", store.Reference("local", "api-key"))
+
+	// IDs with slashes are listed recursively.
+	require.NoError(t, store.Create(context.Background(), "nested", Secret{ID: "team/db"}, []byte("x")))
+	list, err := store.List(context.Background(), "nested")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "team/db", list[0].ID)
+
+	cfg.Vault.Token = "invalid"
+	unauthorized, err := NewVaultStore(cfg.Vault, "vault")
+	require.NoError(t, err)
+	_, err = unauthorized.List(context.Background(), "local")
+	require.Error(t, err)
+}
+
+func TestFileStore(t *testing.T) {
+	cfg := config.ConnectSecretStoreFile{
+		Path:          filepath.Join(t.TempDir(), "secrets.enc"),
+		EncryptionKey: base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+	}
+
+	store, err := NewFileStore(cfg, "file", zap.NewNop())
+	require.NoError(t, err)
+	testStore(t, store)
+
+	// Secrets are persisted and can only be read with the same key.
+	reopened, err := NewFileStore(cfg, "file", zap.NewNop())
+	require.NoError(t, err)
+	secret, err := reopened.Get(context.Background(), "local", "api-key")
+	require.NoError(t, err)
+	assert.Equal(t, "api-key", secret.ID)
+	assert.Equal(t, []byte("key"), reopened.content.Clusters["local"]["api-key"].Value)
+
+	cfg.EncryptionKey = base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210"))
+	_, err = NewFileStore(cfg, "file", zap.NewNop())
+	require.Error(t, err)
+}
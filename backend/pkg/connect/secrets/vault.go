@@ -0,0 +1,241 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// VaultStore stores secrets in a HashiCorp Vault KV version 2 secrets engine.
+// Labels are stored as custom metadata. References point to the logical path
+// of the secret, e.g. ${vault:secret/redpanda-console/connect/cluster/id:value}.
+type VaultStore struct {
+	cfg        config.ConnectSecretStoreVault
+	provider   string
+	httpClient *http.Client
+}
+
+var _ Store = (*VaultStore)(nil)
+
+type vaultResponse struct {
+	Data json.RawMessage `json:"data"`
+}
+
+type vaultMetadata struct {
+	CustomMetadata map[string]string `json:"custom_metadata"`
+}
+
+type vaultList struct {
+	Keys []string `json:"keys"`
+}
+
+type vaultErrors struct {
+	Errors []string `json:"errors"`
+}
+
+// NewVaultStore creates a new VaultStore.
+func NewVaultStore(cfg config.ConnectSecretStoreVault, provider string) (*VaultStore, error) {
+	tlsCfg, err := cfg.TLS.TLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vault tls config: %w", err)
+	}
+	return &VaultStore{
+		cfg:      cfg,
+		provider: provider,
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsCfg},
+		},
+	}, nil
+}
+
+// Get implements Store.Get.
+func (v *VaultStore) Get(ctx context.Context, clusterName, id string) (Secret, error) {
+	var metadata vaultMetadata
+	if err := v.do(ctx, http.MethodGet, v.url("metadata", v.secretPath(clusterName, id)), nil, &metadata); err != nil {
+		return Secret{}, err
+	}
+	return Secret{ID: id, Labels: copyLabels(metadata.CustomMetadata)}, nil
+}
+
+// List implements Store.List.
+func (v *VaultStore) List(ctx context.Context, clusterName string) ([]Secret, error) {
+	ids, err := v.listIDs(ctx, clusterName, "")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(ids)
+
+	secrets := make([]Secret, 0, len(ids))
+	for _, id := range ids {
+		secret, err := v.Get(ctx, clusterName, id)
+		if err != nil {
+			if errors.Is(err, ErrSecretNotFound) {
+				// Deleted in the meantime.
+				continue
+			}
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+// listIDs lists the IDs of all secrets below the given directory. IDs may contain
+// slashes, which are listed as sub directories by Vault.
+func (v *VaultStore) listIDs(ctx context.Context, clusterName, dir string) ([]string, error) {
+	var list vaultList
+	err := v.do(ctx, "LIST", v.url("metadata", v.secretPath(clusterName, dir)), nil, &list)
+	if err != nil {
+		if errors.Is(err, ErrSecretNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var ids []string
+	for _, key := range list.Keys {
+		if strings.HasSuffix(key, "/") {
+			nested, err := v.listIDs(ctx, clusterName, dir+key)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, nested...)
+			continue
+		}
+		ids = append(ids, dir+key)
+	}
+	return ids, nil
+}
+
+// Create implements Store.Create.
+func (v *VaultStore) Create(ctx context.Context, clusterName string, secret Secret, value []byte) error {
+	_, err := v.Get(ctx, clusterName, secret.ID)
+	if err == nil {
+		return ErrSecretAlreadyExists
+	}
+	if !errors.Is(err, ErrSecretNotFound) {
+		return err
+	}
+	// A check-and-set value of 0 only writes the secret if it does not exist yet.
+	return v.write(ctx, clusterName, secret, value, map[string]any{"cas": 0})
+}
+
+// Update implements Store.Update.
+func (v *VaultStore) Update(ctx context.Context, clusterName string, secret Secret, value []byte) error {
+	if _, err := v.Get(ctx, clusterName, secret.ID); err != nil {
+		return err
+	}
+	return v.write(ctx, clusterName, secret, value, nil)
+}
+
+func (v *VaultStore) write(ctx context.Context, clusterName string, secret Secret, value []byte, options map[string]any) error {
+	secretPath := v.secretPath(clusterName, secret.ID)
+	body := map[string]any{"data": map[string]string{v.cfg.DataKey: string(value)}}
+	if options != nil {
+		body["options"] = options
+	}
+	if err := v.do(ctx, http.MethodPost, v.url("data", secretPath), body, nil); err != nil {
+		return err
+	}
+
+	metadata := map[string]any{"custom_metadata": copyLabels(secret.Labels)}
+	if err := v.do(ctx, http.MethodPost, v.url("metadata", secretPath), metadata, nil); err != nil {
+		return fmt.Errorf("failed to store labels: %w", err)
+	}
+	return nil
+}
+
+// Delete implements Store.Delete. All versions of the secret are deleted.
+func (v *VaultStore) Delete(ctx context.Context, clusterName, id string) error {
+	if _, err := v.Get(ctx, clusterName, id); err != nil {
+		return err
+	}
+	return v.do(ctx, http.MethodDelete, v.url("metadata", v.secretPath(clusterName, id)), nil, nil)
+}
+
+// Reference implements Store.Reference.
+func (v *VaultStore) Reference(clusterName, id string) string {
+	return reference(v.provider, path.Join(v.cfg.MountPath, v.secretPath(clusterName, id)), v.cfg.DataKey)
+}
+
+func (v *VaultStore) secretPath(clusterName, id string) string {
+	p := path.Join(v.cfg.PathPrefix, clusterName, id)
+	if strings.HasSuffix(id, "/") || id == "" {
+		p += "/"
+	}
+	return p
+}
+
+func (v *VaultStore) url(api, secretPath string) string {
+	return strings.TrimSuffix(v.cfg.Address, "/") + "/v1/" + strings.Trim(v.cfg.MountPath, "/") + "/" + api + "/" + secretPath
+}
+
+func (v *VaultStore) do(ctx context.Context, method, url string, body, result any) error {
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", v.cfg.Token)
+	if v.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.cfg.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request to vault: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrSecretNotFound
+	}
+	if res.StatusCode >= 300 {
+		var vaultErr vaultErrors
+		_ = json.NewDecoder(res.Body).Decode(&vaultErr)
+		return fmt.Errorf("vault returned status %d: %s", res.StatusCode, strings.Join(vaultErr.Errors, ", "))
+	}
+
+	if result == nil {
+		return nil
+	}
+	var envelope vaultResponse
+	if err := json.NewDecoder(res.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode vault response: %w", err)
+	}
+	if err := json.Unmarshal(envelope.Data, result); err != nil {
+		return fmt.Errorf("failed to decode vault response data: %w", err)
+	}
+	return nil
+}
@@ -12,6 +12,8 @@ package connect
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
+	"regexp"
 	"sync"
 	"sync/atomic"
 
@@ -19,6 +21,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect/discovery"
+	"github.com/redpanda-data/console/backend/pkg/connect/secrets"
 	"github.com/redpanda-data/console/backend/pkg/connector/interceptor"
 )
 
@@ -29,6 +33,26 @@ type Service struct {
 	// ClientsByCluster holds the Client and config. The key is the clusters' name
 	ClientsByCluster map[string]*ClientWithConfig
 	Interceptor      *interceptor.Interceptor
+
+	// History records all connector config changes. It is nil if the
+	// connector config history is not enabled.
+	History              HistoryStore
+	sensitiveKeyPatterns []*regexp.Regexp
+
+	// SecretStore holds the secrets of connectors. It is nil if no secret store
+	// is configured.
+	SecretStore secrets.Store
+
+	pluginSnapshots *pluginSnapshotCache
+
+	// LoggerReverts persists the pending reverts of logger levels. It is nil
+	// if pending reverts are held in memory only.
+	LoggerReverts LoggerRevertStore
+
+	// loggerReverts are the pending reverts of logger levels that have been set
+	// via SetLoggerLevel.
+	loggerRevertsMu sync.Mutex
+	loggerReverts   map[loggerRevertKey]*loggerRevert
 }
 
 // ClientWithConfig carries the Kafka Connect client, along with the configuration
@@ -36,6 +60,112 @@ type Service struct {
 type ClientWithConfig struct {
 	Client *con.Client
 	Cfg    config.ConnectCluster
+
+	// Workers holds the discovered workers that requests are sent to. It is nil
+	// if worker discovery is not enabled for the cluster.
+	Workers *discovery.Pool
+}
+
+// Host returns the address of the Kafka connect cluster. If workers are
+// discovered, this is the worker that requests are currently sent to.
+func (c *ClientWithConfig) Host() string {
+	if c.Workers != nil {
+		return c.Workers.Preferred()
+	}
+	return c.Cfg.URL
+}
+
+// discoveryPlaceholderURL is the base URL of clients whose workers are
+// discovered. The host is replaced with a discovered worker for every request.
+const discoveryPlaceholderURL = "http://kafka-connect-workers"
+
+// baseURL returns the URL that requests are sent to before the worker discovery
+// transport replaces the host.
+func (c *ClientWithConfig) baseURL() string {
+	if c.Cfg.Discovery.Enabled {
+		return discoveryPlaceholderURL
+	}
+	return c.Cfg.URL
+}
+
+// newClientWithConfig creates the HTTP client for a single Kafka connect cluster,
+// including the transports for OAuth2 authentication and worker discovery.
+func newClientWithConfig(cfg config.Connect, clusterCfg config.ConnectCluster, logger *zap.Logger) (*ClientWithConfig, error) {
+	childLogger := logger.With(
+		zap.String("cluster_name", clusterCfg.Name),
+		zap.String("cluster_address", clusterCfg.URL))
+
+	opts := []con.ClientOption{
+		con.WithTimeout(cfg.ReadTimeout),
+		con.WithUserAgent("Redpanda Console"),
+	}
+
+	clientWithCfg := &ClientWithConfig{Cfg: clusterCfg}
+	opts = append(opts, con.WithHost(clientWithCfg.baseURL()))
+	// TLS Config. A configured client certificate is used for mTLS.
+	tlsCfg, err := clusterCfg.TLS.TLSConfig()
+	if err != nil {
+		childLogger.Error("failed to create TLS config for Kafka connect HTTP client, fallback to default TLS config", zap.Error(err))
+		tlsCfg = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	opts = append(opts, con.WithTLSConfig(tlsCfg))
+
+	// Basic Auth
+	if clusterCfg.Username != "" {
+		opts = append(opts, con.WithBasicAuth(clusterCfg.Username, clusterCfg.Password))
+	}
+
+	// Bearer Token
+	if clusterCfg.Token != "" {
+		opts = append(opts, con.WithAuthToken(clusterCfg.Token))
+	}
+
+	// Create client
+	clientWithCfg.Client = con.NewClient(opts...)
+	httpClient := clientWithCfg.Client.GetClient()
+
+	// Worker discovery
+	if clusterCfg.Discovery.Enabled {
+		scheme := clusterCfg.Discovery.Scheme
+		if scheme == "" {
+			scheme = "http"
+			if clusterCfg.TLS.Enabled {
+				scheme = "https"
+			}
+		}
+		discoverer, err := discovery.NewDiscoverer(clusterCfg.Discovery, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create worker discovery: %w", err)
+		}
+		clientWithCfg.Workers = discovery.NewPool(discoverer, httpClient.Transport, clusterCfg.Discovery.RefreshInterval, childLogger)
+		httpClient.Transport = clientWithCfg.Workers
+
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
+		defer cancel()
+		if err := clientWithCfg.Workers.Refresh(ctx); err != nil {
+			childLogger.Warn("failed to discover Kafka connect workers", zap.Error(err))
+		}
+	}
+
+	// OAuth2 client credentials
+	if clusterCfg.OAuth2.Enabled {
+		httpClient.Transport = &oauth2Transport{
+			tokenSource: newOAuth2TokenSource(clusterCfg.OAuth2),
+			base:        httpClient.Transport,
+		}
+	}
+
+	return clientWithCfg, nil
+}
+
+// StartWorkerDiscovery refreshes the discovered workers of all Kafka connect
+// clusters periodically until the context is cancelled.
+func (s *Service) StartWorkerDiscovery(ctx context.Context) {
+	for _, c := range s.ClientsByCluster {
+		if c.Workers != nil {
+			c.Workers.Start(ctx)
+		}
+	}
 }
 
 // NewService creates a new connect.Service. It tests the connectivity for each configured
@@ -43,12 +173,33 @@ type ClientWithConfig struct {
 func NewService(cfg config.Connect, logger *zap.Logger) (*Service, error) {
 	clientsByCluster := make(map[string]*ClientWithConfig)
 
+	sensitiveKeyPatterns := make([]*regexp.Regexp, len(cfg.History.SensitiveKeyPatterns))
+	for i, pattern := range cfg.History.SensitiveKeyPatterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile sensitive key pattern %q: %w", pattern, err)
+		}
+		sensitiveKeyPatterns[i] = compiled
+	}
+
+	var secretStore secrets.Store
+	if cfg.SecretStore.Enabled {
+		store, err := secrets.NewStore(cfg.SecretStore, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create secret store: %w", err)
+		}
+		secretStore = store
+	}
+
 	if len(cfg.Clusters) == 0 {
 		return &Service{
-			Cfg:              cfg,
-			Logger:           logger,
-			ClientsByCluster: clientsByCluster,
-			Interceptor:      interceptor.NewInterceptor(),
+			Cfg:                  cfg,
+			Logger:               logger,
+			ClientsByCluster:     clientsByCluster,
+			Interceptor:          interceptor.NewInterceptor(),
+			sensitiveKeyPatterns: sensitiveKeyPatterns,
+			SecretStore:          secretStore,
+			pluginSnapshots:      newPluginSnapshotCache(),
 		}, nil
 	}
 
@@ -56,48 +207,21 @@ func NewService(cfg config.Connect, logger *zap.Logger) (*Service, error) {
 	logger.Info("creating Kafka connect HTTP clients and testing connectivity to all clusters")
 
 	for _, clusterCfg := range cfg.Clusters {
-		// Create dedicated Connect HTTP Client for each cluster
-		childLogger := logger.With(
-			zap.String("cluster_name", clusterCfg.Name),
-			zap.String("cluster_address", clusterCfg.URL))
-
-		opts := []con.ClientOption{
-			con.WithTimeout(cfg.ReadTimeout),
-			con.WithUserAgent("Redpanda Console"),
-		}
-
-		opts = append(opts, con.WithHost(clusterCfg.URL))
-		// TLS Config
-		tlsCfg, err := clusterCfg.TLS.TLSConfig()
+		client, err := newClientWithConfig(cfg, clusterCfg, logger)
 		if err != nil {
-			childLogger.Error("failed to create TLS config for Kafka connect HTTP client, fallback to default TLS config", zap.Error(err))
-			tlsCfg = &tls.Config{MinVersion: tls.VersionTLS12}
-		}
-		opts = append(opts, con.WithTLSConfig(tlsCfg))
-
-		// Basic Auth
-		if clusterCfg.Username != "" {
-			opts = append(opts, con.WithBasicAuth(clusterCfg.Username, clusterCfg.Password))
-		}
-
-		// Bearer Token
-		if clusterCfg.Token != "" {
-			opts = append(opts, con.WithAuthToken(clusterCfg.Token))
-		}
-
-		// Create client
-		client := con.NewClient(opts...)
-		clientsByCluster[clusterCfg.Name] = &ClientWithConfig{
-			Client: client,
-			Cfg:    clusterCfg,
+			return nil, fmt.Errorf("failed to create client for Kafka connect cluster %q: %w", clusterCfg.Name, err)
 		}
+		clientsByCluster[clusterCfg.Name] = client
 	}
 
 	svc := &Service{
-		Cfg:              cfg,
-		Logger:           logger,
-		ClientsByCluster: clientsByCluster,
-		Interceptor:      interceptor.NewInterceptor(),
+		Cfg:                  cfg,
+		Logger:               logger,
+		ClientsByCluster:     clientsByCluster,
+		Interceptor:          interceptor.NewInterceptor(),
+		sensitiveKeyPatterns: sensitiveKeyPatterns,
+		SecretStore:          secretStore,
+		pluginSnapshots:      newPluginSnapshotCache(),
 	}
 
 	// 2. Test connectivity against each cluster concurrently
@@ -117,18 +241,18 @@ func (s *Service) TestConnectivity(ctx context.Context) {
 	wg := sync.WaitGroup{}
 	for _, clientInfo := range s.ClientsByCluster {
 		wg.Add(1)
-		go func(cfg config.ConnectCluster, c *con.Client) {
+		go func(cfg config.ConnectCluster, host string, c *con.Client) {
 			defer wg.Done()
 			_, err := c.GetRoot(ctx)
 			if err != nil {
 				s.Logger.Warn("connect cluster is not reachable",
 					zap.String("cluster_name", cfg.Name),
-					zap.String("cluster_address", cfg.URL),
+					zap.String("cluster_address", host),
 					zap.Error(err))
 				return
 			}
 			atomic.AddUint32(&successfulChecks, 1)
-		}(clientInfo.Cfg, clientInfo.Client)
+		}(clientInfo.Cfg, clientInfo.Host(), clientInfo.Client)
 	}
 	wg.Wait()
 	s.Logger.Info("tested Kafka connect cluster connectivity",
@@ -0,0 +1,289 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package status caches the connectors and their states of all configured
+// Kafka connect clusters, so that they can be filtered and paginated without
+// requesting every cluster for each page.
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redpanda-data/common-go/api/pagination"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+// pageTokenKey is the key of the keyset page tokens. Connectors are ordered by
+// their cluster name and connector name.
+const pageTokenKey = "cluster_connector"
+
+// ErrInvalidPageToken is returned if the page token cannot be decoded.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Aggregator periodically lists the connectors of all clusters and serves them
+// from memory.
+type Aggregator struct {
+	cfg        config.ConnectStatusAggregator
+	logger     *zap.Logger
+	connectSvc *connect.Service
+
+	mu       sync.RWMutex
+	clusters map[string]*clusterSnapshot
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// clusterSnapshot holds the connectors of the last successful refresh of a
+// cluster along with the outcome of the last refresh.
+type clusterSnapshot struct {
+	connectors      []connect.ClusterConnectorInfo
+	lastRefreshedAt time.Time
+	lastAttemptAt   time.Time
+	err             string
+}
+
+// ClusterStatus describes how recent the cached connectors of a cluster are.
+type ClusterStatus struct {
+	ClusterName string `json:"clusterName"`
+	// Stale is true if the last refresh of the cluster failed or timed out, in
+	// which case the connectors of the last successful refresh are served.
+	Stale bool `json:"stale"`
+	// Error is the error of the last refresh, if it failed.
+	Error string `json:"error,omitempty"`
+	// LastRefreshedAt is the time of the last successful refresh. It is zero if
+	// the cluster has never been refreshed successfully.
+	LastRefreshedAt time.Time `json:"lastRefreshedAt"`
+	TotalConnectors int       `json:"totalConnectors"`
+}
+
+// Connector is a connector along with the cluster that it runs in.
+type Connector struct {
+	ClusterName string `json:"clusterName"`
+	connect.ClusterConnectorInfo
+}
+
+// Filter restricts the connectors that are listed. Empty fields match all
+// connectors.
+type Filter struct {
+	// ClusterNames are the clusters whose connectors are listed.
+	ClusterNames []string
+	// States are matched against the connector's state (e.g. RUNNING) as well
+	// as the holistic status (e.g. DEGRADED), case-insensitively.
+	States []string
+	// Class is matched against the connector class, either the full class name
+	// or the simple name without package.
+	Class string
+	// NameRegex is matched against the connector name.
+	NameRegex *regexp.Regexp
+}
+
+// ListRequest is a request for a single page of connectors.
+type ListRequest struct {
+	Filter    Filter
+	PageSize  int
+	PageToken string
+}
+
+// ListResponse is a page of connectors.
+type ListResponse struct {
+	Connectors    []Connector `json:"connectors"`
+	NextPageToken string      `json:"nextPageToken"`
+	// TotalSize is the number of connectors that match the filter.
+	TotalSize int `json:"totalSize"`
+	// Clusters contains the status of all clusters that are matched by the filter.
+	Clusters []ClusterStatus `json:"clusters"`
+}
+
+// NewAggregator creates a new aggregator for all clusters of the given connect service.
+func NewAggregator(cfg config.ConnectStatusAggregator, logger *zap.Logger, connectSvc *connect.Service) *Aggregator {
+	clusters := make(map[string]*clusterSnapshot, len(connectSvc.ClientsByCluster))
+	for clusterName := range connectSvc.ClientsByCluster {
+		clusters[clusterName] = &clusterSnapshot{}
+	}
+
+	return &Aggregator{
+		cfg:        cfg,
+		logger:     logger.Named("connect_status_aggregator"),
+		connectSvc: connectSvc,
+		clusters:   clusters,
+		now:        time.Now,
+	}
+}
+
+// Start refreshes the connectors of all clusters in the background until the
+// context is cancelled.
+func (a *Aggregator) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(a.cfg.RefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			a.Refresh(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Refresh lists the connectors of all clusters concurrently. Each cluster is
+// given at most the configured cluster timeout.
+func (a *Aggregator) Refresh(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for clusterName := range a.clusters {
+		wg.Add(1)
+		go func(clusterName string) {
+			defer wg.Done()
+			a.refreshCluster(ctx, clusterName)
+		}(clusterName)
+	}
+	wg.Wait()
+}
+
+func (a *Aggregator) refreshCluster(ctx context.Context, clusterName string) {
+	refreshCtx, cancel := context.WithTimeout(ctx, a.cfg.ClusterTimeout)
+	defer cancel()
+
+	var errMsg string
+	res, restErr := a.connectSvc.GetClusterConnectors(refreshCtx, clusterName)
+	switch {
+	case restErr != nil:
+		errMsg = restErr.Message
+	case res.Error != "":
+		errMsg = res.Error
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	snapshot := a.clusters[clusterName]
+	snapshot.lastAttemptAt = a.now()
+	snapshot.err = errMsg
+	if errMsg != "" {
+		a.logger.Warn("failed to refresh connectors, keeping previous connectors",
+			zap.String("cluster_name", clusterName),
+			zap.String("error", errMsg))
+		return
+	}
+
+	connectors := res.Connectors
+	sort.Slice(connectors, func(i, j int) bool { return connectors[i].Name < connectors[j].Name })
+	snapshot.connectors = connectors
+	snapshot.lastRefreshedAt = snapshot.lastAttemptAt
+}
+
+// ClusterNames returns the names of all clusters in alphabetical order.
+func (a *Aggregator) ClusterNames() []string {
+	names := make([]string, 0, len(a.clusters))
+	for clusterName := range a.clusters {
+		names = append(names, clusterName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// List returns a page of the cached connectors that match the filter. The
+// connectors are ordered by cluster name and connector name. Page tokens remain
+// valid if connectors are added or removed between two requests.
+func (a *Aggregator) List(req ListRequest) (ListResponse, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	res := ListResponse{
+		Connectors: make([]Connector, 0),
+		Clusters:   make([]ClusterStatus, 0),
+	}
+	for _, clusterName := range a.ClusterNames() {
+		if len(req.Filter.ClusterNames) > 0 && !slices.Contains(req.Filter.ClusterNames, clusterName) {
+			continue
+		}
+		snapshot := a.clusters[clusterName]
+		res.Clusters = append(res.Clusters, ClusterStatus{
+			ClusterName:     clusterName,
+			Stale:           snapshot.err != "" || snapshot.lastRefreshedAt.IsZero(),
+			Error:           snapshot.err,
+			LastRefreshedAt: snapshot.lastRefreshedAt,
+			TotalConnectors: len(snapshot.connectors),
+		})
+		for _, connector := range snapshot.connectors {
+			if req.Filter.matches(connector) {
+				res.Connectors = append(res.Connectors, Connector{ClusterName: clusterName, ClusterConnectorInfo: connector})
+			}
+		}
+	}
+	res.TotalSize = len(res.Connectors)
+
+	// The connectors are already in the order of their page token key.
+	start := 0
+	if req.PageToken != "" {
+		token, err := pagination.DecodeToken(req.PageToken, []string{pageTokenKey})
+		if err != nil {
+			return ListResponse{}, fmt.Errorf("%w: %w", ErrInvalidPageToken, err)
+		}
+		start = sort.Search(len(res.Connectors), func(i int) bool {
+			return pageKey(res.Connectors[i]) >= token.ValueGreaterEqual
+		})
+	}
+	if req.PageSize <= 0 {
+		res.Connectors = res.Connectors[start:]
+		return res, nil
+	}
+
+	page, nextPageToken, err := pagination.SliceToPaginated(res.Connectors[start:], req.PageSize, pageTokenKey, pageKey)
+	if err != nil {
+		return ListResponse{}, fmt.Errorf("failed to create page token: %w", err)
+	}
+	res.Connectors = page
+	res.NextPageToken = nextPageToken
+	return res, nil
+}
+
+// pageKey returns the key that connectors are ordered by. The null byte sorts
+// before all characters that can be part of a name, so that the order of the
+// keys equals the order by cluster and connector name.
+func pageKey(c Connector) string {
+	return c.ClusterName + "\x00" + c.Name
+}
+
+func (f *Filter) matches(c connect.ClusterConnectorInfo) bool {
+	if len(f.States) > 0 {
+		matched := false
+		for _, state := range f.States {
+			if strings.EqualFold(state, c.State) || strings.EqualFold(state, c.Status) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if f.Class != "" && f.Class != c.Class && !strings.HasSuffix(c.Class, "."+f.Class) {
+		return false
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(c.Name) {
+		return false
+	}
+	return true
+}
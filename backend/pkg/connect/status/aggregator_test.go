@@ -0,0 +1,106 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package status
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/testutil"
+)
+
+func newTestAggregator(t *testing.T, clusters map[string]*testutil.MockKafkaConnect) *Aggregator {
+	t.Helper()
+
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.ConnectTimeout = time.Second
+	for name, mock := range clusters {
+		cfg.Clusters = append(cfg.Clusters, config.ConnectCluster{Name: name, URL: mock.URL})
+	}
+	cfg.StatusAggregator.Enabled = true
+	cfg.StatusAggregator.ClusterTimeout = time.Second
+	require.NoError(t, cfg.Validate())
+
+	svc, err := connect.NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	return NewAggregator(cfg.StatusAggregator, zap.NewNop(), svc)
+}
+
+func connectorNames(connectors []Connector) []string {
+	names := make([]string, len(connectors))
+	for i, c := range connectors {
+		names[i] = c.ClusterName + "/" + c.Name
+	}
+	return names
+}
+
+func TestAggregatorFilterAndPagination(t *testing.T) {
+	staging := testutil.NewMockKafkaConnect(t)
+	staging.AddConnector(testutil.MockConnector{Name: "orders-cdc", Type: "source", State: "RUNNING", Config: map[string]string{"connector.class": "io.debezium.connector.postgresql.PostgresConnector"}})
+	staging.AddConnector(testutil.MockConnector{Name: "orders-s3", Type: "sink", State: "PAUSED", Config: map[string]string{"connector.class": "io.confluent.connect.s3.S3SinkConnector"}})
+	prod := testutil.NewMockKafkaConnect(t)
+	prod.AddConnector(testutil.MockConnector{Name: "orders-cdc", Type: "source", State: "RUNNING", Config: map[string]string{"connector.class": "io.debezium.connector.postgresql.PostgresConnector"}})
+	prod.AddConnector(testutil.MockConnector{Name: "users-cdc", Type: "source", State: "RUNNING", Config: map[string]string{"connector.class": "io.debezium.connector.postgresql.PostgresConnector"}})
+
+	a := newTestAggregator(t, map[string]*testutil.MockKafkaConnect{"staging": staging, "prod": prod})
+	a.Refresh(context.Background())
+
+	// Paginate through all connectors
+	res, err := a.List(ListRequest{PageSize: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prod/orders-cdc", "prod/users-cdc", "staging/orders-cdc"}, connectorNames(res.Connectors))
+	assert.Equal(t, 4, res.TotalSize)
+	require.NotEmpty(t, res.NextPageToken)
+
+	res, err = a.List(ListRequest{PageSize: 3, PageToken: res.NextPageToken})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"staging/orders-s3"}, connectorNames(res.Connectors))
+	assert.Empty(t, res.NextPageToken)
+
+	_, err = a.List(ListRequest{PageSize: 3, PageToken: "invalid"})
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+
+	// Filters
+	res, err = a.List(ListRequest{Filter: Filter{States: []string{"paused"}}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"staging/orders-s3"}, connectorNames(res.Connectors))
+
+	res, err = a.List(ListRequest{Filter: Filter{Class: "PostgresConnector", ClusterNames: []string{"prod"}}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prod/orders-cdc", "prod/users-cdc"}, connectorNames(res.Connectors))
+	assert.Len(t, res.Clusters, 1)
+
+	res, err = a.List(ListRequest{Filter: Filter{NameRegex: regexp.MustCompile("^orders-")}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prod/orders-cdc", "staging/orders-cdc", "staging/orders-s3"}, connectorNames(res.Connectors))
+
+	// Clusters that fail to refresh keep their connectors and are marked as stale.
+	staging.Close()
+	a.Refresh(context.Background())
+	res, err = a.List(ListRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 4, res.TotalSize)
+	require.Len(t, res.Clusters, 2)
+	assert.False(t, res.Clusters[0].Stale)
+	assert.Equal(t, "staging", res.Clusters[1].ClusterName)
+	assert.True(t, res.Clusters[1].Stale)
+	assert.NotEmpty(t, res.Clusters[1].Error)
+	assert.False(t, res.Clusters[1].LastRefreshedAt.IsZero())
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package declarative implements connector guides and config patches that are
+// defined in YAML or JSON files rather than in Go code. This allows to add guides
+// for connectors that are not covered by the community guides without changing
+// Console itself.
+package declarative
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// Names of the validate hooks that can be referenced by a definition. The hooks are
+// provided by the interceptor package.
+const (
+	ValidateHookJSONSchema           = "json-schema"
+	ValidateHookAvroCodec            = "avro-codec"
+	ValidateHookTopicCreation        = "topic-creation"
+	ValidateHookCloudEventsConverter = "cloudevents-converter"
+)
+
+var validateHooks = map[string]bool{
+	ValidateHookJSONSchema:           true,
+	ValidateHookAvroCodec:            true,
+	ValidateHookTopicCreation:        true,
+	ValidateHookCloudEventsConverter: true,
+}
+
+// Definition is the declarative form of a connector guide along with the config
+// patches for the connector class. A file may contain multiple definitions
+// separated by "---". JSON files are supported as well.
+//
+//	className: io.confluent.connect.elasticsearch.ElasticsearchSinkConnector
+//	steps:
+//	  - name: Connection
+//	    groups:
+//	      - documentationLink: https://docs.confluent.io/kafka-connectors/elasticsearch
+//	        configKeys: [connection.url, connection.username, connection.password]
+//	injectedValues:
+//	  key.converter: org.apache.kafka.connect.storage.StringConverter
+//	configs:
+//	  connection.url:
+//	    displayName: Elasticsearch URL
+//	    importance: HIGH
+type Definition struct {
+	// ClassName is the connector plugin class name that this guide is written for.
+	ClassName string `yaml:"className"`
+
+	// Steps are the wizard steps that are rendered in the given order. Config keys
+	// are rendered in the order they are listed. Config keys that are not listed in
+	// any step are not shown.
+	Steps []Step `yaml:"steps"`
+
+	// InjectedValues are added to the connector config unless the user has set them.
+	InjectedValues map[string]string `yaml:"injectedValues"`
+
+	// EnforcedValues are added to the connector config and overwrite user provided values.
+	EnforcedValues map[string]string `yaml:"enforcedValues"`
+
+	// Configs overrides the config definitions that are reported by Kafka connect.
+	Configs map[string]ConfigOverride `yaml:"configs"`
+
+	// ValidateHooks are the names of built-in hooks that further modify the validation
+	// response, e.g. to show schema registry settings only if a JSON schema is used.
+	ValidateHooks []string `yaml:"validateHooks"`
+
+	// Source is the path of the file that declares the definition.
+	Source string `yaml:"-"`
+}
+
+// Step is a single wizard step.
+type Step struct {
+	Name        string  `yaml:"name"`
+	Description string  `yaml:"description"`
+	Groups      []Group `yaml:"groups"`
+}
+
+// Group is a group of config keys within a wizard step.
+type Group struct {
+	Name              string   `yaml:"name"`
+	Description       string   `yaml:"description"`
+	DocumentationLink string   `yaml:"documentationLink"`
+	ConfigKeys        []string `yaml:"configKeys"`
+}
+
+// ConfigOverride overrides properties of a single config definition. Unset
+// properties are not changed.
+type ConfigOverride struct {
+	DisplayName   *string `yaml:"displayName"`
+	Documentation *string `yaml:"documentation"`
+	Importance    *string `yaml:"importance"`
+	Visible       *bool   `yaml:"visible"`
+	Required      *bool   `yaml:"required"`
+	DefaultValue  *string `yaml:"defaultValue"`
+	Order         *int    `yaml:"order"`
+	Width         *string `yaml:"width"`
+	ComponentType *string `yaml:"componentType"`
+
+	// RecommendedValues replaces the recommended values along with their display names.
+	RecommendedValues []RecommendedValue `yaml:"recommendedValues"`
+}
+
+// RecommendedValue is a recommended value along with the name that is shown in
+// the frontend.
+type RecommendedValue struct {
+	Value       string `yaml:"value"`
+	DisplayName string `yaml:"displayName"`
+}
+
+// Parse parses all definitions of a YAML or JSON file.
+func Parse(source string, payload []byte) ([]Definition, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(payload))
+	decoder.KnownFields(true)
+
+	var definitions []Definition
+	for i := 0; ; i++ {
+		var def Definition
+		err := decoder.Decode(&def)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode document %d: %w", i, err)
+		}
+		if def.ClassName == "" && def.Steps == nil {
+			// Empty document, e.g. a trailing separator.
+			continue
+		}
+		def.Source = source
+		if err := def.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid guide %q in document %d: %w", def.ClassName, i, err)
+		}
+		definitions = append(definitions, def)
+	}
+	return definitions, nil
+}
+
+// Validate the definition.
+func (d *Definition) Validate() error {
+	if d.ClassName == "" {
+		return errors.New("className must be set")
+	}
+	if len(d.Steps) == 0 {
+		return errors.New("at least one step must be defined")
+	}
+
+	listedKeys := make(map[string]bool)
+	for i, step := range d.Steps {
+		if len(step.Groups) == 0 {
+			return fmt.Errorf("step %d (%q) must have at least one group", i, step.Name)
+		}
+		for _, group := range step.Groups {
+			if len(group.ConfigKeys) == 0 {
+				return fmt.Errorf("groups of step %d (%q) must list at least one config key", i, step.Name)
+			}
+			for _, key := range group.ConfigKeys {
+				if listedKeys[key] {
+					return fmt.Errorf("config key %q is listed more than once", key)
+				}
+				listedKeys[key] = true
+			}
+		}
+	}
+
+	for key, override := range d.Configs {
+		if err := override.validate(); err != nil {
+			return fmt.Errorf("invalid override of config %q: %w", key, err)
+		}
+	}
+	for _, hook := range d.ValidateHooks {
+		if !validateHooks[hook] {
+			return fmt.Errorf("unknown validate hook %q", hook)
+		}
+	}
+	return nil
+}
+
+func (o *ConfigOverride) validate() error {
+	if o.Importance != nil {
+		switch *o.Importance {
+		case model.ConfigDefinitionImportanceHigh, model.ConfigDefinitionImportanceMedium, model.ConfigDefinitionImportanceLow:
+		default:
+			return fmt.Errorf("importance must be one of HIGH, MEDIUM or LOW, but got %q", *o.Importance)
+		}
+	}
+	if o.Width != nil {
+		switch *o.Width {
+		case model.ConfigDefinitionWidthNone, model.ConfigDefinitionWidthShort, model.ConfigDefinitionWidthMedium, model.ConfigDefinitionWidthLong:
+		default:
+			return fmt.Errorf("width must be one of NONE, SHORT, MEDIUM or LONG, but got %q", *o.Width)
+		}
+	}
+	if o.ComponentType != nil && *o.ComponentType != model.ComponentRadioGroup {
+		return fmt.Errorf("unknown component type %q", *o.ComponentType)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+const elasticsearchGuide = `
+className: io.confluent.connect.elasticsearch.ElasticsearchSinkConnector
+steps:
+  - name: Connection
+    groups:
+      - documentationLink: https://docs.confluent.io/kafka-connectors/elasticsearch
+        configKeys: [connection.url, connection.username]
+injectedValues:
+  key.converter: org.apache.kafka.connect.storage.StringConverter
+enforcedValues:
+  behavior.on.null.values: IGNORE
+configs:
+  connection.url:
+    displayName: Elasticsearch URL
+    importance: HIGH
+    required: true
+    recommendedValues:
+      - value: http://localhost:9200
+        displayName: Local
+validateHooks: [json-schema]
+---
+`
+
+func TestParse(t *testing.T) {
+	payload := elasticsearchGuide + `
+className: io.debezium.connector.sqlserver.SqlServerConnector
+steps:
+  - name: Database
+    groups:
+      - configKeys: [database.hostname]
+`
+	defs, err := Parse("guides/elasticsearch.yaml", []byte(payload))
+	require.NoError(t, err)
+	require.Len(t, defs, 2)
+
+	es := defs[0]
+	assert.Equal(t, "io.confluent.connect.elasticsearch.ElasticsearchSinkConnector", es.ClassName)
+	assert.Equal(t, "guides/elasticsearch.yaml", es.Source)
+	assert.Equal(t, []string{"connection.url", "connection.username"}, es.Steps[0].Groups[0].ConfigKeys)
+	assert.Equal(t, "Elasticsearch URL", *es.Configs["connection.url"].DisplayName)
+	assert.Equal(t, []string{ValidateHookJSONSchema}, es.ValidateHooks)
+	assert.Equal(t, "io.debezium.connector.sqlserver.SqlServerConnector", defs[1].ClassName)
+
+	// JSON is a subset of YAML.
+	defs, err = Parse("guide.json", []byte(`{"className": "a.B", "steps": [{"groups": [{"configKeys": ["x"]}]}]}`))
+	require.NoError(t, err)
+	require.Len(t, defs, 1)
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr string
+	}{
+		{
+			name:    "unknown field",
+			payload: "className: a.B\nstepz: []",
+			wantErr: "field stepz not found",
+		},
+		{
+			name:    "missing class name",
+			payload: "steps: [{groups: [{configKeys: [x]}]}]",
+			wantErr: "className must be set",
+		},
+		{
+			name:    "duplicate config key",
+			payload: "className: a.B\nsteps: [{groups: [{configKeys: [x]}, {configKeys: [x]}]}]",
+			wantErr: `config key "x" is listed more than once`,
+		},
+		{
+			name:    "invalid importance",
+			payload: "className: a.B\nsteps: [{groups: [{configKeys: [x]}]}]\nconfigs: {x: {importance: CRITICAL}}",
+			wantErr: "importance must be one of",
+		},
+		{
+			name:    "unknown validate hook",
+			payload: "className: a.B\nsteps: [{groups: [{configKeys: [x]}]}]\nvalidateHooks: [unknown]",
+			wantErr: `unknown validate hook "unknown"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse("guide.yaml", []byte(tt.payload))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestDefinitionGuideAndPatch(t *testing.T) {
+	defs, err := Parse("guide.yaml", []byte(elasticsearchGuide))
+	require.NoError(t, err)
+	def := defs[0]
+
+	p := def.Patch()
+	assert.True(t, p.IsMatch("connection.url", def.ClassName))
+	assert.False(t, p.IsMatch("connection.url", "other.Connector"))
+	assert.False(t, p.IsMatch("connection.username", def.ClassName))
+
+	patched := p.PatchDefinition(model.ConfigDefinition{
+		Definition: model.ConfigDefinitionKey{Name: "connection.url", Importance: model.ConfigDefinitionImportanceLow},
+	}, def.ClassName)
+	assert.Equal(t, "Elasticsearch URL", patched.Definition.DisplayName)
+	assert.Equal(t, model.ConfigDefinitionImportanceHigh, patched.Definition.Importance)
+	assert.True(t, patched.Definition.Required)
+	assert.Equal(t, []model.RecommendedValueWithMetadata{{Value: "http://localhost:9200", DisplayName: "Local"}}, patched.Metadata.RecommendedValues)
+
+	// Injected values do not overwrite user provided values, but enforced values do.
+	g := def.Guide()
+	assert.Equal(t, def.ClassName, g.ClassName())
+	assert.Equal(t, map[string]any{
+		"key.converter":           "io.confluent.connect.avro.AvroConverter",
+		"behavior.on.null.values": "IGNORE",
+	}, g.ConsoleToKafkaConnect(map[string]any{
+		"key.converter":           "io.confluent.connect.avro.AvroConverter",
+		"behavior.on.null.values": "FAIL",
+	}))
+	assert.Equal(t, map[string]any{
+		"key.converter":           "org.apache.kafka.connect.storage.StringConverter",
+		"behavior.on.null.values": "IGNORE",
+	}, g.ConsoleToKafkaConnect(map[string]any{}))
+}
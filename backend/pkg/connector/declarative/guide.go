@@ -0,0 +1,48 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package declarative
+
+import (
+	"github.com/redpanda-data/console/backend/pkg/connector/guide"
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// Guide returns the wizard guide of the definition. Additional options, such as
+// hooks, are applied after the injected values of the definition.
+func (d *Definition) Guide(opts ...guide.Option) guide.Guide {
+	steps := make([]model.ValidationResponseStep, len(d.Steps))
+	for i, step := range d.Steps {
+		groups := make([]model.ValidationResponseStepGroup, len(step.Groups))
+		for j, group := range step.Groups {
+			groups[j] = model.ValidationResponseStepGroup{
+				Name:              group.Name,
+				Description:       group.Description,
+				DocumentationLink: group.DocumentationLink,
+				ConfigKeys:        group.ConfigKeys,
+			}
+		}
+		steps[i] = model.ValidationResponseStep{
+			Name:        step.Name,
+			Description: step.Description,
+			Groups:      groups,
+		}
+	}
+
+	guideOpts := make([]guide.Option, 0, len(opts)+2)
+	if len(d.InjectedValues) > 0 {
+		guideOpts = append(guideOpts, guide.WithInjectedValues(d.InjectedValues, false))
+	}
+	if len(d.EnforcedValues) > 0 {
+		guideOpts = append(guideOpts, guide.WithInjectedValues(d.EnforcedValues, true))
+	}
+	guideOpts = append(guideOpts, opts...)
+
+	return guide.NewWizardGuide(d.ClassName, steps, guideOpts...)
+}
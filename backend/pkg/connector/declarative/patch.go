@@ -0,0 +1,84 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package declarative
+
+import (
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+	"github.com/redpanda-data/console/backend/pkg/connector/patch"
+)
+
+// ConfigPatch applies the config overrides of a definition.
+type ConfigPatch struct {
+	className string
+	configs   map[string]ConfigOverride
+}
+
+var _ patch.ConfigPatch = (*ConfigPatch)(nil)
+
+// Patch returns the config patch that applies the config overrides of the definition.
+func (d *Definition) Patch() *ConfigPatch {
+	return &ConfigPatch{
+		className: d.ClassName,
+		configs:   d.Configs,
+	}
+}
+
+// IsMatch implements the ConfigPatch.IsMatch interface.
+func (c *ConfigPatch) IsMatch(configKey, connectorClass string) bool {
+	if connectorClass != c.className {
+		return false
+	}
+	_, exists := c.configs[configKey]
+	return exists
+}
+
+// PatchDefinition implements the ConfigPatch.PatchDefinition interface.
+func (c *ConfigPatch) PatchDefinition(d model.ConfigDefinition, _ string) model.ConfigDefinition {
+	o, exists := c.configs[d.Definition.Name]
+	if !exists {
+		return d
+	}
+
+	if o.DisplayName != nil {
+		d.SetDisplayName(*o.DisplayName)
+	}
+	if o.Documentation != nil {
+		d.SetDocumentation(*o.Documentation)
+	}
+	if o.Importance != nil {
+		d.SetImportance(*o.Importance)
+	}
+	if o.Visible != nil {
+		d.SetVisible(*o.Visible)
+	}
+	if o.Required != nil {
+		d.SetRequired(*o.Required)
+	}
+	if o.DefaultValue != nil {
+		d.SetDefaultValue(*o.DefaultValue)
+	}
+	if o.Order != nil {
+		d.Definition.Order = *o.Order
+	}
+	if o.Width != nil {
+		d.Definition.Width = *o.Width
+	}
+	if o.ComponentType != nil {
+		d.SetComponentType(*o.ComponentType)
+	}
+	if o.RecommendedValues != nil {
+		d.ClearRecommendedValuesWithMetadata()
+		for _, v := range o.RecommendedValues {
+			d.AddRecommendedValueWithMetadata(v.Value, v.DisplayName)
+		}
+	}
+
+	return d
+}
@@ -14,8 +14,11 @@
 package interceptor
 
 import (
+	"sync"
+
 	"github.com/cloudhut/connect-client"
 
+	"github.com/redpanda-data/console/backend/pkg/connector/declarative"
 	"github.com/redpanda-data/console/backend/pkg/connector/guide"
 	"github.com/redpanda-data/console/backend/pkg/connector/model"
 	"github.com/redpanda-data/console/backend/pkg/connector/patch"
@@ -33,7 +36,16 @@ type Interceptor struct {
 	defaultGuide guide.Guide
 
 	// guides is the collection of connector specific guides.
-	guides            []guide.Guide
+	guides []guide.Guide
+
+	// declarativeGuides and declarativePatches are loaded from guide definition files.
+	// They can be replaced at runtime and take precedence over guides and patches
+	// for the same connector class.
+	declarativeGuides  []guide.Guide
+	declarativePatches []patch.ConfigPatch
+
+	// mu guards the fields that may change when declarative guides are replaced.
+	mu                sync.RWMutex
 	guidesByClassName map[string]guide.Guide
 }
 
@@ -109,6 +121,38 @@ func CommunityGuides(opts ...guide.Option) []guide.Guide {
 	}
 }
 
+// DeclarativeGuides returns the guides of the given definitions. Validate hooks
+// that are referenced by name are resolved to the hooks of this package.
+func DeclarativeGuides(defs []declarative.Definition) []guide.Guide {
+	hooksByName := map[string]guide.KafkaConnectValidateToConsoleHook{
+		declarative.ValidateHookJSONSchema:           KafkaConnectToConsoleJSONSchemaHook,
+		declarative.ValidateHookAvroCodec:            KafkaConnectToConsoleAvroCodecHook,
+		declarative.ValidateHookTopicCreation:        KafkaConnectToConsoleTopicCreationHook,
+		declarative.ValidateHookCloudEventsConverter: KafkaConnectToConsoleCloudEventsConverterHook,
+	}
+
+	guides := make([]guide.Guide, len(defs))
+	for i, def := range defs {
+		opts := make([]guide.Option, 0, len(def.ValidateHooks))
+		for _, hookName := range def.ValidateHooks {
+			if hook, exists := hooksByName[hookName]; exists {
+				opts = append(opts, guide.WithKafkaConnectValidateToConsoleHookFn(hook))
+			}
+		}
+		guides[i] = def.Guide(opts...)
+	}
+	return guides
+}
+
+// DeclarativePatches returns the config patches of the given definitions.
+func DeclarativePatches(defs []declarative.Definition) []patch.ConfigPatch {
+	patches := make([]patch.ConfigPatch, len(defs))
+	for i, def := range defs {
+		patches[i] = def.Patch()
+	}
+	return patches
+}
+
 // NewInterceptor returns an Interceptor that is initialized with a set of guides
 // and config patches that shall be used for the community version of Redpanda Console.
 func NewInterceptor(opts ...Option) *Interceptor {
@@ -122,16 +166,49 @@ func NewInterceptor(opts ...Option) *Interceptor {
 		opt(in)
 	}
 
-	in.guidesByClassName = make(map[string]guide.Guide)
-	for _, guide := range in.guides {
-		if guide.ClassName() == "" {
-			in.defaultGuide = guide
+	in.guidesByClassName = in.indexGuides()
+
+	return in
+}
+
+// SetDeclarativeGuides replaces all previously registered declarative guides and
+// their config patches. It is safe to call while the interceptor is in use, so that
+// guides can be reloaded when the definition files change.
+func (in *Interceptor) SetDeclarativeGuides(defs []declarative.Definition) {
+	guides := DeclarativeGuides(defs)
+	patches := DeclarativePatches(defs)
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.declarativeGuides = guides
+	in.declarativePatches = patches
+	in.guidesByClassName = in.indexGuides()
+}
+
+// indexGuides returns all guides by their class name. Declarative guides replace
+// guides for the same class name.
+func (in *Interceptor) indexGuides() map[string]guide.Guide {
+	guidesByClassName := make(map[string]guide.Guide)
+	for _, g := range in.guides {
+		if g.ClassName() == "" {
+			in.defaultGuide = g
 		} else {
-			in.guidesByClassName[guide.ClassName()] = guide
+			guidesByClassName[g.ClassName()] = g
 		}
 	}
+	for _, g := range in.declarativeGuides {
+		guidesByClassName[g.ClassName()] = g
+	}
+	return guidesByClassName
+}
 
-	return in
+func (in *Interceptor) guideByClassName(pluginClassName string) guide.Guide {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+	if g, exists := in.guidesByClassName[pluginClassName]; exists {
+		return g
+	}
+	return in.defaultGuide
 }
 
 // ConsoleToKafkaConnect is called when the user sent a request to Console's /validate
@@ -139,46 +216,40 @@ func NewInterceptor(opts ...Option) *Interceptor {
 // connect cluster. We need to modify this request if converters were used before,
 // as these change the configuration properties that are presented to the frontend.
 func (in *Interceptor) ConsoleToKafkaConnect(pluginClassName string, configs map[string]any) map[string]any {
-	g, exists := in.guidesByClassName[pluginClassName]
-	if !exists {
-		return in.defaultGuide.ConsoleToKafkaConnect(configs)
-	}
-	return g.ConsoleToKafkaConnect(configs)
+	return in.guideByClassName(pluginClassName).ConsoleToKafkaConnect(configs)
 }
 
 // KafkaConnectToConsole is called after we retrieved a connector's configuration from
 // the target Kafka connect cluster. We apply modifications based on that response so
 // that the injected configuration is skipped.
 func (in *Interceptor) KafkaConnectToConsole(pluginClassName string, configs map[string]string) map[string]string {
-	g, exists := in.guidesByClassName[pluginClassName]
-	if !exists {
-		return in.defaultGuide.KafkaConnectToConsole(configs)
-	}
-	return g.KafkaConnectToConsole(configs)
+	return in.guideByClassName(pluginClassName).KafkaConnectToConsole(configs)
 }
 
 // KafkaConnectValidateToConsole is called after we retrieved a connector's validate response from
 // the target Kafka connect cluster. We apply modifications based on that response so
 // that the configuration properties are presented in a more user-friendly fashion.
 func (in *Interceptor) KafkaConnectValidateToConsole(pluginClassName string, response connect.ConnectorValidationResult, configs map[string]any) model.ValidationResponse {
-	// 1. Run all patches on each configuration
+	in.mu.RLock()
+	declarativePatches := in.declarativePatches
+	in.mu.RUnlock()
+
+	// 1. Run all patches on each configuration. Declarative patches run last, so that
+	// they can override the community patches.
 	patchedConfigs := make([]model.ConfigDefinition, len(response.Configs))
 	for i, config := range response.Configs {
 		configDef := model.NewConfigDefinitionFromValidationResult(config)
-		configDef = in.applyConfigPatches(pluginClassName, configDef)
+		configDef = applyConfigPatches(in.configPatches, pluginClassName, configDef)
+		configDef = applyConfigPatches(declarativePatches, pluginClassName, configDef)
 		patchedConfigs[i] = configDef
 	}
 
 	// 2. Apply response patch from guide
-	if g, exists := in.guidesByClassName[pluginClassName]; exists {
-		return g.KafkaConnectValidateToConsole(pluginClassName, patchedConfigs, configs)
-	}
-
-	return in.defaultGuide.KafkaConnectValidateToConsole(pluginClassName, patchedConfigs, configs)
+	return in.guideByClassName(pluginClassName).KafkaConnectValidateToConsole(pluginClassName, patchedConfigs, configs)
 }
 
-func (in *Interceptor) applyConfigPatches(pluginClassName string, configDefinition model.ConfigDefinition) model.ConfigDefinition {
-	for _, p := range in.configPatches {
+func applyConfigPatches(patches []patch.ConfigPatch, pluginClassName string, configDefinition model.ConfigDefinition) model.ConfigDefinition {
+	for _, p := range patches {
 		if p.IsMatch(configDefinition.Definition.Name, pluginClassName) {
 			configDefinition = p.PatchDefinition(configDefinition, pluginClassName)
 		}
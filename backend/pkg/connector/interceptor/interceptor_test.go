@@ -0,0 +1,58 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package interceptor
+
+import (
+	"testing"
+
+	"github.com/cloudhut/connect-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/declarative"
+)
+
+func TestInterceptor_SetDeclarativeGuides(t *testing.T) {
+	const className = "com.snowflake.kafka.connector.SnowflakeSinkConnector"
+	defs, err := declarative.Parse("snowflake.yaml", []byte(`
+className: com.snowflake.kafka.connector.SnowflakeSinkConnector
+steps:
+  - name: Connection
+    groups:
+      - configKeys: [snowflake.url.name]
+configs:
+  snowflake.url.name:
+    displayName: Account URL
+`))
+	require.NoError(t, err)
+
+	in := NewInterceptor()
+	validationResult := connect.ConnectorValidationResult{
+		Name: className,
+		Configs: []connect.ConnectorValidationResultConfig{
+			{
+				Definition: map[string]any{"name": "snowflake.url.name", "display_name": "snowflake.url.name"},
+				Value:      map[string]any{"name": "snowflake.url.name", "visible": true},
+			},
+		},
+	}
+
+	in.SetDeclarativeGuides(defs)
+	res := in.KafkaConnectValidateToConsole(className, validationResult, map[string]any{})
+	require.Len(t, res.Steps, 1)
+	assert.Equal(t, "Connection", res.Steps[0].Name)
+	require.Len(t, res.Configs, 1)
+	assert.Equal(t, "Account URL", res.Configs[0].Definition.DisplayName)
+
+	// Removing the declarative guide restores the community guide.
+	in.SetDeclarativeGuides(nil)
+	res = in.KafkaConnectValidateToConsole(className, validationResult, map[string]any{})
+	assert.NotEqual(t, "Connection", res.Steps[0].Name)
+}
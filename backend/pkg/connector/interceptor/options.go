@@ -10,6 +10,7 @@
 package interceptor
 
 import (
+	"github.com/redpanda-data/console/backend/pkg/connector/declarative"
 	"github.com/redpanda-data/console/backend/pkg/connector/guide"
 	"github.com/redpanda-data/console/backend/pkg/connector/patch"
 )
@@ -32,3 +33,12 @@ func WithAdditionalGuides(guides ...guide.Guide) Option {
 		in.guides = append(in.guides, guides...)
 	}
 }
+
+// WithDeclarativeGuides registers guides and config patches that are defined in
+// guide definition files. They can be replaced later via SetDeclarativeGuides.
+func WithDeclarativeGuides(defs ...declarative.Definition) Option {
+	return func(in *Interceptor) {
+		in.declarativeGuides = DeclarativeGuides(defs)
+		in.declarativePatches = DeclarativePatches(defs)
+	}
+}
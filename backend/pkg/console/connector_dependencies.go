@@ -0,0 +1,368 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+)
+
+// ConnectorTopicRole describes how a connector uses a topic.
+type ConnectorTopicRole string
+
+const (
+	// ConnectorTopicRoleSource is a topic that a source connector produces to.
+	ConnectorTopicRoleSource ConnectorTopicRole = "SOURCE"
+	// ConnectorTopicRoleSink is a topic that a sink connector consumes from.
+	ConnectorTopicRoleSink ConnectorTopicRole = "SINK"
+	// ConnectorTopicRoleDeadLetterQueue is the topic that failed records are sent to.
+	ConnectorTopicRoleDeadLetterQueue ConnectorTopicRole = "DEAD_LETTER_QUEUE"
+)
+
+// ConnectorDependencies is the graph of all Kafka resources that a connector
+// depends on. Resources that could not be retrieved are reported in Errors, so
+// that the remaining graph can still be shown.
+type ConnectorDependencies struct {
+	ClusterName    string `json:"clusterName"`
+	ConnectorName  string `json:"connectorName"`
+	ConnectorClass string `json:"connectorClass"`
+	// Type is either source or sink.
+	Type string `json:"type"`
+	// WorkerID is the worker that runs the connector instance.
+	WorkerID string `json:"workerId"`
+
+	Topics []ConnectorDependencyTopic `json:"topics"`
+	// TopicsRegex is the regex of a sink connector that subscribes to topics by pattern.
+	TopicsRegex string `json:"topicsRegex,omitempty"`
+	// ConsumerGroup is the consumer group of a sink connector. It is nil for
+	// source connectors.
+	ConsumerGroup *ConnectorDependencyConsumerGroup `json:"consumerGroup"`
+	Subjects      []ConnectorDependencySubject      `json:"subjects"`
+	Tasks         []ConnectorDependencyTask         `json:"tasks"`
+
+	Errors []ConnectorDependencyError `json:"errors"`
+}
+
+// ConnectorDependencyTopic is a topic that is used by a connector.
+type ConnectorDependencyTopic struct {
+	Name string             `json:"name"`
+	Role ConnectorTopicRole `json:"role"`
+	// IsActive is true if the Kafka connect cluster reports the topic as
+	// actively used by the connector.
+	IsActive bool `json:"isActive"`
+}
+
+// ConnectorDependencyConsumerGroup is the consumer group of a sink connector.
+type ConnectorDependencyConsumerGroup struct {
+	GroupID string `json:"groupId"`
+	// Exists is false if the group has not committed any offsets yet.
+	Exists    bool   `json:"exists"`
+	State     string `json:"state"`
+	Members   int    `json:"members"`
+	SummedLag int64  `json:"summedLag"`
+	// TopicLags is the summed lag by topic.
+	TopicLags map[string]int64 `json:"topicLags"`
+}
+
+// ConnectorDependencySubject is a schema registry subject that the key or value
+// converter of a connector uses.
+type ConnectorDependencySubject struct {
+	Name  string `json:"name"`
+	Topic string `json:"topic"`
+	// IsKey is true if the subject is used by the key converter.
+	IsKey        bool `json:"isKey"`
+	IsRegistered bool `json:"isRegistered"`
+}
+
+// ConnectorDependencyTask is the placement of a connector task.
+type ConnectorDependencyTask struct {
+	TaskID   int    `json:"taskId"`
+	State    string `json:"state"`
+	WorkerID string `json:"workerId"`
+}
+
+// ConnectorDependencyError describes which part of the dependency graph could
+// not be retrieved.
+type ConnectorDependencyError struct {
+	// Component is one of topics, consumerGroup or subjects.
+	Component string `json:"component"`
+	Message   string `json:"message"`
+}
+
+// schemaRegistryConverters are the substrings of converter class names that
+// serialize records with the schema registry.
+var schemaRegistryConverters = []string{"AvroConverter", "ProtobufConverter", "JsonSchemaConverter"}
+
+// GetConnectorDependencies assembles the topics, consumer group, schema registry
+// subjects and task placement of a connector.
+func (s *Service) GetConnectorDependencies(ctx context.Context, clusterName string, connectorName string) (*ConnectorDependencies, *rest.Error) {
+	if s.connectSvc == nil || !s.connectSvc.Cfg.Enabled {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("kafka connect is not enabled"),
+			Status:   http.StatusServiceUnavailable,
+			Message:  "Kafka connect is not configured in Redpanda Console",
+			IsSilent: false,
+		}
+	}
+
+	connectorConfig, restErr := s.connectSvc.GetConnectorConfig(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	status, restErr := s.connectSvc.GetConnectorStatus(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	deps := &ConnectorDependencies{
+		ClusterName:    clusterName,
+		ConnectorName:  connectorName,
+		ConnectorClass: connectorConfig["connector.class"],
+		Type:           status.Type,
+		WorkerID:       status.Connector.WorkerID,
+		TopicsRegex:    connectorConfig["topics.regex"],
+		Topics:         make([]ConnectorDependencyTopic, 0),
+		Subjects:       make([]ConnectorDependencySubject, 0),
+		Tasks:          make([]ConnectorDependencyTask, len(status.Tasks)),
+		Errors:         make([]ConnectorDependencyError, 0),
+	}
+	for i, task := range status.Tasks {
+		deps.Tasks[i] = ConnectorDependencyTask{TaskID: task.TaskID, State: task.State, WorkerID: task.WorkerID}
+	}
+
+	var activeTopics []string
+	connectorTopics, restErr := s.connectSvc.ListConnectorTopics(ctx, clusterName, connectorName)
+	if restErr != nil {
+		deps.Errors = append(deps.Errors, ConnectorDependencyError{Component: "topics", Message: restErr.Message})
+	} else {
+		activeTopics = connectorTopics.Topics
+	}
+	deps.Topics = connectorDependencyTopics(status.Type, connectorConfig, activeTopics)
+
+	if status.Type == "sink" {
+		group, err := s.getConnectorConsumerGroup(ctx, connectorConsumerGroupID(connectorName, connectorConfig))
+		if err != nil {
+			deps.Errors = append(deps.Errors, ConnectorDependencyError{Component: "consumerGroup", Message: err.Message})
+		}
+		deps.ConsumerGroup = group
+	}
+
+	if s.kafkaSvc.SchemaService != nil {
+		subjects, err := s.GetSchemaRegistrySubjects(ctx)
+		if err != nil {
+			deps.Errors = append(deps.Errors, ConnectorDependencyError{Component: "subjects", Message: fmt.Sprintf("Failed to list schema registry subjects: %v", err.Error())})
+		}
+		registered := make(map[string]struct{}, len(subjects))
+		for _, subject := range subjects {
+			if !subject.IsSoftDeleted {
+				registered[subject.Name] = struct{}{}
+			}
+		}
+		deps.Subjects = connectorSchemaSubjects(connectorConfig, deps.Topics, registered)
+	}
+
+	return deps, nil
+}
+
+// FilterTopics removes all topics for which canSee returns false, including their
+// lag in the consumer group and the schema registry subjects that belong to them.
+// The summed lag of the consumer group only covers the remaining topics.
+func (d *ConnectorDependencies) FilterTopics(canSee func(topicName string) (bool, *rest.Error)) *rest.Error {
+	visibleByTopic := make(map[string]bool)
+	isVisible := func(topicName string) (bool, *rest.Error) {
+		visible, exists := visibleByTopic[topicName]
+		if !exists {
+			var restErr *rest.Error
+			visible, restErr = canSee(topicName)
+			if restErr != nil {
+				return false, restErr
+			}
+			visibleByTopic[topicName] = visible
+		}
+		return visible, nil
+	}
+
+	topics := make([]ConnectorDependencyTopic, 0, len(d.Topics))
+	for _, topic := range d.Topics {
+		visible, restErr := isVisible(topic.Name)
+		if restErr != nil {
+			return restErr
+		}
+		if visible {
+			topics = append(topics, topic)
+		}
+	}
+	d.Topics = topics
+
+	if d.ConsumerGroup != nil {
+		topicLags := make(map[string]int64, len(d.ConsumerGroup.TopicLags))
+		var summedLag int64
+		for topicName, lag := range d.ConsumerGroup.TopicLags {
+			visible, restErr := isVisible(topicName)
+			if restErr != nil {
+				return restErr
+			}
+			if visible {
+				topicLags[topicName] = lag
+				summedLag += lag
+			}
+		}
+		d.ConsumerGroup.TopicLags = topicLags
+		d.ConsumerGroup.SummedLag = summedLag
+	}
+
+	subjects := make([]ConnectorDependencySubject, 0, len(d.Subjects))
+	for _, subject := range d.Subjects {
+		visible, restErr := isVisible(subject.Topic)
+		if restErr != nil {
+			return restErr
+		}
+		if visible {
+			subjects = append(subjects, subject)
+		}
+	}
+	d.Subjects = subjects
+
+	return nil
+}
+
+// getConnectorConsumerGroup returns the consumer group with its lag. A group that
+// does not exist is not considered an error, because sink connectors only
+// create their group once they have committed offsets.
+func (s *Service) getConnectorConsumerGroup(ctx context.Context, groupID string) (*ConnectorDependencyConsumerGroup, *rest.Error) {
+	group := &ConnectorDependencyConsumerGroup{
+		GroupID:   groupID,
+		TopicLags: make(map[string]int64),
+	}
+
+	overviews, restErr := s.GetConsumerGroupsOverview(ctx, []string{groupID})
+	if restErr != nil {
+		if restErr.Status == http.StatusNotFound {
+			return group, nil
+		}
+		return group, restErr
+	}
+	for _, overview := range overviews {
+		if overview.GroupID != groupID {
+			continue
+		}
+		group.Exists = true
+		group.State = overview.State
+		group.Members = len(overview.Members)
+		for _, topicOffsets := range overview.TopicOffsets {
+			group.TopicLags[topicOffsets.Topic] = topicOffsets.SummedLag
+			group.SummedLag += topicOffsets.SummedLag
+		}
+	}
+	return group, nil
+}
+
+// connectorConsumerGroupID returns the consumer group of a sink connector. The
+// group can be overridden via the connector's consumer client config.
+func connectorConsumerGroupID(connectorName string, connectorConfig map[string]string) string {
+	if groupID := connectorConfig["consumer.override.group.id"]; groupID != "" {
+		return groupID
+	}
+	return "connect-" + connectorName
+}
+
+// connectorDependencyTopics merges the configured topics with the topics that
+// the connector actively uses. Source connectors define their topics in
+// connector specific ways, so only their active topics are known.
+func connectorDependencyTopics(connectorType string, connectorConfig map[string]string, activeTopics []string) []ConnectorDependencyTopic {
+	role := ConnectorTopicRoleSource
+	if connectorType == "sink" {
+		role = ConnectorTopicRoleSink
+	}
+	dlqTopic := strings.TrimSpace(connectorConfig["errors.deadletterqueue.topic.name"])
+
+	topicsByName := make(map[string]ConnectorDependencyTopic)
+	if role == ConnectorTopicRoleSink {
+		for _, name := range strings.Split(connectorConfig["topics"], ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				topicsByName[name] = ConnectorDependencyTopic{Name: name, Role: role}
+			}
+		}
+	}
+	for _, name := range activeTopics {
+		// The dead letter queue is reported as active topic once records have
+		// been sent to it.
+		if name == dlqTopic {
+			continue
+		}
+		topicsByName[name] = ConnectorDependencyTopic{Name: name, Role: role, IsActive: true}
+	}
+	if dlqTopic != "" {
+		_, isActive := find(activeTopics, dlqTopic)
+		topicsByName[dlqTopic] = ConnectorDependencyTopic{Name: dlqTopic, Role: ConnectorTopicRoleDeadLetterQueue, IsActive: isActive}
+	}
+
+	topics := make([]ConnectorDependencyTopic, 0, len(topicsByName))
+	for _, topic := range topicsByName {
+		topics = append(topics, topic)
+	}
+	sort.Slice(topics, func(i, j int) bool { return topics[i].Name < topics[j].Name })
+	return topics
+}
+
+// connectorSchemaSubjects returns the subjects that the key and value converters
+// of the connector use for the given topics. Only converters that use the schema
+// registry are considered. Subjects of the record name strategy do not depend on
+// the topic and can only be found via the registered subjects.
+func connectorSchemaSubjects(connectorConfig map[string]string, topics []ConnectorDependencyTopic, registered map[string]struct{}) []ConnectorDependencySubject {
+	subjects := make([]ConnectorDependencySubject, 0)
+	for _, isKey := range []bool{true, false} {
+		prefix, suffix := "value.converter", "value"
+		if isKey {
+			prefix, suffix = "key.converter", "key"
+		}
+		if !usesSchemaRegistry(connectorConfig[prefix]) {
+			continue
+		}
+		strategy := connectorConfig[prefix+"."+suffix+".subject.name.strategy"]
+
+		for _, topic := range topics {
+			// Records in the dead letter queue keep their original serialization.
+			if topic.Role == ConnectorTopicRoleDeadLetterQueue {
+				continue
+			}
+			switch {
+			case strings.HasSuffix(strategy, "TopicRecordNameStrategy"):
+				for name := range registered {
+					if strings.HasPrefix(name, topic.Name+"-") && !strings.HasSuffix(name, "-key") && !strings.HasSuffix(name, "-value") {
+						subjects = append(subjects, ConnectorDependencySubject{Name: name, Topic: topic.Name, IsKey: isKey, IsRegistered: true})
+					}
+				}
+			case strings.HasSuffix(strategy, "RecordNameStrategy"):
+				// Subjects are named after the record types, which are not known.
+			default:
+				name := topic.Name + "-" + suffix
+				_, isRegistered := registered[name]
+				subjects = append(subjects, ConnectorDependencySubject{Name: name, Topic: topic.Name, IsKey: isKey, IsRegistered: isRegistered})
+			}
+		}
+	}
+	sort.Slice(subjects, func(i, j int) bool { return subjects[i].Name < subjects[j].Name })
+	return subjects
+}
+
+func usesSchemaRegistry(converterClass string) bool {
+	for _, converter := range schemaRegistryConverters {
+		if strings.Contains(converterClass, converter) {
+			return true
+		}
+	}
+	return false
+}
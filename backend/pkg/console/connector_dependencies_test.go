@@ -0,0 +1,130 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectorDependencyTopics(t *testing.T) {
+	cfg := map[string]string{
+		"topics":                            "orders, payments,",
+		"errors.deadletterqueue.topic.name": "orders-dlq",
+	}
+
+	topics := connectorDependencyTopics("sink", cfg, []string{"orders", "orders-dlq"})
+	assert.Equal(t, []ConnectorDependencyTopic{
+		{Name: "orders", Role: ConnectorTopicRoleSink, IsActive: true},
+		{Name: "orders-dlq", Role: ConnectorTopicRoleDeadLetterQueue, IsActive: true},
+		{Name: "payments", Role: ConnectorTopicRoleSink},
+	}, topics)
+
+	// Source connectors only report their active topics.
+	topics = connectorDependencyTopics("source", map[string]string{"topic.prefix": "cdc"}, []string{"cdc.public.users"})
+	assert.Equal(t, []ConnectorDependencyTopic{
+		{Name: "cdc.public.users", Role: ConnectorTopicRoleSource, IsActive: true},
+	}, topics)
+}
+
+func TestConnectorConsumerGroupID(t *testing.T) {
+	assert.Equal(t, "connect-s3-sink", connectorConsumerGroupID("s3-sink", map[string]string{}))
+	assert.Equal(t, "archive", connectorConsumerGroupID("s3-sink", map[string]string{"consumer.override.group.id": "archive"}))
+}
+
+func TestConnectorSchemaSubjects(t *testing.T) {
+	topics := []ConnectorDependencyTopic{
+		{Name: "orders", Role: ConnectorTopicRoleSink},
+		{Name: "orders-dlq", Role: ConnectorTopicRoleDeadLetterQueue},
+	}
+	registered := map[string]struct{}{
+		"orders-value":               {},
+		"orders-com.example.OrderId": {},
+	}
+
+	tests := []struct {
+		name     string
+		config   map[string]string
+		expected []ConnectorDependencySubject
+	}{
+		{
+			name: "converters without schema registry",
+			config: map[string]string{
+				"key.converter":   "org.apache.kafka.connect.storage.StringConverter",
+				"value.converter": "org.apache.kafka.connect.json.JsonConverter",
+			},
+			expected: []ConnectorDependencySubject{},
+		},
+		{
+			name: "topic name strategy",
+			config: map[string]string{
+				"key.converter":   "io.confluent.connect.avro.AvroConverter",
+				"value.converter": "io.confluent.connect.avro.AvroConverter",
+			},
+			expected: []ConnectorDependencySubject{
+				{Name: "orders-key", Topic: "orders", IsKey: true},
+				{Name: "orders-value", Topic: "orders", IsRegistered: true},
+			},
+		},
+		{
+			name: "topic record name strategy",
+			config: map[string]string{
+				"key.converter": "io.confluent.connect.protobuf.ProtobufConverter",
+				"key.converter.key.subject.name.strategy": "io.confluent.kafka.serializers.subject.TopicRecordNameStrategy",
+			},
+			expected: []ConnectorDependencySubject{
+				{Name: "orders-com.example.OrderId", Topic: "orders", IsKey: true, IsRegistered: true},
+			},
+		},
+		{
+			name: "record name strategy",
+			config: map[string]string{
+				"value.converter": "io.confluent.connect.json.JsonSchemaConverter",
+				"value.converter.value.subject.name.strategy": "io.confluent.kafka.serializers.subject.RecordNameStrategy",
+			},
+			expected: []ConnectorDependencySubject{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, connectorSchemaSubjects(tt.config, topics, registered))
+		})
+	}
+}
+
+func TestConnectorDependenciesFilterTopics(t *testing.T) {
+	deps := &ConnectorDependencies{
+		Topics: []ConnectorDependencyTopic{
+			{Name: "orders", Role: ConnectorTopicRoleSink},
+			{Name: "payments", Role: ConnectorTopicRoleSink},
+		},
+		ConsumerGroup: &ConnectorDependencyConsumerGroup{
+			GroupID:   "connect-s3-sink",
+			SummedLag: 15,
+			TopicLags: map[string]int64{"orders": 5, "payments": 10},
+		},
+		Subjects: []ConnectorDependencySubject{
+			{Name: "orders-value", Topic: "orders"},
+			{Name: "payments-value", Topic: "payments"},
+		},
+	}
+
+	restErr := deps.FilterTopics(func(topicName string) (bool, *rest.Error) {
+		return topicName != "payments", nil
+	})
+	assert.Nil(t, restErr)
+	assert.Equal(t, []ConnectorDependencyTopic{{Name: "orders", Role: ConnectorTopicRoleSink}}, deps.Topics)
+	assert.Equal(t, map[string]int64{"orders": 5}, deps.ConsumerGroup.TopicLags)
+	assert.Equal(t, int64(5), deps.ConsumerGroup.SummedLag)
+	assert.Equal(t, []ConnectorDependencySubject{{Name: "orders-value", Topic: "orders"}}, deps.Subjects)
+}
@@ -0,0 +1,485 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+// connectErrorHeaderPrefix is the prefix of all headers that Kafka connect adds
+// to records in the dead letter queue if errors.deadletterqueue.context.headers.enable
+// is set.
+const connectErrorHeaderPrefix = "__connect.errors."
+
+// ConnectorDLQ contains the most recent records of a connector's dead letter queue.
+type ConnectorDLQ struct {
+	ClusterName   string `json:"clusterName"`
+	ConnectorName string `json:"connectorName"`
+	// TopicName is the dead letter queue topic as configured in
+	// errors.deadletterqueue.topic.name.
+	TopicName string `json:"topicName"`
+	// ContextHeadersEnabled is false if Kafka connect does not add the error
+	// headers, in which case the records cannot be re-sent.
+	ContextHeadersEnabled bool `json:"contextHeadersEnabled"`
+
+	Records []ConnectorDLQRecord     `json:"records"`
+	Groups  []ConnectorDLQErrorGroup `json:"groups"`
+}
+
+// ConnectorDLQRecord is a record in the dead letter queue along with the
+// decoded error headers.
+type ConnectorDLQRecord struct {
+	PartitionID int32                `json:"partitionId"`
+	Offset      int64                `json:"offset"`
+	Timestamp   int64                `json:"timestamp"`
+	Key         *serde.RecordPayload `json:"key"`
+	Value       *serde.RecordPayload `json:"value"`
+	Error       ConnectorDLQError    `json:"error"`
+
+	// rawKey, rawValue and rawHeaders are the original record, which is
+	// required to re-send the record.
+	rawKey     []byte
+	rawValue   []byte
+	rawHeaders []kgo.RecordHeader
+}
+
+// ConnectorDLQError is the error that caused a record to be sent to the dead
+// letter queue. Numeric fields are -1 if the header is missing.
+type ConnectorDLQError struct {
+	OriginalTopic       string `json:"originalTopic"`
+	OriginalPartition   int32  `json:"originalPartition"`
+	OriginalOffset      int64  `json:"originalOffset"`
+	ConnectorName       string `json:"connectorName"`
+	TaskID              int    `json:"taskId"`
+	Stage               string `json:"stage"`
+	ClassName           string `json:"className"`
+	ExceptionClassName  string `json:"exceptionClassName"`
+	ExceptionMessage    string `json:"exceptionMessage"`
+	ExceptionStackTrace string `json:"exceptionStackTrace"`
+}
+
+// ConnectorDLQErrorGroup summarizes all records that failed with the same exception.
+type ConnectorDLQErrorGroup struct {
+	ExceptionClassName string `json:"exceptionClassName"`
+	Count              int    `json:"count"`
+	// ExceptionMessage is the message of the most recent record in the group.
+	ExceptionMessage string   `json:"exceptionMessage"`
+	OriginalTopics   []string `json:"originalTopics"`
+	FirstTimestamp   int64    `json:"firstTimestamp"`
+	LastTimestamp    int64    `json:"lastTimestamp"`
+}
+
+// ConnectorDLQRecordRef references a record in the dead letter queue.
+type ConnectorDLQRecordRef struct {
+	PartitionID int32 `json:"partitionId"`
+	Offset      int64 `json:"offset"`
+}
+
+// GetConnectorDLQ returns up to maxResults of the most recent records in the
+// dead letter queue of the connector, grouped by exception. The dead letter queue
+// must have been resolved with GetConnectorDLQTopic. Records of other connectors
+// that share the same topic are omitted.
+func (s *Service) GetConnectorDLQ(ctx context.Context, dlqTopic *ConnectorDLQ, maxResults int) (*ConnectorDLQ, *rest.Error) {
+	dlq := *dlqTopic
+
+	marks, restErr := s.getDLQPartitionMarks(ctx, dlq.TopicName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	partitions := make(map[int32]*kafka.PartitionConsumeRequest)
+	for _, mark := range marks {
+		if mark.High <= mark.Low {
+			continue
+		}
+		startOffset := max(mark.Low, mark.High-int64(maxResults))
+		partitions[mark.PartitionID] = &kafka.PartitionConsumeRequest{
+			PartitionID:     mark.PartitionID,
+			LowWaterMark:    mark.Low,
+			HighWaterMark:   mark.High,
+			StartOffset:     startOffset,
+			EndOffset:       mark.High - 1,
+			MaxMessageCount: mark.High - startOffset,
+		}
+	}
+
+	records, restErr := s.fetchDLQRecords(ctx, dlq.TopicName, partitions)
+	if restErr != nil {
+		return nil, restErr
+	}
+	records = slices.DeleteFunc(records, func(record ConnectorDLQRecord) bool {
+		return !record.belongsToConnector(dlq.ConnectorName)
+	})
+	sort.Slice(records, func(i, j int) bool { return records[i].Timestamp > records[j].Timestamp })
+	if len(records) > maxResults {
+		records = records[:maxResults]
+	}
+	dlq.Records = records
+	dlq.Groups = groupDLQRecords(records)
+
+	return &dlq, nil
+}
+
+// GetConnectorDLQRecords returns the dead letter queue of the connector with the
+// referenced records only. The dead letter queue must have been resolved with
+// GetConnectorDLQTopic. The records can be re-sent via ResendConnectorDLQRecords.
+func (s *Service) GetConnectorDLQRecords(ctx context.Context, dlqTopic *ConnectorDLQ, refs []ConnectorDLQRecordRef) (*ConnectorDLQ, *rest.Error) {
+	dlq := *dlqTopic
+
+	marks, restErr := s.getDLQPartitionMarks(ctx, dlq.TopicName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	records := make([]ConnectorDLQRecord, 0, len(refs))
+	for _, ref := range refs {
+		mark, exists := marks[ref.PartitionID]
+		if !exists || ref.Offset < mark.Low || ref.Offset >= mark.High {
+			return nil, &rest.Error{
+				Err:      fmt.Errorf("record %d/%d is out of range of the dead letter queue", ref.PartitionID, ref.Offset),
+				Status:   http.StatusNotFound,
+				Message:  fmt.Sprintf("Record at partition %d and offset %d does not exist in topic %q", ref.PartitionID, ref.Offset, dlq.TopicName),
+				IsSilent: false,
+			}
+		}
+		fetched, restErr := s.fetchDLQRecords(ctx, dlq.TopicName, map[int32]*kafka.PartitionConsumeRequest{
+			ref.PartitionID: {
+				PartitionID:     ref.PartitionID,
+				LowWaterMark:    mark.Low,
+				HighWaterMark:   mark.High,
+				StartOffset:     ref.Offset,
+				EndOffset:       ref.Offset,
+				MaxMessageCount: 1,
+			},
+		})
+		if restErr != nil {
+			return nil, restErr
+		}
+		if len(fetched) == 0 || fetched[0].Offset != ref.Offset || !fetched[0].belongsToConnector(dlq.ConnectorName) {
+			return nil, &rest.Error{
+				Err:      fmt.Errorf("record %d/%d not found in dead letter queue", ref.PartitionID, ref.Offset),
+				Status:   http.StatusNotFound,
+				Message:  fmt.Sprintf("Record at partition %d and offset %d does not exist in topic %q", ref.PartitionID, ref.Offset, dlq.TopicName),
+				IsSilent: false,
+			}
+		}
+		records = append(records, fetched[0])
+	}
+	dlq.Records = records
+	dlq.Groups = groupDLQRecords(records)
+
+	return &dlq, nil
+}
+
+// ResendConnectorDLQRecords produces the given dead letter queue records to the
+// partition of their original topic. The error headers that Kafka connect has
+// added are removed. Records without the original topic are not re-sent.
+func (s *Service) ResendConnectorDLQRecords(ctx context.Context, records []ConnectorDLQRecord) ProduceRecordsResponse {
+	kgoRecords := make([]*kgo.Record, 0, len(records))
+	for _, record := range records {
+		if record.Error.OriginalTopic == "" {
+			return ProduceRecordsResponse{
+				Error: fmt.Sprintf("Record at partition %d and offset %d has no %stopic header, make sure that errors.deadletterqueue.context.headers.enable is set",
+					record.PartitionID, record.Offset, connectErrorHeaderPrefix),
+			}
+		}
+		kgoRecords = append(kgoRecords, record.originalRecord())
+	}
+	return s.ProduceRecords(ctx, kgoRecords, false, []kgo.CompressionCodec{kgo.NoCompression()})
+}
+
+// GetConnectorDLQTopic returns the dead letter queue of the connector without
+// any records.
+func (s *Service) GetConnectorDLQTopic(ctx context.Context, clusterName string, connectorName string) (*ConnectorDLQ, *rest.Error) {
+	if s.connectSvc == nil || !s.connectSvc.Cfg.Enabled {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("kafka connect is not enabled"),
+			Status:   http.StatusServiceUnavailable,
+			Message:  "Kafka connect is not configured in Redpanda Console",
+			IsSilent: false,
+		}
+	}
+
+	connectorConfig, restErr := s.connectSvc.GetConnectorConfig(ctx, clusterName, connectorName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	topicName := strings.TrimSpace(connectorConfig["errors.deadletterqueue.topic.name"])
+	if topicName == "" {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("connector has no dead letter queue configured"),
+			Status:   http.StatusNotFound,
+			Message:  fmt.Sprintf("Connector %q has no dead letter queue, set errors.deadletterqueue.topic.name and errors.tolerance=all to enable it", connectorName),
+			IsSilent: false,
+		}
+	}
+
+	return &ConnectorDLQ{
+		ClusterName:           clusterName,
+		ConnectorName:         connectorName,
+		TopicName:             topicName,
+		ContextHeadersEnabled: connectorConfig["errors.deadletterqueue.context.headers.enable"] == "true",
+		Records:               make([]ConnectorDLQRecord, 0),
+		Groups:                make([]ConnectorDLQErrorGroup, 0),
+	}, nil
+}
+
+func (s *Service) getDLQPartitionMarks(ctx context.Context, topicName string) (map[int32]*kafka.PartitionMarks, *rest.Error) {
+	metadata, err := s.kafkaSvc.GetMetadataTopics(ctx, []string{topicName})
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("failed to get metadata of dead letter queue: %w", err),
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to get metadata of dead letter queue: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	var partitionIDs []int32
+	for _, topic := range metadata.Topics {
+		if err := kerr.ErrorForCode(topic.ErrorCode); err != nil {
+			status := http.StatusInternalServerError
+			if err == kerr.UnknownTopicOrPartition {
+				// The topic is only created once the first record failed.
+				status = http.StatusNotFound
+			}
+			return nil, &rest.Error{
+				Err:      fmt.Errorf("failed to get metadata of dead letter queue: %w", err),
+				Status:   status,
+				Message:  fmt.Sprintf("Failed to get metadata of dead letter queue %q: %v", topicName, err.Error()),
+				IsSilent: false,
+			}
+		}
+		for _, partition := range topic.Partitions {
+			partitionIDs = append(partitionIDs, partition.Partition)
+		}
+	}
+
+	marks, err := s.kafkaSvc.GetPartitionMarks(ctx, topicName, partitionIDs)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("failed to get watermarks of dead letter queue: %w", err),
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to get watermarks of dead letter queue: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	for _, mark := range marks {
+		if mark.Error != nil {
+			return nil, &rest.Error{
+				Err:      fmt.Errorf("failed to get watermarks of partition %d: %w", mark.PartitionID, mark.Error),
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Failed to get watermarks of dead letter queue partition %d: %v", mark.PartitionID, mark.Error.Error()),
+				IsSilent: false,
+			}
+		}
+	}
+	return marks, nil
+}
+
+// fetchDLQRecords consumes the requested partitions of the dead letter queue and
+// decodes the error headers of all records.
+func (s *Service) fetchDLQRecords(ctx context.Context, topicName string, partitions map[int32]*kafka.PartitionConsumeRequest) ([]ConnectorDLQRecord, *rest.Error) {
+	progress := &dlqProgress{records: make([]ConnectorDLQRecord, 0)}
+	if len(partitions) == 0 {
+		return progress.records, nil
+	}
+
+	totalCount := int64(0)
+	for _, partition := range partitions {
+		totalCount += partition.MaxMessageCount
+	}
+	err := s.kafkaSvc.FetchMessages(ctx, progress, kafka.TopicConsumeRequest{
+		TopicName:         topicName,
+		MaxMessageCount:   int(totalCount),
+		Partitions:        partitions,
+		IncludeRawPayload: true,
+		KeyDeserializer:   serde.PayloadEncodingUnspecified,
+		ValueDeserializer: serde.PayloadEncodingUnspecified,
+	})
+	if err == nil && progress.errMessage != "" {
+		err = fmt.Errorf("%s", progress.errMessage)
+	}
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("failed to consume dead letter queue: %w", err),
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to consume dead letter queue: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	return progress.records, nil
+}
+
+// dlqProgress implements kafka.IListMessagesProgress and collects all consumed
+// dead letter queue records.
+type dlqProgress struct {
+	records    []ConnectorDLQRecord
+	errMessage string
+}
+
+func (*dlqProgress) OnPhase(string) {}
+
+func (p *dlqProgress) OnMessage(msg *kafka.TopicMessage) {
+	record := ConnectorDLQRecord{
+		PartitionID: msg.PartitionID,
+		Offset:      msg.Offset,
+		Timestamp:   msg.Timestamp,
+		Key:         msg.Key,
+		Value:       msg.Value,
+	}
+	if msg.Key != nil {
+		record.rawKey = msg.Key.OriginalPayload
+		record.Key.OriginalPayload = nil
+	}
+	if msg.Value != nil {
+		record.rawValue = msg.Value.OriginalPayload
+		record.Value.OriginalPayload = nil
+	}
+	record.rawHeaders = make([]kgo.RecordHeader, len(msg.Headers))
+	for i, header := range msg.Headers {
+		record.rawHeaders[i] = kgo.RecordHeader{Key: header.Key, Value: header.Value}
+	}
+	record.Error = decodeConnectErrorHeaders(record.rawHeaders)
+	p.records = append(p.records, record)
+}
+
+func (*dlqProgress) OnMessageConsumed(int64) {}
+
+func (*dlqProgress) OnComplete(int64, bool) {}
+
+func (p *dlqProgress) OnError(msg string) {
+	p.errMessage = msg
+}
+
+// belongsToConnector returns false if the record has been written to the dead
+// letter queue by another connector. Several connectors may share the same dead
+// letter queue topic, which can only be told apart by the context headers.
+func (r *ConnectorDLQRecord) belongsToConnector(connectorName string) bool {
+	return r.Error.ConnectorName == "" || r.Error.ConnectorName == connectorName
+}
+
+// decodeConnectErrorHeaders decodes the error context headers that Kafka connect
+// adds to records in the dead letter queue.
+func decodeConnectErrorHeaders(headers []kgo.RecordHeader) ConnectorDLQError {
+	dlqErr := ConnectorDLQError{OriginalPartition: -1, OriginalOffset: -1, TaskID: -1}
+	for _, header := range headers {
+		name, ok := strings.CutPrefix(header.Key, connectErrorHeaderPrefix)
+		if !ok {
+			continue
+		}
+		value := string(header.Value)
+		switch name {
+		case "topic":
+			dlqErr.OriginalTopic = value
+		case "partition":
+			if partition, err := strconv.ParseInt(value, 10, 32); err == nil {
+				dlqErr.OriginalPartition = int32(partition)
+			}
+		case "offset":
+			if offset, err := strconv.ParseInt(value, 10, 64); err == nil {
+				dlqErr.OriginalOffset = offset
+			}
+		case "connector.name":
+			dlqErr.ConnectorName = value
+		case "task.id":
+			if taskID, err := strconv.Atoi(value); err == nil {
+				dlqErr.TaskID = taskID
+			}
+		case "stage":
+			dlqErr.Stage = value
+		case "class.name":
+			dlqErr.ClassName = value
+		case "exception.class.name":
+			dlqErr.ExceptionClassName = value
+		case "exception.message":
+			dlqErr.ExceptionMessage = value
+		case "exception.stacktrace":
+			dlqErr.ExceptionStackTrace = value
+		}
+	}
+	return dlqErr
+}
+
+// originalRecord returns the record as it was consumed by the connector.
+func (r *ConnectorDLQRecord) originalRecord() *kgo.Record {
+	headers := make([]kgo.RecordHeader, 0, len(r.rawHeaders))
+	for _, header := range r.rawHeaders {
+		if !strings.HasPrefix(header.Key, connectErrorHeaderPrefix) {
+			headers = append(headers, header)
+		}
+	}
+	return &kgo.Record{
+		Topic:     r.Error.OriginalTopic,
+		Partition: r.Error.OriginalPartition,
+		Key:       r.rawKey,
+		Value:     r.rawValue,
+		Headers:   headers,
+	}
+}
+
+// groupDLQRecords groups the records by exception class, sorted by the number
+// of records in descending order.
+func groupDLQRecords(records []ConnectorDLQRecord) []ConnectorDLQErrorGroup {
+	groupsByClass := make(map[string]*ConnectorDLQErrorGroup)
+	topicsByClass := make(map[string]map[string]struct{})
+	for _, record := range records {
+		class := record.Error.ExceptionClassName
+		group, exists := groupsByClass[class]
+		if !exists {
+			group = &ConnectorDLQErrorGroup{
+				ExceptionClassName: class,
+				FirstTimestamp:     record.Timestamp,
+				LastTimestamp:      record.Timestamp,
+			}
+			groupsByClass[class] = group
+			topicsByClass[class] = make(map[string]struct{})
+		}
+		group.Count++
+		if record.Timestamp <= group.FirstTimestamp {
+			group.FirstTimestamp = record.Timestamp
+		}
+		if record.Timestamp >= group.LastTimestamp {
+			group.LastTimestamp = record.Timestamp
+			group.ExceptionMessage = record.Error.ExceptionMessage
+		}
+		if record.Error.OriginalTopic != "" {
+			topicsByClass[class][record.Error.OriginalTopic] = struct{}{}
+		}
+	}
+
+	groups := make([]ConnectorDLQErrorGroup, 0, len(groupsByClass))
+	for class, group := range groupsByClass {
+		group.OriginalTopics = make([]string, 0, len(topicsByClass[class]))
+		for topic := range topicsByClass[class] {
+			group.OriginalTopics = append(group.OriginalTopics, topic)
+		}
+		sort.Strings(group.OriginalTopics)
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].ExceptionClassName < groups[j].ExceptionClassName
+	})
+	return groups
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestDecodeConnectErrorHeaders(t *testing.T) {
+	headers := []kgo.RecordHeader{
+		{Key: "trace-id", Value: []byte("abc")},
+		{Key: "__connect.errors.topic", Value: []byte("orders")},
+		{Key: "__connect.errors.partition", Value: []byte("3")},
+		{Key: "__connect.errors.offset", Value: []byte("1042")},
+		{Key: "__connect.errors.connector.name", Value: []byte("s3-sink")},
+		{Key: "__connect.errors.task.id", Value: []byte("1")},
+		{Key: "__connect.errors.stage", Value: []byte("VALUE_CONVERTER")},
+		{Key: "__connect.errors.class.name", Value: []byte("io.confluent.connect.avro.AvroConverter")},
+		{Key: "__connect.errors.exception.class.name", Value: []byte("org.apache.kafka.connect.errors.DataException")},
+		{Key: "__connect.errors.exception.message", Value: []byte("Unknown magic byte!")},
+		{Key: "__connect.errors.exception.stacktrace", Value: []byte("org.apache.kafka.connect.errors.DataException: ...")},
+	}
+
+	assert.Equal(t, ConnectorDLQError{
+		OriginalTopic:       "orders",
+		OriginalPartition:   3,
+		OriginalOffset:      1042,
+		ConnectorName:       "s3-sink",
+		TaskID:              1,
+		Stage:               "VALUE_CONVERTER",
+		ClassName:           "io.confluent.connect.avro.AvroConverter",
+		ExceptionClassName:  "org.apache.kafka.connect.errors.DataException",
+		ExceptionMessage:    "Unknown magic byte!",
+		ExceptionStackTrace: "org.apache.kafka.connect.errors.DataException: ...",
+	}, decodeConnectErrorHeaders(headers))
+
+	// Records without context headers only have the defaults.
+	assert.Equal(t, ConnectorDLQError{OriginalPartition: -1, OriginalOffset: -1, TaskID: -1}, decodeConnectErrorHeaders(nil))
+
+	record := ConnectorDLQRecord{
+		rawKey:     []byte("key"),
+		rawValue:   []byte("value"),
+		rawHeaders: headers,
+		Error:      decodeConnectErrorHeaders(headers),
+	}
+	original := record.originalRecord()
+	assert.Equal(t, "orders", original.Topic)
+	assert.Equal(t, int32(3), original.Partition)
+	assert.Equal(t, []byte("key"), original.Key)
+	assert.Equal(t, []byte("value"), original.Value)
+	assert.Equal(t, []kgo.RecordHeader{{Key: "trace-id", Value: []byte("abc")}}, original.Headers)
+}
+
+func TestGroupDLQRecords(t *testing.T) {
+	dataException := "org.apache.kafka.connect.errors.DataException"
+	records := []ConnectorDLQRecord{
+		{Timestamp: 300, Error: ConnectorDLQError{OriginalTopic: "orders", ExceptionClassName: dataException, ExceptionMessage: "latest"}},
+		{Timestamp: 100, Error: ConnectorDLQError{OriginalTopic: "payments", ExceptionClassName: dataException, ExceptionMessage: "first"}},
+		{Timestamp: 200, Error: ConnectorDLQError{OriginalTopic: "orders", ExceptionClassName: "java.lang.NullPointerException"}},
+	}
+
+	assert.Equal(t, []ConnectorDLQErrorGroup{
+		{
+			ExceptionClassName: dataException,
+			Count:              2,
+			ExceptionMessage:   "latest",
+			OriginalTopics:     []string{"orders", "payments"},
+			FirstTimestamp:     100,
+			LastTimestamp:      300,
+		},
+		{
+			ExceptionClassName: "java.lang.NullPointerException",
+			Count:              1,
+			OriginalTopics:     []string{"orders"},
+			FirstTimestamp:     200,
+			LastTimestamp:      200,
+		},
+	}, groupDLQRecords(records))
+}
+
+func TestConnectorDLQRecordBelongsToConnector(t *testing.T) {
+	record := ConnectorDLQRecord{Error: decodeConnectErrorHeaders([]kgo.RecordHeader{
+		{Key: "__connect.errors.connector.name", Value: []byte("s3-sink")},
+	})}
+	assert.True(t, record.belongsToConnector("s3-sink"))
+	assert.False(t, record.belongsToConnector("gcs-sink"), "records of other connectors sharing the topic must be omitted")
+
+	// Without context headers the connector is unknown, hence the record is kept.
+	record = ConnectorDLQRecord{Error: decodeConnectErrorHeaders(nil)}
+	assert.True(t, record.belongsToConnector("gcs-sink"))
+}
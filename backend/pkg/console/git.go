@@ -0,0 +1,49 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redpanda-data/console/backend/pkg/git"
+)
+
+// GitRefreshResult describes the sync state of all Git repositories after a refresh.
+type GitRefreshResult struct {
+	TopicDocumentation *git.GroupStatus `json:"topicDocumentation,omitempty"`
+	Protobuf           *git.GroupStatus `json:"protobuf,omitempty"`
+}
+
+// RefreshGitRepositories pulls all Git repositories that are used for topic
+// documentation and proto files immediately. Repositories that have changed
+// trigger a rebuild of the respective caches.
+func (s *Service) RefreshGitRepositories(ctx context.Context) (*GitRefreshResult, error) {
+	result := &GitRefreshResult{}
+	var errs []error
+
+	if s.gitSvc != nil {
+		if err := s.gitSvc.Refresh(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to refresh topic documentation: %w", err))
+		}
+		status := s.gitSvc.Status()
+		result.TopicDocumentation = &status
+	}
+
+	if protoSvc := s.kafkaSvc.ProtoService; protoSvc != nil {
+		if err := protoSvc.RefreshGitRepositories(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to refresh proto files: %w", err))
+		}
+		result.Protobuf = protoSvc.GitStatus()
+	}
+
+	return result, errors.Join(errs...)
+}
@@ -0,0 +1,736 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kbin"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+const (
+	// mirrorHeartbeatsTopic is the topic that the MirrorHeartbeatConnector
+	// produces to in the target cluster.
+	mirrorHeartbeatsTopic = "heartbeats"
+	// mirrorHeartbeatsPerPartition is the number of most recent records that
+	// are read from each partition of the heartbeat topics.
+	mirrorHeartbeatsPerPartition = 50
+)
+
+// mirrorReplication is a configured MirrorMaker 2 replication along with a
+// client for its source cluster.
+type mirrorReplication struct {
+	cfg       config.ConnectMirrorReplication
+	sourceAdm *kadm.Client
+}
+
+// MirrorReplicationInfo describes a configured MirrorMaker 2 replication.
+type MirrorReplicationInfo struct {
+	Name                string `json:"name"`
+	SourceClusterAlias  string `json:"sourceClusterAlias"`
+	TargetClusterAlias  string `json:"targetClusterAlias"`
+	ConnectClusterName  string `json:"connectClusterName"`
+	SourceConnectorName string `json:"sourceConnectorName"`
+	CheckpointsTopic    string `json:"checkpointsTopic"`
+}
+
+// MirrorReplication is the replication state of a MirrorMaker 2 replication.
+type MirrorReplication struct {
+	MirrorReplicationInfo
+	Partitions []MirrorReplicationPartition `json:"partitions"`
+	// TotalLag is the sum of the lag of all partitions with a known lag.
+	TotalLag   int64             `json:"totalLag"`
+	Heartbeats []MirrorHeartbeat `json:"heartbeats"`
+	// Errors contains the parts of the replication state that could not be
+	// retrieved. The remaining state is returned regardless.
+	Errors []MirrorReplicationError `json:"errors"`
+}
+
+// MirrorReplicationPartition is the replication state of a single source partition.
+type MirrorReplicationPartition struct {
+	SourceTopic string `json:"sourceTopic"`
+	TargetTopic string `json:"targetTopic"`
+	PartitionID int32  `json:"partitionId"`
+	// LastReplicatedOffset is the source offset of the most recent record that
+	// has been replicated, as committed by the MirrorSourceConnector.
+	LastReplicatedOffset int64 `json:"lastReplicatedOffset"`
+	// SourceHighWaterMark and TargetHighWaterMark are -1 if they could not be
+	// listed.
+	SourceHighWaterMark int64 `json:"sourceHighWaterMark"`
+	TargetHighWaterMark int64 `json:"targetHighWaterMark"`
+	// Lag is the number of source records that have not been replicated yet.
+	// It is -1 if the source high water mark is unknown.
+	Lag int64 `json:"lag"`
+}
+
+// MirrorHeartbeat is the most recent heartbeat of a source -> target flow
+// within a heartbeat topic of the target cluster.
+type MirrorHeartbeat struct {
+	TopicName          string    `json:"topicName"`
+	SourceClusterAlias string    `json:"sourceClusterAlias"`
+	TargetClusterAlias string    `json:"targetClusterAlias"`
+	Timestamp          time.Time `json:"timestamp"`
+	// AgeMs is the time since the heartbeat has been emitted. For replicated
+	// heartbeats, this includes the replication latency.
+	AgeMs int64 `json:"ageMs"`
+}
+
+// MirrorReplicationError describes a part of the replication state that could
+// not be retrieved.
+type MirrorReplicationError struct {
+	Component string `json:"component"`
+	Message   string `json:"message"`
+}
+
+// MirrorTranslatedOffsets are the consumer group offsets of the source
+// cluster translated to the target cluster, based on the checkpoints emitted
+// by the MirrorCheckpointConnector.
+type MirrorTranslatedOffsets struct {
+	ReplicationName  string                  `json:"replicationName"`
+	CheckpointsTopic string                  `json:"checkpointsTopic"`
+	Groups           []MirrorTranslatedGroup `json:"groups"`
+}
+
+// MirrorTranslatedGroup contains the translated offsets of a single consumer group.
+type MirrorTranslatedGroup struct {
+	GroupID    string                      `json:"groupId"`
+	Partitions []MirrorTranslatedPartition `json:"partitions"`
+}
+
+// MirrorTranslatedPartition is the translated offset of a single partition.
+type MirrorTranslatedPartition struct {
+	SourceTopic string `json:"sourceTopic"`
+	TargetTopic string `json:"targetTopic"`
+	PartitionID int32  `json:"partitionId"`
+	// UpstreamOffset is the committed offset of the group in the source cluster.
+	UpstreamOffset int64 `json:"upstreamOffset"`
+	// TranslatedOffset is the offset in the target cluster that corresponds to
+	// the upstream offset.
+	TranslatedOffset int64 `json:"translatedOffset"`
+	// CurrentOffset is the committed offset of the group in the target
+	// cluster, or -1 if the group has not committed an offset.
+	CurrentOffset int64 `json:"currentOffset"`
+}
+
+// mirrorCheckpoint is a decoded record of the checkpoints topic.
+type mirrorCheckpoint struct {
+	GroupID string
+	// Topic is the replicated topic in the target cluster, which the
+	// MirrorCheckpointConnector already renamed according to the replication
+	// policy.
+	Topic            string
+	Partition        int32
+	UpstreamOffset   int64
+	DownstreamOffset int64
+	Metadata         string
+}
+
+// newMirrorReplications creates a source cluster client for each configured
+// MirrorMaker 2 replication.
+func newMirrorReplications(cfg config.ConnectMirrorMaker2, logger *zap.Logger, hooks kgo.Hook) (map[string]*mirrorReplication, error) {
+	replications := make(map[string]*mirrorReplication, len(cfg.Replications))
+	for _, replicationCfg := range cfg.Replications {
+		kafkaCfg := replicationCfg.Source.KafkaConfig()
+		kgoOpts, err := kafka.NewKgoConfig(&kafkaCfg, logger.With(zap.String("mirror_replication", replicationCfg.Name)), hooks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kafka client config for replication %q: %w", replicationCfg.Name, err)
+		}
+		client, err := kgo.NewClient(kgoOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kafka client for replication %q: %w", replicationCfg.Name, err)
+		}
+		replications[replicationCfg.Name] = &mirrorReplication{
+			cfg:       replicationCfg,
+			sourceAdm: kadm.NewClient(client),
+		}
+	}
+	return replications, nil
+}
+
+// remoteTopic returns the name of a source topic in the target cluster.
+func (m *mirrorReplication) remoteTopic(topic string) string {
+	if m.cfg.ReplicationPolicy == config.MirrorReplicationPolicyIdentity {
+		return topic
+	}
+	return m.cfg.SourceClusterAlias + m.separator() + topic
+}
+
+// sourceTopic returns the name of a replicated topic in the source cluster.
+func (m *mirrorReplication) sourceTopic(remoteTopic string) string {
+	if m.cfg.ReplicationPolicy == config.MirrorReplicationPolicyIdentity {
+		return remoteTopic
+	}
+	return strings.TrimPrefix(remoteTopic, m.cfg.SourceClusterAlias+m.separator())
+}
+
+// translatedPartition returns the translated offset of a checkpoint. The
+// current offset is not known yet and set to -1.
+func (m *mirrorReplication) translatedPartition(checkpoint mirrorCheckpoint) MirrorTranslatedPartition {
+	return MirrorTranslatedPartition{
+		SourceTopic:      m.sourceTopic(checkpoint.Topic),
+		TargetTopic:      checkpoint.Topic,
+		PartitionID:      checkpoint.Partition,
+		UpstreamOffset:   checkpoint.UpstreamOffset,
+		TranslatedOffset: checkpoint.DownstreamOffset,
+		CurrentOffset:    -1,
+	}
+}
+
+// checkpointsTopic returns the name of the checkpoints topic in the target cluster.
+func (m *mirrorReplication) checkpointsTopic() string {
+	return m.cfg.SourceClusterAlias + m.separator() + "checkpoints.internal"
+}
+
+// heartbeatTopics returns the heartbeat topics in the target cluster. The
+// heartbeats of the source cluster are replicated like any other topic.
+func (m *mirrorReplication) heartbeatTopics() []string {
+	topics := []string{mirrorHeartbeatsTopic}
+	if remote := m.remoteTopic(mirrorHeartbeatsTopic); remote != mirrorHeartbeatsTopic {
+		topics = append(topics, remote)
+	}
+	return topics
+}
+
+func (m *mirrorReplication) separator() string {
+	if m.cfg.Separator == "" {
+		return "."
+	}
+	return m.cfg.Separator
+}
+
+func (m *mirrorReplication) info() MirrorReplicationInfo {
+	return MirrorReplicationInfo{
+		Name:                m.cfg.Name,
+		SourceClusterAlias:  m.cfg.SourceClusterAlias,
+		TargetClusterAlias:  m.cfg.TargetClusterAlias,
+		ConnectClusterName:  m.cfg.ConnectClusterName,
+		SourceConnectorName: m.cfg.SourceConnectorName,
+		CheckpointsTopic:    m.checkpointsTopic(),
+	}
+}
+
+// ListMirrorReplications returns all configured MirrorMaker 2 replications.
+func (s *Service) ListMirrorReplications() ([]MirrorReplicationInfo, *rest.Error) {
+	if s.mirrorReplications == nil {
+		return nil, errMirrorMakerNotEnabled()
+	}
+
+	replications := make([]MirrorReplicationInfo, 0, len(s.mirrorReplications))
+	for _, replication := range s.mirrorReplications {
+		replications = append(replications, replication.info())
+	}
+	sort.Slice(replications, func(i, j int) bool { return replications[i].Name < replications[j].Name })
+	return replications, nil
+}
+
+// GetMirrorReplication returns the lag of all replicated partitions along with
+// the most recent heartbeats of the replication.
+func (s *Service) GetMirrorReplication(ctx context.Context, name string) (*MirrorReplication, *rest.Error) {
+	replication, restErr := s.getMirrorReplication(name)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	res := &MirrorReplication{
+		MirrorReplicationInfo: replication.info(),
+		Partitions:            make([]MirrorReplicationPartition, 0),
+		Heartbeats:            make([]MirrorHeartbeat, 0),
+		Errors:                make([]MirrorReplicationError, 0),
+	}
+	addError := func(component string, err error) {
+		res.Errors = append(res.Errors, MirrorReplicationError{Component: component, Message: err.Error()})
+	}
+
+	// 1. The source offsets of the MirrorSourceConnector are the last replicated
+	// offset of each source partition.
+	offsets, restErr := s.connectSvc.GetConnectorOffsets(ctx, replication.cfg.ConnectClusterName, replication.cfg.SourceConnectorName)
+	if restErr != nil {
+		addError("sourceConnector", errors.New(restErr.Message))
+	}
+	for _, offset := range offsets.Offsets {
+		topic, partition, lastOffset, ok := decodeMirrorSourceOffset(offset.Partition, offset.Offset)
+		if !ok {
+			continue
+		}
+		res.Partitions = append(res.Partitions, MirrorReplicationPartition{
+			SourceTopic:          topic,
+			TargetTopic:          replication.remoteTopic(topic),
+			PartitionID:          partition,
+			LastReplicatedOffset: lastOffset,
+			SourceHighWaterMark:  -1,
+			TargetHighWaterMark:  -1,
+			Lag:                  -1,
+		})
+	}
+	sort.Slice(res.Partitions, func(i, j int) bool {
+		if res.Partitions[i].SourceTopic != res.Partitions[j].SourceTopic {
+			return res.Partitions[i].SourceTopic < res.Partitions[j].SourceTopic
+		}
+		return res.Partitions[i].PartitionID < res.Partitions[j].PartitionID
+	})
+
+	// 2. Compare them with the high water marks of both clusters
+	if len(res.Partitions) > 0 {
+		s.setMirrorHighWaterMarks(ctx, replication, res.Partitions, addError)
+	}
+	for _, partition := range res.Partitions {
+		if partition.Lag > 0 {
+			res.TotalLag += partition.Lag
+		}
+	}
+
+	// 3. Heartbeats
+	now := time.Now()
+	for _, topic := range replication.heartbeatTopics() {
+		heartbeats, err := s.readMirrorHeartbeats(ctx, topic)
+		if err != nil {
+			addError("heartbeats", err)
+			continue
+		}
+		for _, heartbeat := range heartbeats {
+			heartbeat.AgeMs = now.Sub(heartbeat.Timestamp).Milliseconds()
+			res.Heartbeats = append(res.Heartbeats, heartbeat)
+		}
+	}
+
+	return res, nil
+}
+
+// GetMirrorTranslatedOffsets translates the source cluster offsets of the given
+// consumer groups to the target cluster. If no groups are given, all groups
+// with checkpoints are returned.
+func (s *Service) GetMirrorTranslatedOffsets(ctx context.Context, name string, groupIDs []string) (*MirrorTranslatedOffsets, *rest.Error) {
+	replication, restErr := s.getMirrorReplication(name)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	checkpoints, err := s.readMirrorCheckpoints(ctx, replication.checkpointsTopic())
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("failed to read checkpoints: %w", err),
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to read checkpoints topic %q: %v", replication.checkpointsTopic(), err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	groupFilter := make(map[string]struct{}, len(groupIDs))
+	for _, groupID := range groupIDs {
+		groupFilter[groupID] = struct{}{}
+	}
+	checkpointsByGroup := make(map[string][]mirrorCheckpoint)
+	for _, checkpoint := range checkpoints {
+		if _, ok := groupFilter[checkpoint.GroupID]; len(groupFilter) > 0 && !ok {
+			continue
+		}
+		checkpointsByGroup[checkpoint.GroupID] = append(checkpointsByGroup[checkpoint.GroupID], checkpoint)
+	}
+
+	res := &MirrorTranslatedOffsets{
+		ReplicationName:  replication.cfg.Name,
+		CheckpointsTopic: replication.checkpointsTopic(),
+		Groups:           make([]MirrorTranslatedGroup, 0, len(checkpointsByGroup)),
+	}
+	if len(checkpointsByGroup) == 0 {
+		return res, nil
+	}
+
+	groups := make([]string, 0, len(checkpointsByGroup))
+	for groupID := range checkpointsByGroup {
+		groups = append(groups, groupID)
+	}
+	sort.Strings(groups)
+	currentOffsets := s.kafkaSvc.ListConsumerGroupOffsetsBulk(ctx, groups)
+
+	for _, groupID := range groups {
+		group := MirrorTranslatedGroup{
+			GroupID:    groupID,
+			Partitions: make([]MirrorTranslatedPartition, 0, len(checkpointsByGroup[groupID])),
+		}
+		for _, checkpoint := range checkpointsByGroup[groupID] {
+			partition := replication.translatedPartition(checkpoint)
+			if offset, exists := currentOffsets[groupID].Fetched.Lookup(partition.TargetTopic, partition.PartitionID); exists && offset.Err == nil {
+				partition.CurrentOffset = offset.At
+			}
+			group.Partitions = append(group.Partitions, partition)
+		}
+		sort.Slice(group.Partitions, func(i, j int) bool {
+			if group.Partitions[i].TargetTopic != group.Partitions[j].TargetTopic {
+				return group.Partitions[i].TargetTopic < group.Partitions[j].TargetTopic
+			}
+			return group.Partitions[i].PartitionID < group.Partitions[j].PartitionID
+		})
+		res.Groups = append(res.Groups, group)
+	}
+
+	return res, nil
+}
+
+// ApplyMirrorTranslatedOffsets commits the translated offsets of a consumer
+// group in the target cluster, so that its consumers can fail over. Partitions
+// whose committed offset is already at or beyond the translated offset are
+// not rewound. Like any other offset edit, the group must not have active
+// members.
+func (s *Service) ApplyMirrorTranslatedOffsets(ctx context.Context, name string, groupID string) (*EditConsumerGroupOffsetsResponse, *rest.Error) {
+	translated, restErr := s.GetMirrorTranslatedOffsets(ctx, name, []string{groupID})
+	if restErr != nil {
+		return nil, restErr
+	}
+	if len(translated.Groups) == 0 {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("no checkpoints found for consumer group %q", groupID),
+			Status:   http.StatusNotFound,
+			Message:  fmt.Sprintf("No checkpoints found for consumer group %q in topic %q", groupID, translated.CheckpointsTopic),
+			IsSilent: false,
+		}
+	}
+
+	topics := mirrorOffsetCommitTopics(translated.Groups[0].Partitions)
+	if len(topics) == 0 {
+		return &EditConsumerGroupOffsetsResponse{Topics: make([]EditConsumerGroupOffsetsResponseTopic, 0)}, nil
+	}
+	return s.EditConsumerGroupOffsets(ctx, groupID, topics)
+}
+
+// mirrorOffsetCommitTopics builds the offset commit request for all partitions
+// whose translated offset is ahead of the current offset.
+func mirrorOffsetCommitTopics(partitions []MirrorTranslatedPartition) []kmsg.OffsetCommitRequestTopic {
+	topicsByName := make(map[string]*kmsg.OffsetCommitRequestTopic)
+	topicNames := make([]string, 0)
+	for _, partition := range partitions {
+		if partition.CurrentOffset >= partition.TranslatedOffset {
+			continue
+		}
+		topic, exists := topicsByName[partition.TargetTopic]
+		if !exists {
+			topicReq := kmsg.NewOffsetCommitRequestTopic()
+			topicReq.Topic = partition.TargetTopic
+			topic = &topicReq
+			topicsByName[partition.TargetTopic] = topic
+			topicNames = append(topicNames, partition.TargetTopic)
+		}
+		partitionReq := kmsg.NewOffsetCommitRequestTopicPartition()
+		partitionReq.Partition = partition.PartitionID
+		partitionReq.Offset = partition.TranslatedOffset
+		topic.Partitions = append(topic.Partitions, partitionReq)
+	}
+
+	topics := make([]kmsg.OffsetCommitRequestTopic, len(topicNames))
+	for i, topicName := range topicNames {
+		topics[i] = *topicsByName[topicName]
+	}
+	return topics
+}
+
+func (s *Service) getMirrorReplication(name string) (*mirrorReplication, *rest.Error) {
+	if s.mirrorReplications == nil {
+		return nil, errMirrorMakerNotEnabled()
+	}
+	replication, exists := s.mirrorReplications[name]
+	if !exists {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("replication %q is not configured", name),
+			Status:   http.StatusNotFound,
+			Message:  fmt.Sprintf("MirrorMaker 2 replication %q is not configured", name),
+			IsSilent: false,
+		}
+	}
+	return replication, nil
+}
+
+func errMirrorMakerNotEnabled() *rest.Error {
+	return &rest.Error{
+		Err:      errors.New("mirror maker 2 replications are not enabled"),
+		Status:   http.StatusServiceUnavailable,
+		Message:  "MirrorMaker 2 replications are not enabled",
+		IsSilent: false,
+	}
+}
+
+// setMirrorHighWaterMarks sets the high water marks and the lag of all partitions.
+func (s *Service) setMirrorHighWaterMarks(ctx context.Context, replication *mirrorReplication, partitions []MirrorReplicationPartition, addError func(string, error)) {
+	sourceTopics := make([]string, 0)
+	targetPartitions := make(map[string][]int32)
+	for _, partition := range partitions {
+		if _, exists := targetPartitions[partition.TargetTopic]; !exists {
+			sourceTopics = append(sourceTopics, partition.SourceTopic)
+		}
+		targetPartitions[partition.TargetTopic] = append(targetPartitions[partition.TargetTopic], partition.PartitionID)
+	}
+
+	sourceOffsets, err := replication.sourceAdm.ListEndOffsets(ctx, sourceTopics...)
+	if err != nil {
+		addError("sourceCluster", err)
+	}
+	targetMarks, err := s.kafkaSvc.GetPartitionMarksBulk(ctx, targetPartitions)
+	if err != nil {
+		addError("targetCluster", err)
+	}
+
+	for i := range partitions {
+		partition := &partitions[i]
+		if offset, exists := sourceOffsets.Lookup(partition.SourceTopic, partition.PartitionID); exists && offset.Err == nil {
+			partition.SourceHighWaterMark = offset.Offset
+			partition.Lag = max(0, offset.Offset-partition.LastReplicatedOffset-1)
+		}
+		if mark, exists := targetMarks[partition.TargetTopic][partition.PartitionID]; exists && mark.Error == nil {
+			partition.TargetHighWaterMark = mark.High
+		}
+	}
+}
+
+// decodeMirrorSourceOffset decodes a source partition and offset of the
+// MirrorSourceConnector, e.g. {"cluster": "us-east", "topic": "orders",
+// "partition": 3} and {"offset": 1042}.
+func decodeMirrorSourceOffset(partition map[string]any, offset map[string]any) (string, int32, int64, bool) {
+	topic, ok := partition["topic"].(string)
+	if !ok {
+		return "", 0, 0, false
+	}
+	partitionID, ok := partition["partition"].(float64)
+	if !ok {
+		return "", 0, 0, false
+	}
+	lastOffset, ok := offset["offset"].(float64)
+	if !ok {
+		return "", 0, 0, false
+	}
+	return topic, int32(partitionID), int64(lastOffset), true
+}
+
+// readMirrorHeartbeats returns the most recent heartbeat of each source ->
+// target flow in the heartbeat topic. A missing topic has no heartbeats.
+func (s *Service) readMirrorHeartbeats(ctx context.Context, topicName string) ([]MirrorHeartbeat, error) {
+	records, err := s.readMirrorTopic(ctx, topicName, mirrorHeartbeatsPerPartition)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[[2]string]MirrorHeartbeat)
+	for _, record := range records {
+		heartbeat, err := decodeMirrorHeartbeat(record.Key, record.Value)
+		if err != nil {
+			s.logger.Debug("skipping invalid heartbeat record", zap.String("topic_name", topicName), zap.Error(err))
+			continue
+		}
+		heartbeat.TopicName = topicName
+		key := [2]string{heartbeat.SourceClusterAlias, heartbeat.TargetClusterAlias}
+		if heartbeat.Timestamp.After(latest[key].Timestamp) {
+			latest[key] = heartbeat
+		}
+	}
+
+	heartbeats := make([]MirrorHeartbeat, 0, len(latest))
+	for _, heartbeat := range latest {
+		heartbeats = append(heartbeats, heartbeat)
+	}
+	sort.Slice(heartbeats, func(i, j int) bool {
+		if heartbeats[i].SourceClusterAlias != heartbeats[j].SourceClusterAlias {
+			return heartbeats[i].SourceClusterAlias < heartbeats[j].SourceClusterAlias
+		}
+		return heartbeats[i].TargetClusterAlias < heartbeats[j].TargetClusterAlias
+	})
+	return heartbeats, nil
+}
+
+// readMirrorCheckpoints reads the entire checkpoints topic and returns the
+// latest checkpoint of each group, topic and partition.
+func (s *Service) readMirrorCheckpoints(ctx context.Context, topicName string) ([]mirrorCheckpoint, error) {
+	records, err := s.readMirrorTopic(ctx, topicName, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	type checkpointKey struct {
+		groupID   string
+		topic     string
+		partition int32
+	}
+	// Checkpoints with the same key are always in the same partition, so
+	// that the last consumed checkpoint of each key is the latest.
+	latest := make(map[checkpointKey]mirrorCheckpoint)
+	for _, record := range records {
+		checkpoint, err := decodeMirrorCheckpoint(record.Key, record.Value)
+		if err != nil {
+			s.logger.Debug("skipping invalid checkpoint record", zap.String("topic_name", topicName), zap.Error(err))
+			continue
+		}
+		latest[checkpointKey{checkpoint.GroupID, checkpoint.Topic, checkpoint.Partition}] = checkpoint
+	}
+
+	checkpoints := make([]mirrorCheckpoint, 0, len(latest))
+	for _, checkpoint := range latest {
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	return checkpoints, nil
+}
+
+// readMirrorTopic consumes the given topic of the target cluster. If
+// perPartition is greater than 0, only the most recent records of each
+// partition are consumed. A missing topic has no records.
+func (s *Service) readMirrorTopic(ctx context.Context, topicName string, perPartition int64) ([]*kgo.Record, error) {
+	metadata, err := s.kafkaSvc.GetMetadataTopics(ctx, []string{topicName})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata of topic %q: %w", topicName, err)
+	}
+	var partitionIDs []int32
+	for _, topic := range metadata.Topics {
+		if err := kerr.ErrorForCode(topic.ErrorCode); err != nil {
+			if errors.Is(err, kerr.UnknownTopicOrPartition) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to get metadata of topic %q: %w", topicName, err)
+		}
+		for _, partition := range topic.Partitions {
+			partitionIDs = append(partitionIDs, partition.Partition)
+		}
+	}
+	marks, err := s.kafkaSvc.GetPartitionMarks(ctx, topicName, partitionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watermarks of topic %q: %w", topicName, err)
+	}
+
+	partitions := make(map[int32]*kafka.PartitionConsumeRequest)
+	totalCount := int64(0)
+	for _, mark := range marks {
+		if mark.Error != nil {
+			return nil, fmt.Errorf("failed to get watermarks of topic %q partition %d: %w", topicName, mark.PartitionID, mark.Error)
+		}
+		if mark.High <= mark.Low {
+			continue
+		}
+		startOffset := mark.Low
+		if perPartition > 0 {
+			startOffset = max(mark.Low, mark.High-perPartition)
+		}
+		partitions[mark.PartitionID] = &kafka.PartitionConsumeRequest{
+			PartitionID:     mark.PartitionID,
+			LowWaterMark:    mark.Low,
+			HighWaterMark:   mark.High,
+			StartOffset:     startOffset,
+			EndOffset:       mark.High - 1,
+			MaxMessageCount: mark.High - startOffset,
+		}
+		totalCount += mark.High - startOffset
+	}
+	if len(partitions) == 0 {
+		return nil, nil
+	}
+
+	progress := &mirrorTopicProgress{}
+	err = s.kafkaSvc.FetchMessages(ctx, progress, kafka.TopicConsumeRequest{
+		TopicName:         topicName,
+		MaxMessageCount:   int(totalCount),
+		Partitions:        partitions,
+		IncludeRawPayload: true,
+		KeyDeserializer:   serde.PayloadEncodingBinary,
+		ValueDeserializer: serde.PayloadEncodingBinary,
+	})
+	if err == nil && progress.errMessage != "" {
+		err = errors.New(progress.errMessage)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume topic %q: %w", topicName, err)
+	}
+	return progress.records, nil
+}
+
+// mirrorTopicProgress implements kafka.IListMessagesProgress and collects the
+// raw keys and values of all consumed records.
+type mirrorTopicProgress struct {
+	records    []*kgo.Record
+	errMessage string
+}
+
+func (*mirrorTopicProgress) OnPhase(string) {}
+
+func (p *mirrorTopicProgress) OnMessage(msg *kafka.TopicMessage) {
+	record := &kgo.Record{Partition: msg.PartitionID, Offset: msg.Offset}
+	if msg.Key != nil {
+		record.Key = msg.Key.OriginalPayload
+	}
+	if msg.Value != nil {
+		record.Value = msg.Value.OriginalPayload
+	}
+	p.records = append(p.records, record)
+}
+
+func (*mirrorTopicProgress) OnMessageConsumed(int64) {}
+
+func (*mirrorTopicProgress) OnComplete(int64, bool) {}
+
+func (p *mirrorTopicProgress) OnError(msg string) {
+	p.errMessage = msg
+}
+
+// decodeMirrorHeartbeat decodes a heartbeat record. The key consists of the
+// source and target cluster alias, the value of a version and the timestamp
+// in milliseconds.
+func decodeMirrorHeartbeat(key []byte, value []byte) (MirrorHeartbeat, error) {
+	keyReader := kbin.Reader{Src: key}
+	heartbeat := MirrorHeartbeat{
+		SourceClusterAlias: keyReader.String(),
+		TargetClusterAlias: keyReader.String(),
+	}
+	if err := keyReader.Complete(); err != nil {
+		return MirrorHeartbeat{}, fmt.Errorf("failed to decode heartbeat key: %w", err)
+	}
+
+	valueReader := kbin.Reader{Src: value}
+	valueReader.Int16() // version
+	timestamp := valueReader.Int64()
+	if !valueReader.Ok() {
+		return MirrorHeartbeat{}, errors.New("failed to decode heartbeat value")
+	}
+	heartbeat.Timestamp = time.UnixMilli(timestamp)
+	return heartbeat, nil
+}
+
+// decodeMirrorCheckpoint decodes a checkpoint record. The key consists of the
+// consumer group, topic and partition, the value of a version, the upstream
+// and downstream offset and the offset metadata.
+func decodeMirrorCheckpoint(key []byte, value []byte) (mirrorCheckpoint, error) {
+	keyReader := kbin.Reader{Src: key}
+	checkpoint := mirrorCheckpoint{
+		GroupID:   keyReader.String(),
+		Topic:     keyReader.String(),
+		Partition: keyReader.Int32(),
+	}
+	if err := keyReader.Complete(); err != nil {
+		return mirrorCheckpoint{}, fmt.Errorf("failed to decode checkpoint key: %w", err)
+	}
+
+	valueReader := kbin.Reader{Src: value}
+	valueReader.Int16() // version
+	checkpoint.UpstreamOffset = valueReader.Int64()
+	checkpoint.DownstreamOffset = valueReader.Int64()
+	checkpoint.Metadata = valueReader.String()
+	if !valueReader.Ok() {
+		return mirrorCheckpoint{}, errors.New("failed to decode checkpoint value")
+	}
+	return checkpoint, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kbin"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestDecodeMirrorRecords(t *testing.T) {
+	key := kbin.AppendString(nil, "us-east")
+	key = kbin.AppendString(key, "eu-west")
+	value := kbin.AppendInt16(nil, 0)
+	value = kbin.AppendInt64(value, 1700000000000)
+
+	heartbeat, err := decodeMirrorHeartbeat(key, value)
+	require.NoError(t, err)
+	assert.Equal(t, "us-east", heartbeat.SourceClusterAlias)
+	assert.Equal(t, "eu-west", heartbeat.TargetClusterAlias)
+	assert.Equal(t, time.UnixMilli(1700000000000), heartbeat.Timestamp)
+
+	_, err = decodeMirrorHeartbeat(key, []byte{0})
+	assert.Error(t, err)
+
+	key = kbin.AppendString(nil, "order-processor")
+	key = kbin.AppendString(key, "us-east.orders")
+	key = kbin.AppendInt32(key, 3)
+	value = kbin.AppendInt16(nil, 0)
+	value = kbin.AppendInt64(value, 1042)
+	value = kbin.AppendInt64(value, 987)
+	value = kbin.AppendString(value, "")
+
+	checkpoint, err := decodeMirrorCheckpoint(key, value)
+	require.NoError(t, err)
+	assert.Equal(t, mirrorCheckpoint{
+		GroupID:          "order-processor",
+		Topic:            "us-east.orders",
+		Partition:        3,
+		UpstreamOffset:   1042,
+		DownstreamOffset: 987,
+	}, checkpoint)
+
+	_, err = decodeMirrorCheckpoint(key[:len(key)-1], value)
+	assert.Error(t, err)
+}
+
+func TestDecodeMirrorSourceOffset(t *testing.T) {
+	topic, partition, offset, ok := decodeMirrorSourceOffset(
+		map[string]any{"cluster": "us-east", "topic": "orders", "partition": float64(3)},
+		map[string]any{"offset": float64(1042)},
+	)
+	require.True(t, ok)
+	assert.Equal(t, "orders", topic)
+	assert.Equal(t, int32(3), partition)
+	assert.Equal(t, int64(1042), offset)
+
+	_, _, _, ok = decodeMirrorSourceOffset(map[string]any{"topic": "orders"}, map[string]any{"offset": float64(1)})
+	assert.False(t, ok)
+}
+
+func TestMirrorReplicationTopics(t *testing.T) {
+	replication := &mirrorReplication{cfg: config.ConnectMirrorReplication{SourceClusterAlias: "us-east"}}
+	assert.Equal(t, "us-east.orders", replication.remoteTopic("orders"))
+	assert.Equal(t, "us-east.checkpoints.internal", replication.checkpointsTopic())
+	assert.Equal(t, []string{"heartbeats", "us-east.heartbeats"}, replication.heartbeatTopics())
+
+	assert.Equal(t, "orders", replication.sourceTopic("us-east.orders"))
+
+	replication.cfg.Separator = "_"
+	assert.Equal(t, "us-east_orders", replication.remoteTopic("orders"))
+	assert.Equal(t, "orders", replication.sourceTopic("us-east_orders"))
+
+	replication.cfg.ReplicationPolicy = config.MirrorReplicationPolicyIdentity
+	assert.Equal(t, "orders", replication.remoteTopic("orders"))
+	assert.Equal(t, "orders", replication.sourceTopic("orders"))
+	assert.Equal(t, []string{"heartbeats"}, replication.heartbeatTopics())
+}
+
+func TestMirrorOffsetCommitTopics(t *testing.T) {
+	topics := mirrorOffsetCommitTopics([]MirrorTranslatedPartition{
+		{TargetTopic: "us-east.orders", PartitionID: 0, TranslatedOffset: 100, CurrentOffset: -1},
+		{TargetTopic: "us-east.orders", PartitionID: 1, TranslatedOffset: 100, CurrentOffset: 150},
+		{TargetTopic: "us-east.payments", PartitionID: 0, TranslatedOffset: 20, CurrentOffset: 10},
+	})
+
+	require.Len(t, topics, 2)
+	assert.Equal(t, "us-east.orders", topics[0].Topic)
+	require.Len(t, topics[0].Partitions, 1)
+	assert.Equal(t, int32(0), topics[0].Partitions[0].Partition)
+	assert.Equal(t, int64(100), topics[0].Partitions[0].Offset)
+	assert.Equal(t, "us-east.payments", topics[1].Topic)
+	assert.Equal(t, int64(20), topics[1].Partitions[0].Offset)
+}
+
+func TestMirrorCheckpointOffsetCommit(t *testing.T) {
+	// Checkpoints refer to the topic in the target cluster, which must be
+	// committed as is rather than being renamed a second time.
+	key := kbin.AppendString(nil, "order-processor")
+	key = kbin.AppendString(key, "us-east.orders")
+	key = kbin.AppendInt32(key, 2)
+	value := kbin.AppendInt16(nil, 0)
+	value = kbin.AppendInt64(value, 1042)
+	value = kbin.AppendInt64(value, 987)
+	value = kbin.AppendString(value, "")
+	checkpoint, err := decodeMirrorCheckpoint(key, value)
+	require.NoError(t, err)
+
+	replication := &mirrorReplication{cfg: config.ConnectMirrorReplication{SourceClusterAlias: "us-east"}}
+	partition := replication.translatedPartition(checkpoint)
+	assert.Equal(t, MirrorTranslatedPartition{
+		SourceTopic:      "orders",
+		TargetTopic:      "us-east.orders",
+		PartitionID:      2,
+		UpstreamOffset:   1042,
+		TranslatedOffset: 987,
+		CurrentOffset:    -1,
+	}, partition)
+
+	topics := mirrorOffsetCommitTopics([]MirrorTranslatedPartition{partition})
+	require.Len(t, topics, 1)
+	assert.Equal(t, "us-east.orders", topics[0].Topic)
+	require.Len(t, topics[0].Partitions, 1)
+	assert.Equal(t, int32(2), topics[0].Partitions[0].Partition)
+	assert.Equal(t, int64(987), topics[0].Partitions[0].Offset)
+}
@@ -75,6 +75,16 @@ type OverviewKafkaConnectCluster struct {
 	Host             string `json:"host"`
 	Version          string `json:"version"`
 	InstalledPlugins int    `json:"installedPlugins"`
+	// Workers is the health of all discovered workers. It is only set if worker
+	// discovery is enabled for the cluster.
+	Workers []OverviewKafkaConnectWorker `json:"workers,omitempty"`
+}
+
+// OverviewKafkaConnectWorker is the overview information for a single discovered
+// Kafka connect worker.
+type OverviewKafkaConnectWorker struct {
+	OverviewStatus
+	URL string `json:"url"`
 }
 
 // OverviewSchemaRegistry provides information for the schema registry that is configured
@@ -187,6 +197,7 @@ func (s *Service) getConnectOverview(ctx context.Context) OverviewKafkaConnect {
 			Host:             clusterInfo.Host,
 			Version:          clusterInfo.Version,
 			InstalledPlugins: len(clusterInfo.Plugins),
+			Workers:          s.getConnectWorkersOverview(ctx, clusterInfo.Name),
 		}
 	}
 	return OverviewKafkaConnect{
@@ -194,3 +205,26 @@ func (s *Service) getConnectOverview(ctx context.Context) OverviewKafkaConnect {
 		Clusters:     clustersOverview,
 	}
 }
+
+// getConnectWorkersOverview returns the health of all discovered workers of the
+// Kafka connect cluster. The cluster status is reported as part of the cluster
+// overview, hence errors are not returned.
+func (s *Service) getConnectWorkersOverview(ctx context.Context, clusterName string) []OverviewKafkaConnectWorker {
+	workers, restErr := s.connectSvc.CheckWorkers(ctx, clusterName)
+	if restErr != nil || workers == nil {
+		return nil
+	}
+
+	overview := make([]OverviewKafkaConnectWorker, len(workers))
+	for i, worker := range workers {
+		status := OverviewStatus{Status: StatusTypeHealthy}
+		if !worker.Healthy {
+			status.SetStatus(StatusTypeUnhealthy, fmt.Sprintf("Could not reach Kafka connect worker: %v", worker.Error))
+		}
+		overview[i] = OverviewKafkaConnectWorker{
+			OverviewStatus: status,
+			URL:            worker.URL,
+		}
+	}
+	return overview
+}
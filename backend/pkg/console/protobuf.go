@@ -0,0 +1,38 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redpanda-data/console/backend/pkg/proto"
+)
+
+// errProtobufNotConfigured is returned if the protobuf deserializer is not enabled.
+var errProtobufNotConfigured = errors.New("protobuf deserializer is not configured")
+
+// GetProtobufDiagnostics returns the loaded proto files, registered message types,
+// compile errors and resolved topic mappings of the last proto registry refresh.
+func (s *Service) GetProtobufDiagnostics(_ context.Context) (*proto.Diagnostics, error) {
+	if s.kafkaSvc.ProtoService == nil {
+		return nil, errProtobufNotConfigured
+	}
+	return s.kafkaSvc.ProtoService.GetDiagnostics(), nil
+}
+
+// DecodeProtobufPayload decodes a raw payload with the given message type. If no
+// message type is provided, the schema registry wire format is assumed.
+func (s *Service) DecodeProtobufPayload(_ context.Context, payload []byte, messageType string) (*proto.DecodeResult, error) {
+	if s.kafkaSvc.ProtoService == nil {
+		return nil, errProtobufNotConfigured
+	}
+	return s.kafkaSvc.ProtoService.TestDecode(payload, messageType), nil
+}
@@ -49,6 +49,65 @@ func (s *Service) GetSchemaRegistryMode(ctx context.Context) (*SchemaRegistryMod
 	return &SchemaRegistryMode{Mode: mode.Mode}, nil
 }
 
+// PutSchemaRegistryMode sets the global schema registry mode. The IMPORT mode can only be
+// set if no schemas are registered, unless force is set to true.
+func (s *Service) PutSchemaRegistryMode(ctx context.Context, mode schema.Mode, force bool) (*SchemaRegistryMode, error) {
+	res, err := s.kafkaSvc.SchemaService.PutMode(ctx, mode, force)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaRegistryMode{Mode: res.Mode}, nil
+}
+
+// GetSchemaRegistrySubjectMode retrieves the subject's mode. The global mode is returned
+// if there is no mode set for the subject.
+func (s *Service) GetSchemaRegistrySubjectMode(ctx context.Context, subject string) (*SchemaRegistryMode, error) {
+	res, err := s.kafkaSvc.SchemaService.GetSubjectMode(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaRegistryMode{Mode: res.Mode}, nil
+}
+
+// PutSchemaRegistrySubjectMode sets the subject's mode.
+func (s *Service) PutSchemaRegistrySubjectMode(ctx context.Context, subject string, mode schema.Mode, force bool) (*SchemaRegistryMode, error) {
+	res, err := s.kafkaSvc.SchemaService.PutSubjectMode(ctx, subject, mode, force)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaRegistryMode{Mode: res.Mode}, nil
+}
+
+// DeleteSchemaRegistrySubjectMode deletes the subject's mode, so that the global mode applies again.
+func (s *Service) DeleteSchemaRegistrySubjectMode(ctx context.Context, subject string) (*SchemaRegistryMode, error) {
+	res, err := s.kafkaSvc.SchemaService.DeleteSubjectMode(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaRegistryMode{Mode: res.Mode}, nil
+}
+
+// GetSchemaRegistryContexts returns the names of all schema registry contexts, sorted
+// by name. The default context is always included and presented as ".".
+func (s *Service) GetSchemaRegistryContexts(ctx context.Context) ([]string, error) {
+	contexts, err := s.kafkaSvc.SchemaService.GetContexts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(contexts)+1)
+	result = append(result, schema.DefaultContext)
+	for _, c := range contexts {
+		c = schema.NormalizeContextName(c)
+		if !slices.Contains(result, c) {
+			result = append(result, c)
+		}
+	}
+	slices.Sort(result)
+
+	return result, nil
+}
+
 // GetSchemaRegistryConfig returns the schema registry config which currently
 // only contains the global compatibility config (e.g. "BACKWARD").
 func (s *Service) GetSchemaRegistryConfig(ctx context.Context) (*SchemaRegistryConfig, error) {
@@ -89,12 +148,34 @@ func (s *Service) DeleteSchemaRegistrySubjectConfig(ctx context.Context, subject
 // GetSchemaRegistrySubjects returns a list of all register subjects. The list includes
 // soft-deleted subjects.
 func (s *Service) GetSchemaRegistrySubjects(ctx context.Context) ([]SchemaRegistrySubject, error) {
+	return s.getSchemaRegistrySubjects(ctx, "")
+}
+
+// GetSchemaRegistryContextSubjects returns a list of all subjects that are registered within
+// the given context. Subject names are context-qualified (e.g. ":.staging:orders-value") unless
+// they belong to the default context. The list includes soft-deleted subjects.
+func (s *Service) GetSchemaRegistryContextSubjects(ctx context.Context, schemaContext string) ([]SchemaRegistrySubject, error) {
+	schemaContext = schema.NormalizeContextName(schemaContext)
+	subjects, err := s.getSchemaRegistrySubjects(ctx, schema.ContextSubjectPrefix(schemaContext))
+	if err != nil {
+		return nil, err
+	}
+
+	// Depending on the schema registry implementation, subjects of other contexts may be
+	// returned when no prefix is set, hence we have to filter them ourselves.
+	return slices.DeleteFunc(subjects, func(subject SchemaRegistrySubject) bool {
+		subjectContext, _ := schema.ParseQualifiedSubject(subject.Name)
+		return subjectContext != schemaContext
+	}), nil
+}
+
+func (s *Service) getSchemaRegistrySubjects(ctx context.Context, subjectPrefix string) ([]SchemaRegistrySubject, error) {
 	subjects := make(map[string]struct{})
 	subjectsWithDeleted := make(map[string]struct{})
 
 	grp, _ := errgroup.WithContext(ctx)
 	grp.Go(func() error {
-		res, err := s.kafkaSvc.SchemaService.GetSubjects(ctx, false)
+		res, err := s.kafkaSvc.SchemaService.GetSubjectsByPrefix(ctx, subjectPrefix, false)
 		if err != nil {
 			return err
 		}
@@ -104,7 +185,7 @@ func (s *Service) GetSchemaRegistrySubjects(ctx context.Context) ([]SchemaRegist
 		return nil
 	})
 	grp.Go(func() error {
-		res, err := s.kafkaSvc.SchemaService.GetSubjects(ctx, true)
+		res, err := s.kafkaSvc.SchemaService.GetSubjectsByPrefix(ctx, subjectPrefix, true)
 		if err != nil {
 			return err
 		}
@@ -341,6 +422,8 @@ type SchemaRegistryVersionedSchema struct {
 	Type          schema.SchemaType `json:"type"`
 	Schema        string            `json:"schema"`
 	References    []Reference       `json:"references"`
+	Metadata      *schema.Metadata  `json:"metadata,omitempty"`
+	RuleSet       *schema.RuleSet   `json:"ruleSet,omitempty"`
 }
 
 // Reference describes a reference to a different schema stored in the schema registry.
@@ -373,6 +456,8 @@ func (s *Service) GetSchemaRegistrySchema(ctx context.Context, subjectName, vers
 		Type:       latestSchema.Type,
 		Schema:     latestSchema.Schema,
 		References: references,
+		Metadata:   latestSchema.Metadata,
+		RuleSet:    latestSchema.RuleSet,
 	}, nil
 }
 
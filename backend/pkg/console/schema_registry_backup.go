@@ -0,0 +1,28 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+
+	"github.com/redpanda-data/console/backend/pkg/schema"
+)
+
+// ExportSchemaRegistryBackup creates a portable backup of all subjects, schema versions and
+// compatibility levels of the schema registry.
+func (s *Service) ExportSchemaRegistryBackup(ctx context.Context) (*schema.Backup, error) {
+	return s.kafkaSvc.SchemaService.ExportBackup(ctx)
+}
+
+// RestoreSchemaRegistryBackup imports a backup into the schema registry while preserving all
+// schema IDs and versions. Use a dry run to retrieve the restore steps and conflicts only.
+func (s *Service) RestoreSchemaRegistryBackup(ctx context.Context, backup *schema.Backup, opts schema.RestoreOptions) (*schema.RestoreReport, error) {
+	return s.kafkaSvc.SchemaService.RestoreBackup(ctx, backup, opts)
+}
@@ -0,0 +1,235 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/redpanda-data/console/backend/pkg/schema"
+)
+
+// SchemaRegistrySchemaDiff is the response to comparing schemas of a subject. It contains
+// one comparison per existing schema version that had to be checked according to
+// the compatibility level.
+type SchemaRegistrySchemaDiff struct {
+	Subject       string                               `json:"subject"`
+	Compatibility schema.CompatibilityLevel            `json:"compatibility"`
+	IsCompatible  bool                                 `json:"isCompatible"`
+	Comparisons   []SchemaRegistrySchemaDiffComparison `json:"comparisons"`
+}
+
+// SchemaRegistrySchemaDiffComparison is the diff between an existing schema version
+// (old) and either another existing version or a candidate schema (new).
+type SchemaRegistrySchemaDiffComparison struct {
+	OldVersion int `json:"oldVersion"`
+	// NewVersion is not set if a candidate schema that is not yet registered has been compared.
+	NewVersion int                                    `json:"newVersion,omitempty"`
+	Changes    []schema.SchemaChange                  `json:"changes"`
+	Violations []SchemaRegistryCompatibilityViolation `json:"violations"`
+}
+
+// SchemaRegistryCompatibilityViolation explains why a change breaks the subject's compatibility level.
+type SchemaRegistryCompatibilityViolation struct {
+	Path        string            `json:"path"`
+	Kind        schema.ChangeKind `json:"kind"`
+	Explanation string            `json:"explanation"`
+}
+
+// DiffSchemaRegistrySchema compares a candidate schema against the given version of the subject.
+// If the effective compatibility level is transitive, the candidate is compared against all
+// active versions instead. If compatLevel is not set, the subject's compatibility level is used.
+func (s *Service) DiffSchemaRegistrySchema(
+	ctx context.Context,
+	subjectName string,
+	version string,
+	candidate schema.Schema,
+	compatLevel schema.CompatibilityLevel,
+) (*SchemaRegistrySchemaDiff, error) {
+	compatLevel, err := s.getEffectiveSchemaRegistryCompatibility(ctx, subjectName, compatLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	versionsToCompare := []string{version}
+	if compatLevel.IsTransitive() {
+		versions, err := s.getSchemaRegistrySchemaVersions(ctx, subjectName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve subject versions: %w", err)
+		}
+		versionsToCompare = make([]string, 0, len(versions))
+		for _, v := range versions {
+			if v.IsSoftDeleted {
+				continue
+			}
+			versionsToCompare = append(versionsToCompare, strconv.Itoa(v.Version))
+		}
+	}
+
+	comparisons := make([]SchemaRegistrySchemaDiffComparison, len(versionsToCompare))
+	grp, grpCtx := errgroup.WithContext(ctx)
+	grp.SetLimit(10)
+	for i, v := range versionsToCompare {
+		i, v := i, v
+		grp.Go(func() error {
+			oldSchema, err := s.kafkaSvc.SchemaService.GetSchemaBySubject(grpCtx, subjectName, v, false)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve version %q: %w", v, err)
+			}
+			comparison, err := s.compareSchemaRegistrySchemas(grpCtx, subjectName, oldSchema, candidate, compatLevel)
+			if err != nil {
+				return fmt.Errorf("failed to compare with version %d: %w", oldSchema.Version, err)
+			}
+			comparisons[i] = *comparison
+			return nil
+		})
+	}
+	if err := grp.Wait(); err != nil {
+		return nil, err
+	}
+
+	return newSchemaRegistrySchemaDiff(subjectName, compatLevel, comparisons), nil
+}
+
+// DiffSchemaRegistrySubjectVersions compares two registered versions of the same subject. If
+// oldVersion is empty, the closest active version prior to newVersion is used.
+func (s *Service) DiffSchemaRegistrySubjectVersions(
+	ctx context.Context,
+	subjectName string,
+	oldVersion string,
+	newVersion string,
+	compatLevel schema.CompatibilityLevel,
+) (*SchemaRegistrySchemaDiff, error) {
+	compatLevel, err := s.getEffectiveSchemaRegistryCompatibility(ctx, subjectName, compatLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	newSchema, err := s.kafkaSvc.SchemaService.GetSchemaBySubject(ctx, subjectName, newVersion, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve version %q: %w", newVersion, err)
+	}
+
+	if oldVersion == "" {
+		versions, err := s.getSchemaRegistrySchemaVersions(ctx, subjectName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve subject versions: %w", err)
+		}
+		idx := slices.IndexFunc(versions, func(v SchemaRegistrySubjectDetailsVersion) bool {
+			return v.Version == newSchema.Version
+		})
+		for i := idx - 1; i >= 0; i-- {
+			if !versions[i].IsSoftDeleted {
+				oldVersion = strconv.Itoa(versions[i].Version)
+				break
+			}
+		}
+		if oldVersion == "" {
+			return nil, fmt.Errorf("version %d has no previous version to compare with", newSchema.Version)
+		}
+	}
+
+	oldSchema, err := s.kafkaSvc.SchemaService.GetSchemaBySubject(ctx, subjectName, oldVersion, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve version %q: %w", oldVersion, err)
+	}
+
+	comparison, err := s.compareSchemaRegistrySchemas(ctx, subjectName, oldSchema, schema.Schema{
+		Schema:     newSchema.Schema,
+		Type:       newSchema.Type,
+		References: newSchema.References,
+	}, compatLevel)
+	if err != nil {
+		return nil, err
+	}
+	comparison.NewVersion = newSchema.Version
+
+	return newSchemaRegistrySchemaDiff(subjectName, compatLevel, []SchemaRegistrySchemaDiffComparison{*comparison}), nil
+}
+
+func (s *Service) compareSchemaRegistrySchemas(
+	ctx context.Context,
+	subjectName string,
+	oldSchema *schema.SchemaVersionedResponse,
+	newSchema schema.Schema,
+	compatLevel schema.CompatibilityLevel,
+) (*SchemaRegistrySchemaDiffComparison, error) {
+	diff, err := s.kafkaSvc.SchemaService.DiffSchemas(ctx, subjectName, schema.Schema{
+		Schema:     oldSchema.Schema,
+		Type:       oldSchema.Type,
+		References: oldSchema.References,
+	}, newSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := make([]SchemaRegistryCompatibilityViolation, 0)
+	for _, change := range diff.Violations(compatLevel) {
+		violations = append(violations, SchemaRegistryCompatibilityViolation{
+			Path:        change.Path,
+			Kind:        change.Kind,
+			Explanation: change.Explain(compatLevel),
+		})
+	}
+
+	return &SchemaRegistrySchemaDiffComparison{
+		OldVersion: oldSchema.Version,
+		Changes:    diff.Changes,
+		Violations: violations,
+	}, nil
+}
+
+// getEffectiveSchemaRegistryCompatibility returns the compatibility level that applies to the
+// given subject. The subject level takes precedence over the global compatibility level. If
+// an explicit level is requested it will be returned as is.
+func (s *Service) getEffectiveSchemaRegistryCompatibility(ctx context.Context, subjectName string, requested schema.CompatibilityLevel) (schema.CompatibilityLevel, error) {
+	if requested != 0 && requested != schema.CompatDefault {
+		return requested, nil
+	}
+
+	subjectConfig, err := s.kafkaSvc.SchemaService.GetSubjectConfig(ctx, subjectName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve subject compatibility: %w", err)
+	}
+	if subjectConfig.Compatibility != schema.CompatDefault {
+		return subjectConfig.Compatibility, nil
+	}
+
+	globalConfig, err := s.kafkaSvc.SchemaService.GetConfig(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve global compatibility: %w", err)
+	}
+	return globalConfig.Compatibility, nil
+}
+
+func newSchemaRegistrySchemaDiff(subjectName string, compatLevel schema.CompatibilityLevel, comparisons []SchemaRegistrySchemaDiffComparison) *SchemaRegistrySchemaDiff {
+	slices.SortFunc(comparisons, func(a, b SchemaRegistrySchemaDiffComparison) int {
+		return a.OldVersion - b.OldVersion
+	})
+
+	isCompatible := true
+	for _, c := range comparisons {
+		if len(c.Violations) > 0 {
+			isCompatible = false
+			break
+		}
+	}
+
+	return &SchemaRegistrySchemaDiff{
+		Subject:       subjectName,
+		Compatibility: compatLevel,
+		IsCompatible:  isCompatible,
+		Comparisons:   comparisons,
+	}
+}
@@ -0,0 +1,436 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kerr"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/schema"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+// SchemaUsageReport describes which schema IDs have been found in the most recent
+// records of each topic.
+type SchemaUsageReport struct {
+	GeneratedAt         time.Time `json:"generatedAt"`
+	ElapsedMs           int64     `json:"elapsedMs"`
+	RecordsPerPartition int       `json:"recordsPerPartition"`
+
+	Topics []SchemaUsageTopic `json:"topics"`
+	// UnusedSubjects are active subjects none of whose schema IDs have been found
+	// in any of the sampled records.
+	UnusedSubjects []string `json:"unusedSubjects"`
+	// Errors contains all topics that could not be sampled.
+	Errors []SchemaUsageTopicError `json:"errors"`
+}
+
+// SchemaUsageTopic contains the schema IDs found in the sampled records of a topic.
+type SchemaUsageTopic struct {
+	TopicName      string              `json:"topicName"`
+	SampledRecords int                 `json:"sampledRecords"`
+	KeySchemas     []SchemaUsageSchema `json:"keySchemas"`
+	ValueSchemas   []SchemaUsageSchema `json:"valueSchemas"`
+
+	// UsesDeprecatedSchema is true if records are produced with a schema that has
+	// been superseded by a newer version in all subjects it is registered in.
+	UsesDeprecatedSchema bool `json:"usesDeprecatedSchema"`
+	// UsesSoftDeletedSchema is true if records are produced with a schema that is
+	// only registered in soft-deleted subject versions.
+	UsesSoftDeletedSchema bool `json:"usesSoftDeletedSchema"`
+}
+
+// SchemaUsageSchema is a schema ID that has been found in the sampled records.
+type SchemaUsageSchema struct {
+	SchemaID    uint32                     `json:"schemaId"`
+	RecordCount int                        `json:"recordCount"`
+	Versions    []SchemaUsageSchemaVersion `json:"versions"`
+
+	// IsUnknown is true if the schema ID is not registered in the schema registry.
+	IsUnknown     bool `json:"isUnknown"`
+	IsDeprecated  bool `json:"isDeprecated"`
+	IsSoftDeleted bool `json:"isSoftDeleted"`
+}
+
+// SchemaUsageSchemaVersion is a subject version that uses the found schema ID.
+type SchemaUsageSchemaVersion struct {
+	Subject       string `json:"subject"`
+	Version       int    `json:"version"`
+	IsLatest      bool   `json:"isLatest"`
+	IsSoftDeleted bool   `json:"isSoftDeleted"`
+}
+
+// SchemaUsageTopicError describes why a topic could not be sampled.
+type SchemaUsageTopicError struct {
+	TopicName string `json:"topicName"`
+	Message   string `json:"message"`
+}
+
+// schemaUsageCache holds the most recently generated schema usage report.
+type schemaUsageCache struct {
+	mutex  sync.RWMutex
+	report *SchemaUsageReport
+	group  singleflight.Group
+}
+
+// GetSchemaUsageReport returns the cached schema usage report. A new report is generated if
+// none has been generated yet or if forceRefresh is set.
+func (s *Service) GetSchemaUsageReport(ctx context.Context, forceRefresh bool) (*SchemaUsageReport, error) {
+	if !forceRefresh {
+		s.schemaUsage.mutex.RLock()
+		report := s.schemaUsage.report
+		s.schemaUsage.mutex.RUnlock()
+		if report != nil {
+			return report, nil
+		}
+	}
+
+	return s.refreshSchemaUsageReport(ctx)
+}
+
+// refreshSchemaUsageReport generates a new schema usage report and caches it. Concurrent
+// calls share the same report.
+func (s *Service) refreshSchemaUsageReport(ctx context.Context) (*SchemaUsageReport, error) {
+	res, err, _ := s.schemaUsage.group.Do("refresh", func() (any, error) {
+		report, err := s.createSchemaUsageReport(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.schemaUsage.mutex.Lock()
+		s.schemaUsage.report = report
+		s.schemaUsage.mutex.Unlock()
+		return report, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*SchemaUsageReport), nil
+}
+
+// refreshSchemaUsagePeriodically refreshes the schema usage report until the context is done.
+func (s *Service) refreshSchemaUsagePeriodically(ctx context.Context) {
+	interval := s.kafkaSvc.Config.Console.SchemaUsage.RefreshInterval
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.refreshSchemaUsageReport(ctx); err != nil {
+			s.logger.Warn("failed to refresh schema usage report", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) createSchemaUsageReport(ctx context.Context) (*SchemaUsageReport, error) {
+	if s.kafkaSvc.SchemaService == nil {
+		return nil, errors.New("schema registry is not configured")
+	}
+	start := time.Now()
+	cfg := s.kafkaSvc.Config.Console.SchemaUsage
+
+	// 1. Retrieve all registered schemas, so that we can resolve the schema IDs
+	activeSchemas, err := s.kafkaSvc.SchemaService.GetSchemas(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve schemas: %w", err)
+	}
+	allSchemas, err := s.kafkaSvc.SchemaService.GetSchemas(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve soft-deleted schemas: %w", err)
+	}
+	index := newSchemaUsageIndex(activeSchemas, allSchemas)
+
+	// 2. Get all partitions along with their watermarks
+	metadata, err := s.kafkaSvc.GetMetadataTopics(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve topics: %w", err)
+	}
+	topicPartitions := make(map[string][]int32)
+	for _, topic := range metadata.Topics {
+		if topic.IsInternal || kerr.ErrorForCode(topic.ErrorCode) != nil {
+			continue
+		}
+		partitionIDs := make([]int32, 0, len(topic.Partitions))
+		for _, partition := range topic.Partitions {
+			if kerr.ErrorForCode(partition.ErrorCode) == nil {
+				partitionIDs = append(partitionIDs, partition.Partition)
+			}
+		}
+		topicPartitions[*topic.Topic] = partitionIDs
+	}
+	marks, err := s.kafkaSvc.GetPartitionMarksBulk(ctx, topicPartitions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve partition watermarks: %w", err)
+	}
+
+	// 3. Sample the most recent records of each topic
+	report := &SchemaUsageReport{
+		RecordsPerPartition: cfg.RecordsPerPartition,
+		Topics:              make([]SchemaUsageTopic, 0, len(marks)),
+		Errors:              make([]SchemaUsageTopicError, 0),
+	}
+	mutex := sync.Mutex{}
+	grp, grpCtx := errgroup.WithContext(ctx)
+	grp.SetLimit(cfg.TopicConcurrency)
+	for topicName, partitionMarks := range marks {
+		topicName, partitionMarks := topicName, partitionMarks
+		grp.Go(func() error {
+			topicCtx, cancel := context.WithTimeout(grpCtx, cfg.TopicTimeout)
+			defer cancel()
+
+			progress, err := s.sampleSchemaUsage(topicCtx, topicName, partitionMarks, cfg.RecordsPerPartition)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				report.Errors = append(report.Errors, SchemaUsageTopicError{TopicName: topicName, Message: err.Error()})
+				return nil
+			}
+			report.Topics = append(report.Topics, index.topicUsage(topicName, progress))
+			return nil
+		})
+	}
+	_ = grp.Wait() // Errors are collected per topic
+
+	// 4. Find subjects without traffic
+	seenSchemaIDs := make(map[uint32]struct{})
+	for _, topic := range report.Topics {
+		for _, sch := range topic.KeySchemas {
+			seenSchemaIDs[sch.SchemaID] = struct{}{}
+		}
+		for _, sch := range topic.ValueSchemas {
+			seenSchemaIDs[sch.SchemaID] = struct{}{}
+		}
+	}
+	report.UnusedSubjects = index.unusedSubjects(seenSchemaIDs)
+
+	sort.Slice(report.Topics, func(i, j int) bool { return report.Topics[i].TopicName < report.Topics[j].TopicName })
+	sort.Slice(report.Errors, func(i, j int) bool { return report.Errors[i].TopicName < report.Errors[j].TopicName })
+	report.GeneratedAt = time.Now()
+	report.ElapsedMs = time.Since(start).Milliseconds()
+
+	return report, nil
+}
+
+// sampleSchemaUsage consumes up to recordsPerPartition of the most recent records from each partition and
+// counts the schema IDs found in the record keys and values.
+func (s *Service) sampleSchemaUsage(ctx context.Context, topicName string, marks map[int32]*kafka.PartitionMarks, recordsPerPartition int) (*schemaUsageProgress, error) {
+	progress := &schemaUsageProgress{
+		keySchemaIDs:   make(map[uint32]int),
+		valueSchemaIDs: make(map[uint32]int),
+	}
+
+	partitions := make(map[int32]*kafka.PartitionConsumeRequest)
+	totalCount := 0
+	for _, mark := range marks {
+		if mark.Error != nil {
+			return nil, fmt.Errorf("failed to get watermarks of partition %d: %w", mark.PartitionID, mark.Error)
+		}
+		if mark.High <= mark.Low {
+			continue
+		}
+		startOffset := max(mark.Low, mark.High-int64(recordsPerPartition))
+		partitions[mark.PartitionID] = &kafka.PartitionConsumeRequest{
+			PartitionID:     mark.PartitionID,
+			LowWaterMark:    mark.Low,
+			HighWaterMark:   mark.High,
+			StartOffset:     startOffset,
+			EndOffset:       mark.High - 1,
+			MaxMessageCount: mark.High - startOffset,
+		}
+		totalCount += int(mark.High - startOffset)
+	}
+	if len(partitions) == 0 {
+		return progress, nil
+	}
+
+	// We only need the raw payloads to extract the schema IDs, hence we skip the
+	// deserialization by requesting the binary encoding.
+	err := s.kafkaSvc.FetchMessages(ctx, progress, kafka.TopicConsumeRequest{
+		TopicName:          topicName,
+		MaxMessageCount:    totalCount,
+		Partitions:         partitions,
+		IncludeRawPayload:  true,
+		IgnoreMaxSizeLimit: true,
+		KeyDeserializer:    serde.PayloadEncodingBinary,
+		ValueDeserializer:  serde.PayloadEncodingBinary,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if progress.errMessage != "" {
+		return nil, fmt.Errorf("%s", progress.errMessage)
+	}
+
+	return progress, nil
+}
+
+// schemaUsageProgress implements kafka.IListMessagesProgress and counts the schema IDs
+// of all consumed records.
+type schemaUsageProgress struct {
+	records        int
+	keySchemaIDs   map[uint32]int
+	valueSchemaIDs map[uint32]int
+	errMessage     string
+}
+
+func (*schemaUsageProgress) OnPhase(string) {}
+
+func (p *schemaUsageProgress) OnMessage(msg *kafka.TopicMessage) {
+	p.records++
+	if id, ok := schemaIDFromPayload(msg.Key); ok {
+		p.keySchemaIDs[id]++
+	}
+	if id, ok := schemaIDFromPayload(msg.Value); ok {
+		p.valueSchemaIDs[id]++
+	}
+}
+
+func (*schemaUsageProgress) OnMessageConsumed(int64) {}
+
+func (*schemaUsageProgress) OnComplete(int64, bool) {}
+
+func (p *schemaUsageProgress) OnError(msg string) {
+	p.errMessage = msg
+}
+
+// schemaIDFromPayload extracts the schema ID from the Confluent wire format header,
+// which is a zero magic byte followed by the big-endian schema ID.
+func schemaIDFromPayload(payload *serde.RecordPayload) (uint32, bool) {
+	if payload == nil || len(payload.OriginalPayload) < 5 || payload.OriginalPayload[0] != 0 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(payload.OriginalPayload[1:5]), true
+}
+
+// schemaUsageIndex resolves schema IDs to the subject versions that use them.
+type schemaUsageIndex struct {
+	versionsByID           map[uint32][]SchemaUsageSchemaVersion
+	activeSubjectIDs       map[string][]uint32
+	latestVersionBySubject map[string]int
+}
+
+func newSchemaUsageIndex(activeSchemas, allSchemas []*schema.SchemaVersionedResponse) *schemaUsageIndex {
+	idx := &schemaUsageIndex{
+		versionsByID:           make(map[uint32][]SchemaUsageSchemaVersion),
+		activeSubjectIDs:       make(map[string][]uint32),
+		latestVersionBySubject: make(map[string]int),
+	}
+
+	active := make(map[string]struct{}, len(activeSchemas))
+	for _, sch := range activeSchemas {
+		active[fmt.Sprintf("%s/%d", sch.Subject, sch.Version)] = struct{}{}
+		if sch.Version > idx.latestVersionBySubject[sch.Subject] {
+			idx.latestVersionBySubject[sch.Subject] = sch.Version
+		}
+		id := uint32(sch.SchemaID)
+		idx.activeSubjectIDs[sch.Subject] = append(idx.activeSubjectIDs[sch.Subject], id)
+	}
+
+	for _, sch := range allSchemas {
+		_, isActive := active[fmt.Sprintf("%s/%d", sch.Subject, sch.Version)]
+		id := uint32(sch.SchemaID)
+		idx.versionsByID[id] = append(idx.versionsByID[id], SchemaUsageSchemaVersion{
+			Subject:       sch.Subject,
+			Version:       sch.Version,
+			IsLatest:      isActive && idx.latestVersionBySubject[sch.Subject] == sch.Version,
+			IsSoftDeleted: !isActive,
+		})
+	}
+	for id := range idx.versionsByID {
+		versions := idx.versionsByID[id]
+		sort.Slice(versions, func(i, j int) bool {
+			if versions[i].Subject != versions[j].Subject {
+				return versions[i].Subject < versions[j].Subject
+			}
+			return versions[i].Version < versions[j].Version
+		})
+	}
+
+	return idx
+}
+
+func (idx *schemaUsageIndex) topicUsage(topicName string, progress *schemaUsageProgress) SchemaUsageTopic {
+	topic := SchemaUsageTopic{
+		TopicName:      topicName,
+		SampledRecords: progress.records,
+		KeySchemas:     idx.schemaUsages(progress.keySchemaIDs),
+		ValueSchemas:   idx.schemaUsages(progress.valueSchemaIDs),
+	}
+	for _, schemas := range [][]SchemaUsageSchema{topic.KeySchemas, topic.ValueSchemas} {
+		for _, sch := range schemas {
+			topic.UsesDeprecatedSchema = topic.UsesDeprecatedSchema || sch.IsDeprecated
+			topic.UsesSoftDeletedSchema = topic.UsesSoftDeletedSchema || sch.IsSoftDeleted
+		}
+	}
+	return topic
+}
+
+func (idx *schemaUsageIndex) schemaUsages(recordCountByID map[uint32]int) []SchemaUsageSchema {
+	usages := make([]SchemaUsageSchema, 0, len(recordCountByID))
+	for id, count := range recordCountByID {
+		versions, exists := idx.versionsByID[id]
+		usage := SchemaUsageSchema{
+			SchemaID:    id,
+			RecordCount: count,
+			Versions:    versions,
+			IsUnknown:   !exists,
+		}
+		if usage.Versions == nil {
+			usage.Versions = make([]SchemaUsageSchemaVersion, 0)
+		}
+
+		if exists {
+			hasActive, hasLatest := false, false
+			for _, v := range versions {
+				hasActive = hasActive || !v.IsSoftDeleted
+				hasLatest = hasLatest || v.IsLatest
+			}
+			usage.IsSoftDeleted = !hasActive
+			usage.IsDeprecated = hasActive && !hasLatest
+		}
+		usages = append(usages, usage)
+	}
+	sort.Slice(usages, func(i, j int) bool { return usages[i].SchemaID < usages[j].SchemaID })
+	return usages
+}
+
+func (idx *schemaUsageIndex) unusedSubjects(seenSchemaIDs map[uint32]struct{}) []string {
+	unused := make([]string, 0)
+	for subject, ids := range idx.activeSubjectIDs {
+		isUsed := false
+		for _, id := range ids {
+			if _, seen := seenSchemaIDs[id]; seen {
+				isUsed = true
+				break
+			}
+		}
+		if !isUsed {
+			unused = append(unused, subject)
+		}
+	}
+	sort.Strings(unused)
+	return unused
+}
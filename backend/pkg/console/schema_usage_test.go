@@ -0,0 +1,96 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/schema"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+func TestSchemaIDFromPayload(t *testing.T) {
+	tests := []struct {
+		name       string
+		payload    *serde.RecordPayload
+		expectedID uint32
+		expectedOk bool
+	}{
+		{
+			name:    "nil payload",
+			payload: nil,
+		},
+		{
+			name:    "payload too short",
+			payload: &serde.RecordPayload{OriginalPayload: []byte{0, 0, 0}},
+		},
+		{
+			name:    "wrong magic byte",
+			payload: &serde.RecordPayload{OriginalPayload: []byte(`{"a":1}`)},
+		},
+		{
+			name:       "wire format header",
+			payload:    &serde.RecordPayload{OriginalPayload: []byte{0, 0, 0, 1, 2, 'x'}},
+			expectedID: 258,
+			expectedOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := schemaIDFromPayload(tt.payload)
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expectedID, id)
+		})
+	}
+}
+
+func TestSchemaUsageIndex(t *testing.T) {
+	active := []*schema.SchemaVersionedResponse{
+		{Subject: "orders-value", Version: 1, SchemaID: 1},
+		{Subject: "orders-value", Version: 2, SchemaID: 2},
+		{Subject: "payments-value", Version: 1, SchemaID: 3},
+	}
+	all := append([]*schema.SchemaVersionedResponse{
+		{Subject: "legacy-value", Version: 1, SchemaID: 4},
+	}, active...)
+	idx := newSchemaUsageIndex(active, all)
+
+	progress := &schemaUsageProgress{
+		records:        6,
+		keySchemaIDs:   map[uint32]int{},
+		valueSchemaIDs: map[uint32]int{1: 2, 2: 1, 4: 2, 99: 1},
+	}
+	usage := idx.topicUsage("orders", progress)
+
+	assert.Equal(t, "orders", usage.TopicName)
+	assert.Equal(t, 6, usage.SampledRecords)
+	assert.Empty(t, usage.KeySchemas)
+	assert.True(t, usage.UsesDeprecatedSchema)
+	assert.True(t, usage.UsesSoftDeletedSchema)
+
+	require.Len(t, usage.ValueSchemas, 4)
+	byID := make(map[uint32]SchemaUsageSchema)
+	for _, sch := range usage.ValueSchemas {
+		byID[sch.SchemaID] = sch
+	}
+	assert.True(t, byID[1].IsDeprecated)
+	assert.False(t, byID[2].IsDeprecated)
+	assert.Equal(t, 1, byID[2].RecordCount)
+	assert.True(t, byID[4].IsSoftDeleted)
+	assert.True(t, byID[99].IsUnknown)
+	assert.Empty(t, byID[99].Versions)
+
+	unused := idx.unusedSubjects(map[uint32]struct{}{1: {}, 2: {}, 4: {}})
+	assert.Equal(t, []string{"payments-value"}, unused)
+}
@@ -12,14 +12,17 @@ package console
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/redpanda-data/console/backend/pkg/config"
 	"github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
 	"github.com/redpanda-data/console/backend/pkg/git"
 	"github.com/redpanda-data/console/backend/pkg/kafka"
 	"github.com/redpanda-data/console/backend/pkg/redpanda"
+	"github.com/redpanda-data/console/backend/pkg/topicdocs"
 )
 
 // Service offers all methods to serve the responses for the REST API. This usually only involves fetching
@@ -27,16 +30,43 @@ import (
 type Service struct {
 	kafkaSvc    *kafka.Service
 	redpandaSvc *redpanda.Service
-	gitSvc      *git.Service // Git service can be nil if not configured
+	gitSvc      *git.Group          // Git service can be nil if not configured
+	docsFsSvc   *filesystem.Service // Filesystem service for topic documentation can be nil if not configured
 	connectSvc  *connect.Service
 	logger      *zap.Logger
 
+	// connectHistory records connector config changes. It is nil if the
+	// connector config history is not enabled.
+	connectHistory *connect.KafkaHistoryStore
+	// connectLoggerReverts persists pending logger level reverts. It is nil if
+	// pending reverts are held in memory only.
+	connectLoggerReverts *connect.KafkaLoggerRevertStore
+
+	// mirrorReplications are the configured MirrorMaker 2 replications by name.
+	// It is nil if MirrorMaker 2 replications are not enabled.
+	mirrorReplications map[string]*mirrorReplication
+
+	// topicDocSources are the configured sources for topic documentation in the
+	// order they are queried.
+	topicDocSources []topicDocumentationSource
+	topicDocEditing config.TopicDocumentationEditing
+
 	// configExtensionsByName contains additional metadata about Topic or BrokerWithLogDirs configs.
 	// The additional information is used by the frontend to provide a good UX when
 	// editing configs or creating new topics.
 	configExtensionsByName map[string]ConfigEntryExtension
+
+	// schemaUsage caches the most recent schema usage report.
+	schemaUsage schemaUsageCache
+
+	// stopBackgroundTasks cancels all go routines that have been started in Start().
+	stopBackgroundTasks context.CancelFunc
 }
 
+// connectHistoryStartTimeout is the maximum time to wait for the connector config
+// history and the pending logger level reverts to be loaded on start.
+const connectHistoryStartTimeout = time.Minute
+
 // NewService for the Console package
 func NewService(
 	cfg *config.Config,
@@ -44,16 +74,28 @@ func NewService(
 	redpandaSvc *redpanda.Service,
 	connectSvc *connect.Service,
 ) (Servicer, error) {
-	var gitSvc *git.Service
+	var gitSvc *git.Group
 	cfg.Console.TopicDocumentation.Git.AllowedFileExtensions = []string{"md"}
-	if cfg.Console.TopicDocumentation.Enabled && cfg.Console.TopicDocumentation.Git.Enabled {
-		svc, err := git.NewService(cfg.Console.TopicDocumentation.Git, logger, nil)
+	gitSources := cfg.Console.TopicDocumentation.GitSources()
+	if cfg.Console.TopicDocumentation.Enabled && len(gitSources) > 0 {
+		svc, err := git.NewGroup(gitSources, logger, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create git service: %w", err)
 		}
 		gitSvc = svc
 	}
 
+	var docsFsSvc *filesystem.Service
+	if cfg.Console.TopicDocumentation.Enabled && cfg.Console.TopicDocumentation.FileSystem.Enabled {
+		fsCfg := cfg.Console.TopicDocumentation.FileSystem
+		fsCfg.AllowedFileExtensions = []string{"md"}
+		svc, err := filesystem.NewService(fsCfg, logger, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create filesystem service: %w", err)
+		}
+		docsFsSvc = svc
+	}
+
 	configExtensionsByName, err := loadConfigExtensions()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config extensions: %w", err)
@@ -64,15 +106,86 @@ func NewService(
 		return nil, fmt.Errorf("failed to create kafka svc: %w", err)
 	}
 
-	return &Service{
+	svc := &Service{
 		kafkaSvc:    kafkaSvc,
 		redpandaSvc: redpandaSvc,
 		gitSvc:      gitSvc,
+		docsFsSvc:   docsFsSvc,
 		connectSvc:  connectSvc,
 		logger:      logger,
 
 		configExtensionsByName: configExtensionsByName,
-	}, nil
+	}
+	if cfg.Connect.Enabled && cfg.Connect.History.Enabled && connectSvc != nil {
+		kgoOpts, err := kafka.NewKgoConfig(&cfg.Kafka, logger, kafkaSvc.KafkaClientHooks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kafka client config for connect history: %w", err)
+		}
+		store, err := connect.NewKafkaHistoryStore(cfg.Connect.History, logger, kgoOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create connect history store: %w", err)
+		}
+		svc.connectHistory = store
+		connectSvc.History = store
+	}
+	if cfg.Connect.Enabled && cfg.Connect.LoggerReverts.Enabled && connectSvc != nil {
+		kgoOpts, err := kafka.NewKgoConfig(&cfg.Kafka, logger, kafkaSvc.KafkaClientHooks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kafka client config for connect logger reverts: %w", err)
+		}
+		store, err := connect.NewKafkaLoggerRevertStore(cfg.Connect.LoggerReverts, logger, kgoOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create connect logger revert store: %w", err)
+		}
+		svc.connectLoggerReverts = store
+		connectSvc.LoggerReverts = store
+	}
+	if cfg.Connect.Enabled && cfg.Connect.MirrorMaker2.Enabled && connectSvc != nil {
+		replications, err := newMirrorReplications(cfg.Connect.MirrorMaker2, logger, kafkaSvc.KafkaClientHooks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create mirror maker 2 replications: %w", err)
+		}
+		svc.mirrorReplications = replications
+	}
+	if cfg.Console.TopicDocumentation.Enabled {
+		svc.topicDocEditing = cfg.Console.TopicDocumentation.Editing
+		svc.topicDocSources = svc.newTopicDocumentationSources(cfg.Console.TopicDocumentation)
+	}
+
+	return svc, nil
+}
+
+// newTopicDocumentationSources creates all configured topic documentation sources.
+// Local sources are queried first, remote sources are cached.
+func (s *Service) newTopicDocumentationSources(cfg config.ConsoleTopicDocumentation) []topicDocumentationSource {
+	sources := make([]topicDocumentationSource, 0)
+	if s.gitSvc != nil {
+		sources = append(sources, &gitTopicDocumentationSource{svc: s.gitSvc})
+	}
+	if s.docsFsSvc != nil {
+		sources = append(sources, newFilesystemTopicDocumentationSource(s.docsFsSvc))
+	}
+	if cfg.TopicConfig.Enabled {
+		sources = append(sources, newCachedTopicDocumentationSource(&topicConfigTopicDocumentationSource{
+			configKey:       cfg.TopicConfig.ConfigKey,
+			describeConfigs: s.GetTopicsConfigs,
+		}, cfg.CacheTTL))
+	}
+	if cfg.SchemaRegistry.Enabled {
+		if s.kafkaSvc.SchemaService != nil {
+			sources = append(sources, newCachedTopicDocumentationSource(&schemaRegistryTopicDocumentationSource{
+				schemaSvc: s.kafkaSvc.SchemaService,
+			}, cfg.CacheTTL))
+		} else {
+			s.logger.Warn("schema registry source for topic documentation is enabled, but schema registry is not configured")
+		}
+	}
+	if cfg.HTTP.Enabled {
+		sources = append(sources, newCachedTopicDocumentationSource(&catalogTopicDocumentationSource{
+			client: topicdocs.NewHTTPCatalogClient(cfg.HTTP),
+		}, cfg.CacheTTL))
+	}
+	return sources
 }
 
 // Start starts all the (background) tasks which are required for this service to work properly. If any of these
@@ -85,15 +198,62 @@ func (s *Service) Start() error {
 		}
 	}
 
+	if s.docsFsSvc != nil {
+		if err := s.docsFsSvc.Start(); err != nil {
+			return fmt.Errorf("failed to start filesystem service: %w", err)
+		}
+		for _, source := range s.topicDocSources {
+			if fsSource, ok := source.(*filesystemTopicDocumentationSource); ok {
+				fsSource.reindex()
+			}
+		}
+	}
+
 	if err := s.kafkaSvc.Start(); err != nil {
 		return fmt.Errorf("failed to start kafka service: %w", err)
 	}
 
+	if s.connectHistory != nil {
+		startCtx, cancel := context.WithTimeout(context.Background(), connectHistoryStartTimeout)
+		err := s.connectHistory.Start(startCtx)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to start connect history store: %w", err)
+		}
+	}
+
+	if s.connectLoggerReverts != nil {
+		startCtx, cancel := context.WithTimeout(context.Background(), connectHistoryStartTimeout)
+		err := s.connectLoggerReverts.Start(startCtx, s.connectSvc)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to start connect logger revert store: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopBackgroundTasks = cancel
+	if s.kafkaSvc.SchemaService != nil && s.kafkaSvc.Config.Console.SchemaUsage.Enabled {
+		go s.refreshSchemaUsagePeriodically(ctx)
+	}
+
 	return nil
 }
 
 // Stop stops running go routines and releases allocated resources.
 func (s *Service) Stop() {
+	if s.stopBackgroundTasks != nil {
+		s.stopBackgroundTasks()
+	}
+	if s.connectHistory != nil {
+		s.connectHistory.Close()
+	}
+	if s.connectLoggerReverts != nil {
+		s.connectLoggerReverts.Close()
+	}
+	for _, replication := range s.mirrorReplications {
+		replication.sourceAdm.Close()
+	}
 	s.kafkaSvc.KafkaClient.Close()
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/twmb/franz-go/pkg/kmsg"
 
 	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/proto"
 	"github.com/redpanda-data/console/backend/pkg/schema"
 	"github.com/redpanda-data/console/backend/pkg/serde"
 )
@@ -22,6 +23,15 @@ type Servicer interface {
 	GetClusterInfo(ctx context.Context) (*ClusterInfo, error)
 	DeleteConsumerGroup(ctx context.Context, groupID string) error
 	GetConsumerGroupsOverview(ctx context.Context, groupIDs []string) ([]ConsumerGroupOverview, *rest.Error)
+	GetConnectorDependencies(ctx context.Context, clusterName string, connectorName string) (*ConnectorDependencies, *rest.Error)
+	GetConnectorDLQTopic(ctx context.Context, clusterName string, connectorName string) (*ConnectorDLQ, *rest.Error)
+	GetConnectorDLQ(ctx context.Context, dlqTopic *ConnectorDLQ, maxResults int) (*ConnectorDLQ, *rest.Error)
+	GetConnectorDLQRecords(ctx context.Context, dlqTopic *ConnectorDLQ, refs []ConnectorDLQRecordRef) (*ConnectorDLQ, *rest.Error)
+	ResendConnectorDLQRecords(ctx context.Context, records []ConnectorDLQRecord) ProduceRecordsResponse
+	ListMirrorReplications() ([]MirrorReplicationInfo, *rest.Error)
+	GetMirrorReplication(ctx context.Context, name string) (*MirrorReplication, *rest.Error)
+	GetMirrorTranslatedOffsets(ctx context.Context, name string, groupIDs []string) (*MirrorTranslatedOffsets, *rest.Error)
+	ApplyMirrorTranslatedOffsets(ctx context.Context, name string, groupID string) (*EditConsumerGroupOffsetsResponse, *rest.Error)
 	CreateACL(ctx context.Context, createReq kmsg.CreateACLsRequestCreation) *rest.Error
 	CreateKafkaClient(_ context.Context, additionalOpts ...kgo.Opt) (*kgo.Client, error)
 	CreateTopic(ctx context.Context, createTopicReq kmsg.CreateTopicsRequestTopic) (CreateTopicResponse, *rest.Error)
@@ -49,12 +59,19 @@ type Servicer interface {
 	GetTopicConfigs(ctx context.Context, topicName string, configNames []string) (*TopicConfig, *rest.Error)
 	GetTopicsConfigs(ctx context.Context, topicNames []string, configNames []string) (map[string]*TopicConfig, error)
 	ListTopicConsumers(ctx context.Context, topicName string) ([]*TopicConsumerGroup, error)
-	GetTopicDocumentation(topicName string) *TopicDocumentation
+	GetTopicDocumentation(ctx context.Context, topicName string) *TopicDocumentation
+	UpdateTopicDocumentation(ctx context.Context, topicName string, markdown []byte) (*TopicDocumentationUpdate, error)
 	GetTopicsOverview(ctx context.Context) ([]*TopicSummary, error)
 	GetAllTopicNames(ctx context.Context, metadata *kmsg.MetadataResponse) ([]string, error)
 	GetTopicDetails(ctx context.Context, topicNames []string) ([]TopicDetails, *rest.Error)
 
 	GetSchemaRegistryMode(ctx context.Context) (*SchemaRegistryMode, error)
+	PutSchemaRegistryMode(ctx context.Context, mode schema.Mode, force bool) (*SchemaRegistryMode, error)
+	GetSchemaRegistrySubjectMode(ctx context.Context, subject string) (*SchemaRegistryMode, error)
+	PutSchemaRegistrySubjectMode(ctx context.Context, subject string, mode schema.Mode, force bool) (*SchemaRegistryMode, error)
+	DeleteSchemaRegistrySubjectMode(ctx context.Context, subject string) (*SchemaRegistryMode, error)
+	GetSchemaRegistryContexts(ctx context.Context) ([]string, error)
+	GetSchemaRegistryContextSubjects(ctx context.Context, schemaContext string) ([]SchemaRegistrySubject, error)
 	GetSchemaRegistryConfig(ctx context.Context) (*SchemaRegistryConfig, error)
 	PutSchemaRegistryConfig(ctx context.Context, compatLevel schema.CompatibilityLevel) (*SchemaRegistryConfig, error)
 	PutSchemaRegistrySubjectConfig(ctx context.Context, subject string, compatLevel schema.CompatibilityLevel) (*SchemaRegistryConfig, error)
@@ -68,6 +85,14 @@ type Servicer interface {
 	CreateSchemaRegistrySchema(ctx context.Context, subjectName string, schema schema.Schema) (*CreateSchemaResponse, error)
 	ValidateSchemaRegistrySchema(ctx context.Context, subjectName string, version string, schema schema.Schema) *SchemaRegistrySchemaValidation
 	GetSchemaUsagesByID(ctx context.Context, schemaID int) ([]SchemaVersion, error)
+	DiffSchemaRegistrySchema(ctx context.Context, subjectName string, version string, candidate schema.Schema, compatLevel schema.CompatibilityLevel) (*SchemaRegistrySchemaDiff, error)
+	DiffSchemaRegistrySubjectVersions(ctx context.Context, subjectName string, oldVersion string, newVersion string, compatLevel schema.CompatibilityLevel) (*SchemaRegistrySchemaDiff, error)
+	ExportSchemaRegistryBackup(ctx context.Context) (*schema.Backup, error)
+	RestoreSchemaRegistryBackup(ctx context.Context, backup *schema.Backup, opts schema.RestoreOptions) (*schema.RestoreReport, error)
+	GetSchemaUsageReport(ctx context.Context, forceRefresh bool) (*SchemaUsageReport, error)
+	GetProtobufDiagnostics(ctx context.Context) (*proto.Diagnostics, error)
+	DecodeProtobufPayload(ctx context.Context, payload []byte, messageType string) (*proto.DecodeResult, error)
+	RefreshGitRepositories(ctx context.Context) (*GitRefreshResult, error)
 
 	// ------------------------------------------------------------------
 	// Plain Kafka requests, used by Connect API.
@@ -9,25 +9,172 @@
 
 package console
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/git"
+	"github.com/redpanda-data/console/backend/pkg/topicdocs"
+)
+
+// ErrTopicDocumentationEditingDisabled is returned if topic documentation shall be
+// edited, but editing is not enabled.
+var ErrTopicDocumentationEditingDisabled = errors.New("editing topic documentation is not enabled")
+
+// ErrInvalidTopicName is returned if a topic name contains characters that are not
+// allowed in Kafka topic names.
+var ErrInvalidTopicName = errors.New("invalid topic name")
+
+// topicNameRegex matches all legal Kafka topic names. The topic name is used as file
+// and branch name when editing documentation, so we must not accept path separators.
+var topicNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`)
+
 // TopicDocumentation holds the Markdown with potential metadata (e. g. editor, last edited at etc).
 type TopicDocumentation struct {
 	IsEnabled bool   `json:"isEnabled"`
 	Markdown  []byte `json:"markdown"`
+
+	// Source is the name of the documentation source the markdown has been loaded from.
+	Source string `json:"source,omitempty"`
+	// Metadata is parsed from the YAML front-matter of the markdown, if present.
+	// The front-matter is not part of the returned markdown.
+	Metadata *topicdocs.Metadata `json:"metadata,omitempty"`
 }
 
 // GetTopicDocumentation returns the documentation for the given topic if available.
-func (s *Service) GetTopicDocumentation(topicName string) *TopicDocumentation {
-	if s.gitSvc == nil {
-		return &TopicDocumentation{
-			IsEnabled: false,
-			Markdown:  nil,
+func (s *Service) GetTopicDocumentation(ctx context.Context, topicName string) *TopicDocumentation {
+	return s.getTopicsDocumentation(ctx, []string{topicName})[topicName]
+}
+
+// getTopicsDocumentation returns the documentation for all given topics. The sources
+// are queried in the order they are configured in, the first source that has
+// documentation for a topic wins. Topics whose documentation a source is still
+// fetching in the background are not looked up in the following sources, so that
+// the returned documentation does not depend on the latency of a source.
+func (s *Service) getTopicsDocumentation(ctx context.Context, topicNames []string) map[string]*TopicDocumentation {
+	docs := make(map[string]*TopicDocumentation, len(topicNames))
+	if len(s.topicDocSources) == 0 {
+		for _, topicName := range topicNames {
+			docs[topicName] = &TopicDocumentation{IsEnabled: false}
 		}
+		return docs
 	}
 
-	markdown := s.gitSvc.GetFileByFilename(topicName)
+	remaining := topicNames
+	for _, source := range s.topicDocSources {
+		if len(remaining) == 0 {
+			break
+		}
+
+		markdownByTopic, err := source.GetTopicsDocumentation(ctx, remaining)
+		pending := make(map[string]struct{})
+		var pendingErr *topicDocumentationPendingError
+		if errors.As(err, &pendingErr) {
+			for _, topicName := range pendingErr.topicNames {
+				pending[topicName] = struct{}{}
+			}
+			s.logger.Debug("topic documentation is still being fetched from source",
+				zap.String("source", source.Name()), zap.Strings("topic_names", pendingErr.topicNames))
+			err = pendingErr.err
+		}
+		if err != nil {
+			// Sources may return partial results, hence we continue with what we got
+			s.logger.Warn("failed to load topic documentation from source",
+				zap.String("source", source.Name()), zap.Error(err))
+		}
+
+		stillRemaining := make([]string, 0, len(remaining))
+		for _, topicName := range remaining {
+			if _, isPending := pending[topicName]; isPending {
+				docs[topicName] = &TopicDocumentation{IsEnabled: true}
+				continue
+			}
+			markdown, exists := markdownByTopic[topicName]
+			if !exists || markdown == nil {
+				stillRemaining = append(stillRemaining, topicName)
+				continue
+			}
+			docs[topicName] = s.newTopicDocumentation(topicName, source.Name(), markdown)
+		}
+		remaining = stillRemaining
+	}
+
+	for _, topicName := range remaining {
+		docs[topicName] = &TopicDocumentation{IsEnabled: true}
+	}
+
+	return docs
+}
+
+func (s *Service) newTopicDocumentation(topicName, source string, markdown []byte) *TopicDocumentation {
+	metadata, body, err := topicdocs.ParseFrontMatter(markdown)
+	if err != nil {
+		s.logger.Warn("failed to parse front-matter of topic documentation, returning markdown as is",
+			zap.String("topic_name", topicName), zap.String("source", source), zap.Error(err))
+	}
 
 	return &TopicDocumentation{
 		IsEnabled: true,
-		Markdown:  markdown.Payload,
+		Markdown:  body,
+		Source:    source,
+		Metadata:  metadata,
+	}
+}
+
+// TopicDocumentationUpdate describes the commit that contains the edited documentation.
+type TopicDocumentationUpdate struct {
+	// Mode is either "direct" if the commit has been pushed to the synced branch or
+	// "change" if it has been pushed to a new branch that needs to be merged.
+	Mode string `json:"mode"`
+	*git.CommitResult
+}
+
+// UpdateTopicDocumentation commits the markdown as documentation for the given topic
+// to the Git repository that serves the topic documentation. The front-matter of the
+// existing documentation is kept, unless the markdown declares its own.
+func (s *Service) UpdateTopicDocumentation(ctx context.Context, topicName string, markdown []byte) (*TopicDocumentationUpdate, error) {
+	cfg := s.topicDocEditing
+	if !cfg.Enabled || s.gitSvc == nil {
+		return nil, ErrTopicDocumentationEditingDisabled
 	}
+	if !topicNameRegex.MatchString(topicName) || topicName == "." || topicName == ".." {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTopicName, topicName)
+	}
+
+	opts := git.CommitOptions{
+		Message: strings.ReplaceAll(cfg.CommitMessageTemplate, "{topic}", topicName),
+		Author: object.Signature{
+			Name:  cfg.AuthorName,
+			Email: cfg.AuthorEmail,
+			When:  time.Now(),
+		},
+	}
+	if cfg.Mode == config.TopicDocumentationEditingModeChange {
+		opts.Branch = strings.NewReplacer(
+			"{topic}", topicName,
+			"{timestamp}", opts.Author.When.UTC().Format("20060102150405"),
+		).Replace(cfg.BranchNameTemplate)
+	}
+
+	// Documentation is returned without its front-matter, which must not get lost
+	// when the edited markdown is saved.
+	markdown = topicdocs.KeepFrontMatter(s.gitSvc.GetFileByFilename(topicName).Payload, markdown)
+
+	res, err := s.gitSvc.WriteFile(ctx, topicName, "md", markdown, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit topic documentation: %w", err)
+	}
+
+	return &TopicDocumentationUpdate{
+		Mode:         cfg.Mode,
+		CommitResult: res,
+	}, nil
 }
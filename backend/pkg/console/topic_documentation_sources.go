@@ -0,0 +1,379 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+	"github.com/redpanda-data/console/backend/pkg/git"
+	"github.com/redpanda-data/console/backend/pkg/schema"
+	"github.com/redpanda-data/console/backend/pkg/topicdocs"
+)
+
+// maxConcurrentTopicDocumentationRequests limits the number of concurrent requests
+// that sources issue for topics that have to be requested one by one.
+const maxConcurrentTopicDocumentationRequests = 10
+
+// topicDocumentationSource is a source that provides markdown documentation for topics.
+type topicDocumentationSource interface {
+	// Name of the source, which is returned along with the documentation.
+	Name() string
+
+	// GetTopicsDocumentation returns the markdown for all given topics that have
+	// documentation in this source. Topics that have been checked successfully but
+	// have no documentation may be returned with nil markdown. Partial results may be
+	// returned along with an error.
+	GetTopicsDocumentation(ctx context.Context, topicNames []string) (map[string][]byte, error)
+}
+
+// gitTopicDocumentationSource serves markdown files from Git repositories.
+type gitTopicDocumentationSource struct {
+	svc *git.Group
+}
+
+func (*gitTopicDocumentationSource) Name() string { return "git" }
+
+func (s *gitTopicDocumentationSource) GetTopicsDocumentation(_ context.Context, topicNames []string) (map[string][]byte, error) {
+	markdownByTopic := make(map[string][]byte)
+	for _, topicName := range topicNames {
+		if file := s.svc.GetFileByFilename(topicName); file.Payload != nil {
+			markdownByTopic[topicName] = file.Payload
+		}
+	}
+	return markdownByTopic, nil
+}
+
+// filesystemTopicDocumentationSource serves markdown files from the local filesystem.
+// The filesystem service indexes files by their full path, therefore the files are
+// re-indexed by their filename whenever they change.
+type filesystemTopicDocumentationSource struct {
+	svc *filesystem.Service
+
+	mutex       sync.RWMutex
+	filesByName map[string]filesystem.File
+}
+
+func newFilesystemTopicDocumentationSource(svc *filesystem.Service) *filesystemTopicDocumentationSource {
+	source := &filesystemTopicDocumentationSource{
+		svc:         svc,
+		filesByName: make(map[string]filesystem.File),
+	}
+	svc.OnFilesUpdatedHook = func([]string) { source.reindex() }
+	return source
+}
+
+func (*filesystemTopicDocumentationSource) Name() string { return "filesystem" }
+
+func (s *filesystemTopicDocumentationSource) reindex() {
+	filesByName := make(map[string]filesystem.File)
+	for _, file := range s.svc.GetFilesByFilename() {
+		name := strings.TrimSuffix(file.Filename, filepath.Ext(file.Filename))
+		filesByName[name] = file
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.filesByName = filesByName
+}
+
+func (s *filesystemTopicDocumentationSource) GetTopicsDocumentation(_ context.Context, topicNames []string) (map[string][]byte, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	markdownByTopic := make(map[string][]byte)
+	for _, topicName := range topicNames {
+		if file, exists := s.filesByName[topicName]; exists {
+			markdownByTopic[topicName] = file.Payload
+		}
+	}
+	return markdownByTopic, nil
+}
+
+// topicConfigTopicDocumentationSource reads the markdown from a topic config.
+type topicConfigTopicDocumentationSource struct {
+	configKey       string
+	describeConfigs func(ctx context.Context, topicNames []string, configNames []string) (map[string]*TopicConfig, error)
+}
+
+func (*topicConfigTopicDocumentationSource) Name() string { return "topicConfig" }
+
+func (s *topicConfigTopicDocumentationSource) GetTopicsDocumentation(ctx context.Context, topicNames []string) (map[string][]byte, error) {
+	configs, err := s.describeConfigs(ctx, topicNames, []string{s.configKey})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe topic configs: %w", err)
+	}
+
+	markdownByTopic := make(map[string][]byte, len(topicNames))
+	for _, topicName := range topicNames {
+		markdownByTopic[topicName] = nil
+		cfg, exists := configs[topicName]
+		if !exists {
+			continue
+		}
+		entry := cfg.GetConfigEntryByName(s.configKey)
+		if entry == nil || entry.Value == nil || *entry.Value == "" {
+			continue
+		}
+		markdownByTopic[topicName] = []byte(*entry.Value)
+	}
+	return markdownByTopic, nil
+}
+
+// schemaRegistryTopicDocumentationSource reads the documentation of the latest value
+// schema of each topic.
+type schemaRegistryTopicDocumentationSource struct {
+	schemaSvc *schema.Service
+}
+
+func (*schemaRegistryTopicDocumentationSource) Name() string { return "schemaRegistry" }
+
+func (s *schemaRegistryTopicDocumentationSource) GetTopicsDocumentation(ctx context.Context, topicNames []string) (map[string][]byte, error) {
+	return getTopicsDocumentationConcurrently(ctx, topicNames, s.getTopicDocumentation)
+}
+
+func (s *schemaRegistryTopicDocumentationSource) getTopicDocumentation(ctx context.Context, topicName string) ([]byte, error) {
+	res, err := s.schemaSvc.GetSchemaBySubject(ctx, topicName+"-value", "latest", false)
+	if err != nil {
+		var restErr *schema.RestError
+		if errors.As(err, &restErr) && restErr.ErrorCode == schema.CodeSubjectNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return schemaDocumentation(res.Type, res.Schema), nil
+}
+
+// schemaDocumentation returns the top level doc (Avro) or description (JSON schema)
+// of the given schema. Other schema types are not supported.
+func schemaDocumentation(schemaType schema.SchemaType, rawSchema string) []byte {
+	var doc struct {
+		Doc         string `json:"doc"`
+		Description string `json:"description"`
+	}
+	if err := json.Unmarshal([]byte(rawSchema), &doc); err != nil {
+		return nil
+	}
+
+	switch schemaType {
+	case schema.TypeAvro:
+		if doc.Doc != "" {
+			return []byte(doc.Doc)
+		}
+	case schema.TypeJSON:
+		if doc.Description != "" {
+			return []byte(doc.Description)
+		}
+	default:
+	}
+	return nil
+}
+
+// catalogTopicDocumentationSource requests the markdown from a data catalog.
+type catalogTopicDocumentationSource struct {
+	client topicdocs.CatalogClient
+}
+
+func (*catalogTopicDocumentationSource) Name() string { return "http" }
+
+func (s *catalogTopicDocumentationSource) GetTopicsDocumentation(ctx context.Context, topicNames []string) (map[string][]byte, error) {
+	return getTopicsDocumentationConcurrently(ctx, topicNames, s.client.GetTopicDocumentation)
+}
+
+// getTopicsDocumentationConcurrently calls get for each topic with limited concurrency.
+// All topics are requested, even if some requests fail. Only topics whose request
+// succeeded are part of the returned map.
+func getTopicsDocumentationConcurrently(
+	ctx context.Context,
+	topicNames []string,
+	get func(ctx context.Context, topicName string) ([]byte, error),
+) (map[string][]byte, error) {
+	var mutex sync.Mutex
+	markdownByTopic := make(map[string][]byte)
+	var errs []error
+
+	grp := errgroup.Group{}
+	grp.SetLimit(maxConcurrentTopicDocumentationRequests)
+	for _, topicName := range topicNames {
+		grp.Go(func() error {
+			markdown, err := get(ctx, topicName)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("topic %q: %w", topicName, err))
+				return nil
+			}
+			markdownByTopic[topicName] = markdown
+			return nil
+		})
+	}
+	_ = grp.Wait()
+
+	return markdownByTopic, errors.Join(errs...)
+}
+
+// topicDocumentationFetchTimeout is the maximum time a cached source waits for
+// the documentation of uncached topics. The fetch is not bound to the request, so
+// that the results are cached even if the requester stops waiting.
+const topicDocumentationFetchTimeout = 30 * time.Second
+
+// errTopicDocumentationPending is returned if the documentation of some topics is
+// still being fetched in the background.
+var errTopicDocumentationPending = errors.New("documentation of some topics is still being fetched")
+
+// topicDocumentationPendingError reports the topics whose documentation is still
+// being fetched in the background, along with the error of the topics that have
+// been fetched, if any. It matches errTopicDocumentationPending.
+type topicDocumentationPendingError struct {
+	topicNames []string
+	err        error
+}
+
+func (e *topicDocumentationPendingError) Error() string {
+	if e.err == nil {
+		return errTopicDocumentationPending.Error()
+	}
+	return errTopicDocumentationPending.Error() + ": " + e.err.Error()
+}
+
+func (e *topicDocumentationPendingError) Unwrap() error { return e.err }
+
+func (*topicDocumentationPendingError) Is(target error) bool {
+	return target == errTopicDocumentationPending
+}
+
+// cachedTopicDocumentationSource caches the results of remote sources, including the
+// absence of documentation. Failed requests are not cached. Uncached topics are
+// fetched in the background, so that requesters can stop waiting for a slow source
+// and get the documentation from the cache later on.
+type cachedTopicDocumentationSource struct {
+	source topicDocumentationSource
+	ttl    time.Duration
+
+	mutex   sync.Mutex
+	entries map[string]cachedTopicDocumentation
+	// inFlight holds the topics that are currently being fetched, so that
+	// concurrent requests do not fetch them again.
+	inFlight map[string]struct{}
+}
+
+type cachedTopicDocumentation struct {
+	markdown  []byte
+	fetchedAt time.Time
+}
+
+func newCachedTopicDocumentationSource(source topicDocumentationSource, ttl time.Duration) topicDocumentationSource {
+	if ttl == 0 {
+		return source
+	}
+	return &cachedTopicDocumentationSource{
+		source:   source,
+		ttl:      ttl,
+		entries:  make(map[string]cachedTopicDocumentation),
+		inFlight: make(map[string]struct{}),
+	}
+}
+
+func (c *cachedTopicDocumentationSource) Name() string { return c.source.Name() }
+
+func (c *cachedTopicDocumentationSource) GetTopicsDocumentation(ctx context.Context, topicNames []string) (map[string][]byte, error) {
+	markdownByTopic := make(map[string][]byte)
+	missing := make([]string, 0)
+	pending := make([]string, 0)
+
+	c.mutex.Lock()
+	for _, topicName := range topicNames {
+		entry, exists := c.entries[topicName]
+		if exists && time.Since(entry.fetchedAt) <= c.ttl {
+			markdownByTopic[topicName] = entry.markdown
+			continue
+		}
+		if _, isFetching := c.inFlight[topicName]; isFetching {
+			pending = append(pending, topicName)
+			continue
+		}
+		c.inFlight[topicName] = struct{}{}
+		missing = append(missing, topicName)
+	}
+	c.mutex.Unlock()
+
+	var err error
+	if len(missing) > 0 {
+		var fetched map[string][]byte
+		var completed bool
+		fetched, completed, err = c.fetch(ctx, missing)
+		for topicName, markdown := range fetched {
+			markdownByTopic[topicName] = markdown
+		}
+		if !completed {
+			pending = append(pending, missing...)
+		}
+	}
+	if len(pending) > 0 {
+		return markdownByTopic, &topicDocumentationPendingError{topicNames: pending, err: err}
+	}
+	return markdownByTopic, err
+}
+
+// fetch requests the documentation of the given topics from the source and caches
+// the results. It returns as soon as the fetch has completed or the context is done,
+// whichever happens first, and reports whether the fetch has completed.
+func (c *cachedTopicDocumentationSource) fetch(ctx context.Context, topicNames []string) (map[string][]byte, bool, error) {
+	type result struct {
+		markdownByTopic map[string][]byte
+		err             error
+	}
+	done := make(chan result, 1)
+	go func() {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), topicDocumentationFetchTimeout)
+		defer cancel()
+		fetched, err := c.source.GetTopicsDocumentation(fetchCtx, topicNames)
+		done <- result{markdownByTopic: c.store(topicNames, fetched, err), err: err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.markdownByTopic, true, res.err
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
+
+// store caches the fetched documentation and returns the documentation of all
+// topics that have been checked.
+func (c *cachedTopicDocumentationSource) store(topicNames []string, fetched map[string][]byte, err error) map[string][]byte {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	markdownByTopic := make(map[string][]byte)
+	now := time.Now()
+	for _, topicName := range topicNames {
+		delete(c.inFlight, topicName)
+		markdown, exists := fetched[topicName]
+		// Topics that are not part of the result have not been checked if the source
+		// reported an error, hence the absence of documentation is only cached otherwise.
+		if !exists && err != nil {
+			continue
+		}
+		markdownByTopic[topicName] = markdown
+		c.entries[topicName] = cachedTopicDocumentation{markdown: markdown, fetchedAt: now}
+	}
+	return markdownByTopic
+}
@@ -0,0 +1,313 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/git"
+	"github.com/redpanda-data/console/backend/pkg/schema"
+	"github.com/redpanda-data/console/backend/pkg/topicdocs/mocks"
+)
+
+type staticTopicDocumentationSource struct {
+	name            string
+	markdownByTopic map[string][]byte
+}
+
+func (s *staticTopicDocumentationSource) Name() string { return s.name }
+
+func (s *staticTopicDocumentationSource) GetTopicsDocumentation(_ context.Context, topicNames []string) (map[string][]byte, error) {
+	res := make(map[string][]byte)
+	for _, topicName := range topicNames {
+		if markdown, exists := s.markdownByTopic[topicName]; exists {
+			res[topicName] = markdown
+		}
+	}
+	return res, nil
+}
+
+func TestService_GetTopicsDocumentation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	catalog := mocks.NewMockCatalogClient(ctrl)
+	catalog.EXPECT().GetTopicDocumentation(gomock.Any(), "payments").
+		Return([]byte("---\nowner: jane\nteam: payments\n---\n# Payments\n"), nil)
+	catalog.EXPECT().GetTopicDocumentation(gomock.Any(), "undocumented").Return(nil, nil)
+
+	svc := &Service{
+		logger: zap.NewNop(),
+		topicDocSources: []topicDocumentationSource{
+			&staticTopicDocumentationSource{name: "git", markdownByTopic: map[string][]byte{"orders": []byte("# Orders")}},
+			&catalogTopicDocumentationSource{client: catalog},
+		},
+	}
+
+	docs := svc.getTopicsDocumentation(context.Background(), []string{"orders", "payments", "undocumented"})
+	require.Len(t, docs, 3)
+
+	assert.Equal(t, &TopicDocumentation{IsEnabled: true, Markdown: []byte("# Orders"), Source: "git"}, docs["orders"])
+
+	assert.Equal(t, "http", docs["payments"].Source)
+	assert.Equal(t, "# Payments\n", string(docs["payments"].Markdown))
+	require.NotNil(t, docs["payments"].Metadata)
+	assert.Equal(t, "jane", docs["payments"].Metadata.Owner)
+	assert.Equal(t, "payments", docs["payments"].Metadata.Team)
+
+	assert.Equal(t, &TopicDocumentation{IsEnabled: true}, docs["undocumented"])
+
+	notConfigured := (&Service{logger: zap.NewNop()}).GetTopicDocumentation(context.Background(), "orders")
+	assert.False(t, notConfigured.IsEnabled)
+}
+
+func TestCachedTopicDocumentationSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	catalog := mocks.NewMockCatalogClient(ctrl)
+	// Each topic must only be requested once, including topics without documentation
+	catalog.EXPECT().GetTopicDocumentation(gomock.Any(), "orders").Return([]byte("# Orders"), nil).Times(1)
+	catalog.EXPECT().GetTopicDocumentation(gomock.Any(), "undocumented").Return(nil, nil).Times(1)
+	// Failed requests are not cached
+	catalog.EXPECT().GetTopicDocumentation(gomock.Any(), "broken").Return(nil, errors.New("unavailable")).Times(2)
+
+	source := newCachedTopicDocumentationSource(&catalogTopicDocumentationSource{client: catalog}, time.Minute)
+	topicNames := []string{"orders", "undocumented", "broken"}
+	for i := 0; i < 2; i++ {
+		markdownByTopic, err := source.GetTopicsDocumentation(context.Background(), topicNames)
+		assert.Error(t, err)
+		assert.Equal(t, map[string][]byte{"orders": []byte("# Orders"), "undocumented": nil}, markdownByTopic)
+	}
+}
+
+// blockingTopicDocumentationSource returns the documentation of all topics once
+// release is closed.
+type blockingTopicDocumentationSource struct {
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (*blockingTopicDocumentationSource) Name() string { return "http" }
+
+func (s *blockingTopicDocumentationSource) GetTopicsDocumentation(_ context.Context, topicNames []string) (map[string][]byte, error) {
+	s.calls.Add(1)
+	<-s.release
+	res := make(map[string][]byte)
+	for _, topicName := range topicNames {
+		res[topicName] = []byte("# " + topicName)
+	}
+	return res, nil
+}
+
+func TestCachedTopicDocumentationSource_SlowSource(t *testing.T) {
+	blocking := &blockingTopicDocumentationSource{release: make(chan struct{})}
+	source := newCachedTopicDocumentationSource(blocking, time.Minute)
+
+	// The requester does not wait longer than its context allows.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	markdownByTopic, err := source.GetTopicsDocumentation(ctx, []string{"orders"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, markdownByTopic)
+
+	// Topics that are still being fetched are not requested again.
+	markdownByTopic, err = source.GetTopicsDocumentation(context.Background(), []string{"orders"})
+	assert.ErrorIs(t, err, errTopicDocumentationPending)
+	var pendingErr *topicDocumentationPendingError
+	require.ErrorAs(t, err, &pendingErr)
+	assert.Equal(t, []string{"orders"}, pendingErr.topicNames)
+	assert.Empty(t, markdownByTopic)
+
+	// The fetch completes in the background and is cached.
+	close(blocking.release)
+	assert.Eventually(t, func() bool {
+		markdownByTopic, err := source.GetTopicsDocumentation(context.Background(), []string{"orders"})
+		return err == nil && string(markdownByTopic["orders"]) == "# orders"
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), blocking.calls.Load())
+}
+
+func TestService_GetTopicsDocumentation_PendingSource(t *testing.T) {
+	blocking := &blockingTopicDocumentationSource{release: make(chan struct{})}
+	svc := &Service{
+		logger: zap.NewNop(),
+		topicDocSources: []topicDocumentationSource{
+			newCachedTopicDocumentationSource(blocking, time.Minute),
+			&staticTopicDocumentationSource{name: "schema", markdownByTopic: map[string][]byte{"orders": []byte("Orders schema")}},
+		},
+	}
+
+	// Topics that are still being fetched must not fall through to the following
+	// sources, neither for the requester that timed out nor for later requesters.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	docs := svc.getTopicsDocumentation(ctx, []string{"orders"})
+	assert.Equal(t, &TopicDocumentation{IsEnabled: true}, docs["orders"])
+	docs = svc.getTopicsDocumentation(context.Background(), []string{"orders"})
+	assert.Equal(t, &TopicDocumentation{IsEnabled: true}, docs["orders"])
+
+	close(blocking.release)
+	assert.Eventually(t, func() bool {
+		return svc.GetTopicDocumentation(context.Background(), "orders").Source == "http"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSchemaDocumentation(t *testing.T) {
+	tests := []struct {
+		name       string
+		schemaType schema.SchemaType
+		schema     string
+		want       []byte
+	}{
+		{
+			name:       "avro doc",
+			schemaType: schema.TypeAvro,
+			schema:     `{"type":"record","name":"Order","doc":"All orders","fields":[]}`,
+			want:       []byte("All orders"),
+		},
+		{
+			name:       "avro without doc",
+			schemaType: schema.TypeAvro,
+			schema:     `{"type":"record","name":"Order","fields":[]}`,
+		},
+		{
+			name:       "avro primitive",
+			schemaType: schema.TypeAvro,
+			schema:     `"string"`,
+		},
+		{
+			name:       "json schema description",
+			schemaType: schema.TypeJSON,
+			schema:     `{"type":"object","description":"All orders"}`,
+			want:       []byte("All orders"),
+		},
+		{
+			name:       "protobuf",
+			schemaType: schema.TypeProtobuf,
+			schema:     `syntax = "proto3";`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, schemaDocumentation(tt.schemaType, tt.schema))
+		})
+	}
+}
+
+func TestService_UpdateTopicDocumentation_Validation(t *testing.T) {
+	_, err := (&Service{logger: zap.NewNop()}).UpdateTopicDocumentation(context.Background(), "orders", []byte("# Orders"))
+	assert.ErrorIs(t, err, ErrTopicDocumentationEditingDisabled)
+
+	svc := &Service{
+		logger:          zap.NewNop(),
+		gitSvc:          &git.Group{},
+		topicDocEditing: config.TopicDocumentationEditing{Enabled: true},
+	}
+	for _, topicName := range []string{"", ".", "..", "../orders", "docs/orders"} {
+		_, err := svc.UpdateTopicDocumentation(context.Background(), topicName, []byte("# Orders"))
+		assert.ErrorIs(t, err, ErrInvalidTopicName, topicName)
+	}
+}
+
+// newTopicDocumentationRepository creates a local bare repository whose branch
+// main contains the given documentation files in the directory docs.
+func newTopicDocumentationRepository(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	// The file transport of go-git uses the git binaries for pushing and pulling
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git binary is required for pushing to local repositories")
+	}
+
+	bareDir := t.TempDir()
+	_, err := gogit.PlainInit(bareDir, true)
+	require.NoError(t, err)
+
+	seedDir := t.TempDir()
+	seed, err := gogit.PlainInitWithOptions(seedDir, &gogit.PlainInitOptions{
+		InitOptions: gogit.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	require.NoError(t, err)
+	tree, err := seed.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(seedDir, "docs"), 0o755))
+	for fileName, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(seedDir, "docs", fileName), []byte(content), 0o600))
+		_, err = tree.Add(path.Join("docs", fileName))
+		require.NoError(t, err)
+	}
+	_, err = tree.Commit("Initial commit", &gogit.CommitOptions{
+		Author: &object.Signature{Name: "Redpanda Console", Email: "console@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	_, err = seed.CreateRemote(&gitconfig.RemoteConfig{Name: "origin", URLs: []string{bareDir}})
+	require.NoError(t, err)
+	require.NoError(t, seed.Push(&gogit.PushOptions{RefSpecs: []gitconfig.RefSpec{"refs/heads/main:refs/heads/main"}}))
+
+	return bareDir
+}
+
+func TestService_UpdateTopicDocumentation_KeepsFrontMatter(t *testing.T) {
+	url := newTopicDocumentationRepository(t, map[string]string{
+		"orders.md": "---\nowner: jane@example.com\nteam: payments\npiiClassification: confidential\n---\n\n# Orders\n",
+	})
+
+	gitCfg := config.Git{}
+	gitCfg.SetDefaults()
+	gitCfg.Enabled = true
+	gitCfg.Repository.URL = url
+	gitCfg.Repository.Branch = "main"
+	gitCfg.Repository.BaseDirectory = "docs"
+	gitCfg.AllowedFileExtensions = []string{"md"}
+	group, err := git.NewGroup([]config.Git{gitCfg}, zap.NewNop(), nil)
+	require.NoError(t, err)
+	require.NoError(t, group.Start())
+
+	editing := config.TopicDocumentationEditing{Enabled: true}
+	editing.SetDefaults()
+	svc := &Service{
+		logger:          zap.NewNop(),
+		gitSvc:          group,
+		topicDocSources: []topicDocumentationSource{&gitTopicDocumentationSource{svc: group}},
+		topicDocEditing: editing,
+	}
+	ctx := context.Background()
+
+	// Load, edit and save the documentation like the frontend does.
+	doc := svc.GetTopicDocumentation(ctx, "orders")
+	require.NotNil(t, doc.Metadata)
+	assert.Equal(t, "# Orders\n", string(doc.Markdown))
+	_, err = svc.UpdateTopicDocumentation(ctx, "orders", append(doc.Markdown, []byte("\nAll orders.\n")...))
+	require.NoError(t, err)
+
+	doc = svc.GetTopicDocumentation(ctx, "orders")
+	assert.Equal(t, "# Orders\n\nAll orders.\n", string(doc.Markdown))
+	require.NotNil(t, doc.Metadata)
+	assert.Equal(t, "jane@example.com", doc.Metadata.Owner)
+	assert.Equal(t, "payments", doc.Metadata.Team)
+	assert.Equal(t, "confidential", doc.Metadata.PIIClassification)
+}
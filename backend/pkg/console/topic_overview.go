@@ -19,6 +19,8 @@ import (
 	"github.com/twmb/franz-go/pkg/kerr"
 	"github.com/twmb/franz-go/pkg/kmsg"
 	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/topicdocs"
 )
 
 // DocumentationState denotes whether topic documentation is available for a certain
@@ -48,6 +50,9 @@ type TopicSummary struct {
 	Documentation     DocumentationState `json:"documentation"`
 	LogDirSummary     TopicLogDirSummary `json:"logDirSummary"`
 
+	// DocumentationMetadata is parsed from the front-matter of the topic documentation.
+	DocumentationMetadata *topicdocs.Metadata `json:"documentationMetadata,omitempty"`
+
 	// What actions the logged in user is allowed to run on this topic
 	AllowedActions []string `json:"allowedActions"`
 }
@@ -66,7 +71,7 @@ func (s *Service) GetTopicsOverview(ctx context.Context) ([]*TopicSummary, error
 		return nil, err
 	}
 
-	// 3. Get log dir sizes & configs for each topic concurrently
+	// 3. Get log dir sizes, configs & documentation for each topic concurrently
 	// Use a shorter ctx timeout so that we don't wait for too long if one broker is currently down.
 	childCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -74,8 +79,9 @@ func (s *Service) GetTopicsOverview(ctx context.Context) ([]*TopicSummary, error
 	configs := make(map[string]*TopicConfig)
 	var logDirsByTopic map[string]TopicLogDirSummary
 	var logDirErrorMsg string
+	var docsByTopic map[string]*TopicDocumentation
 	wg := sync.WaitGroup{}
-	wg.Add(2)
+	wg.Add(3)
 	go func() {
 		defer wg.Done()
 		configs, err = s.GetTopicsConfigs(childCtx, topicNames, []string{"cleanup.policy"})
@@ -93,6 +99,10 @@ func (s *Service) GetTopicsOverview(ctx context.Context) ([]*TopicSummary, error
 			logDirErrorMsg = err.Error()
 		}
 	}()
+	go func() {
+		defer wg.Done()
+		docsByTopic = s.getTopicsDocumentation(childCtx, topicNames)
+	}()
 	wg.Wait()
 
 	// 4. Merge information from all requests and construct the TopicSummary object
@@ -111,7 +121,7 @@ func (s *Service) GetTopicsOverview(ctx context.Context) ([]*TopicSummary, error
 			}
 		}
 
-		docs := s.GetTopicDocumentation(topicName)
+		docs := docsByTopic[topicName]
 		var docState DocumentationState
 		if !docs.IsEnabled {
 			docState = DocumentationStateNotConfigured
@@ -143,6 +153,8 @@ func (s *Service) GetTopicsOverview(ctx context.Context) ([]*TopicSummary, error
 			CleanupPolicy:     policy,
 			LogDirSummary:     logDirSummary,
 			Documentation:     docState,
+
+			DocumentationMetadata: docs.Metadata,
 		}
 	}
 
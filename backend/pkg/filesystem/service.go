@@ -34,11 +34,16 @@ type Service struct {
 	filesByName map[string]File
 	mutex       sync.RWMutex
 
-	OnFilesUpdatedHook func()
+	// reloadMutex ensures that periodic and watch triggered reloads do not run concurrently.
+	reloadMutex sync.Mutex
+
+	// OnFilesUpdatedHook is called with the names of all added, modified or removed
+	// files whenever a reload detects changes.
+	OnFilesUpdatedHook func(changedFiles []string)
 }
 
 // NewService creates a new Git service with preconfigured Auth
-func NewService(cfg config.Filesystem, logger *zap.Logger, onFilesUpdatedHook func()) (*Service, error) {
+func NewService(cfg config.Filesystem, logger *zap.Logger, onFilesUpdatedHook func(changedFiles []string)) (*Service, error) {
 	childLogger := logger.With(zap.String("source", "file_provider"))
 
 	return &Service{
@@ -58,49 +63,81 @@ func (c *Service) Start() error {
 
 	// Initially do it once to ensure there's no error. Afterwards we'll do that periodically and only print errors
 	// instead of propagating them back.
-	loadedFiles, err := c.loadFilesIntoCache()
+	_, loadedFiles, err := c.reloadFiles()
 	if err != nil {
 		return err
 	}
 	c.logger.Info("successfully loaded all files from filesystem into cache", zap.Int("loaded_files", loadedFiles))
 
-	go func(refreshInterval time.Duration) {
-		// Stop sync when we receive a signal
-		quit := make(chan os.Signal, 1)
-		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-
-		ticker := time.NewTicker(refreshInterval)
-		for {
-			select {
-			case <-quit:
-				c.logger.Info("stopped sync", zap.String("reason", "received signal"))
-				return
-			case <-ticker.C:
-				loadedFiles, err := c.loadFilesIntoCache()
-				if err != nil {
-					c.logger.Warn("failed to read files in file provider", zap.Error(err))
-					break
-				}
-
-				if c.OnFilesUpdatedHook != nil {
-					c.OnFilesUpdatedHook()
-				}
-				c.logger.Debug("successfully loaded all files from filesystem into cache", zap.Int("loaded_files", loadedFiles))
+	if c.Cfg.Watch {
+		if err := c.startWatcher(); err != nil {
+			if c.Cfg.RefreshInterval == 0 {
+				return fmt.Errorf("failed to start filesystem watcher: %w", err)
 			}
+			c.logger.Warn("failed to start filesystem watcher, falling back to periodic reloads", zap.Error(err))
 		}
-	}(c.Cfg.RefreshInterval)
+	}
+
+	if c.Cfg.RefreshInterval > 0 {
+		go c.reloadPeriodically(c.Cfg.RefreshInterval)
+	}
 
 	return nil
 }
 
-func (c *Service) loadFilesIntoCache() (int, error) {
+func (c *Service) reloadPeriodically(refreshInterval time.Duration) {
+	// Stop sync when we receive a signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			c.logger.Info("stopped sync", zap.String("reason", "received signal"))
+			return
+		case <-ticker.C:
+			c.reloadAndNotify("interval")
+		}
+	}
+}
+
+// reloadAndNotify reloads all files and calls the OnFilesUpdatedHook if any file has
+// been added, modified or removed.
+func (c *Service) reloadAndNotify(trigger string) {
+	changedFiles, loadedFiles, err := c.reloadFiles()
+	if err != nil {
+		c.logger.Warn("failed to read files in file provider", zap.Error(err))
+		return
+	}
+	c.logger.Debug("successfully loaded all files from filesystem into cache",
+		zap.String("trigger", trigger),
+		zap.Int("loaded_files", loadedFiles),
+		zap.Int("changed_files", len(changedFiles)))
+
+	if len(changedFiles) == 0 {
+		return
+	}
+	if c.OnFilesUpdatedHook != nil {
+		c.OnFilesUpdatedHook(changedFiles)
+	}
+}
+
+// reloadFiles reads all files into the cache and returns the names of the files
+// that have changed compared to the previous cache along with the number of loaded files.
+func (c *Service) reloadFiles() ([]string, int, error) {
+	c.reloadMutex.Lock()
+	defer c.reloadMutex.Unlock()
+
 	filesByName, err := c.readFiles()
 	if err != nil {
-		return 0, fmt.Errorf("failed to read files in file provider: %w", err)
+		return nil, 0, fmt.Errorf("failed to read files in file provider: %w", err)
 	}
 
+	changed := changedFiles(c.GetFilesByFilename(), filesByName)
 	c.setFileContents(filesByName)
-	return len(filesByName), nil
+	return changed, len(filesByName), nil
 }
 
 func (c *Service) readFiles() (map[string]File, error) {
@@ -0,0 +1,159 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package filesystem
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// startWatcher registers filesystem notifications for all configured paths and their
+// subdirectories. Events are debounced, so that a batch of changes results in a single reload.
+func (c *Service) startWatcher() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create watcher: %w", err)
+	}
+
+	for _, p := range c.Cfg.Paths {
+		absPath, err := filepath.Abs(p)
+		if err != nil {
+			_ = watcher.Close()
+			return fmt.Errorf("failed to get abs path for given path '%v': %w", p, err)
+		}
+		if err := c.watchRecursively(watcher, absPath); err != nil {
+			_ = watcher.Close()
+			return fmt.Errorf("failed to watch path '%v': %w", p, err)
+		}
+	}
+
+	go c.watch(watcher)
+	c.logger.Info("watching filesystem for changed files", zap.Strings("paths", c.Cfg.Paths))
+
+	return nil
+}
+
+// watchRecursively adds the given directory and all its subdirectories to the watcher,
+// because notifications are only emitted for the direct children of a watched directory.
+func (c *Service) watchRecursively(watcher *fsnotify.Watcher, root string) error {
+	return filepath.Walk(root, func(currentPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if c.shouldSkip(currentPath) {
+			return filepath.SkipDir
+		}
+		return watcher.Add(currentPath)
+	})
+}
+
+func (c *Service) watch(watcher *fsnotify.Watcher) {
+	defer watcher.Close()
+
+	// Stop watching when we receive a signal
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	// debounce is nil until the first relevant event arrives. Every further event
+	// restarts the debounce period.
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-quit:
+			c.logger.Info("stopped watching filesystem", zap.String("reason", "received signal"))
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if !c.isRelevantEvent(event) {
+				continue
+			}
+			if event.Has(fsnotify.Create) {
+				c.watchIfDirectory(watcher, event.Name)
+			}
+			debounce = time.After(c.Cfg.WatchDebounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			c.logger.Warn("filesystem watcher reported an error", zap.Error(err))
+		case <-debounce:
+			debounce = nil
+			c.reloadAndNotify("watch")
+		}
+	}
+}
+
+// watchIfDirectory adds newly created directories to the watcher.
+func (c *Service) watchIfDirectory(watcher *fsnotify.Watcher, path string) {
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() || c.shouldSkip(path) {
+		return
+	}
+	if err := c.watchRecursively(watcher, path); err != nil {
+		c.logger.Warn("failed to watch created directory", zap.String("path", path), zap.Error(err))
+	}
+}
+
+// isRelevantEvent returns true if the event may change the loaded files. Besides files
+// with an allowed extension this includes directories and symlinks, because mounted
+// Kubernetes ConfigMaps are updated by atomically swapping the "..data" symlink rather
+// than by modifying the files themselves.
+func (c *Service) isRelevantEvent(event fsnotify.Event) bool {
+	if event.Op == fsnotify.Chmod {
+		return false
+	}
+	if isValid, _ := c.isValidFileExtension(event.Name); isValid {
+		return true
+	}
+	if strings.HasPrefix(filepath.Base(event.Name), "..") {
+		return true
+	}
+
+	info, err := os.Lstat(event.Name)
+	if err != nil {
+		// The path no longer exists, it may have been a directory that contained relevant files.
+		return event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename)
+	}
+	return info.IsDir() || info.Mode()&os.ModeSymlink != 0
+}
+
+// changedFiles returns the sorted names of all files that have been added, modified
+// or removed between the previous and the current files.
+func changedFiles(previous, current map[string]File) []string {
+	changed := make([]string, 0)
+	for name, file := range current {
+		previousFile, exists := previous[name]
+		if !exists || previousFile.Path != file.Path || !bytes.Equal(previousFile.Payload, file.Payload) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range previous {
+		if _, exists := current[name]; !exists {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
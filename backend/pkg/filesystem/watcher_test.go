@@ -0,0 +1,128 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package filesystem
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestChangedFiles(t *testing.T) {
+	previous := map[string]File{
+		"unchanged": {Path: "unchanged.proto", Payload: []byte("a")},
+		"modified":  {Path: "modified.proto", Payload: []byte("a")},
+		"removed":   {Path: "removed.proto", Payload: []byte("a")},
+		"moved":     {Path: "old/moved.proto", Payload: []byte("a")},
+	}
+	current := map[string]File{
+		"unchanged": {Path: "unchanged.proto", Payload: []byte("a")},
+		"modified":  {Path: "modified.proto", Payload: []byte("b")},
+		"added":     {Path: "added.proto", Payload: []byte("a")},
+		"moved":     {Path: "new/moved.proto", Payload: []byte("a")},
+	}
+
+	assert.Equal(t, []string{"added", "modified", "moved", "removed"}, changedFiles(previous, current))
+	assert.Empty(t, changedFiles(current, current))
+}
+
+func newWatchTestService(t *testing.T, dir string) (*Service, chan []string) {
+	t.Helper()
+
+	cfg := config.Filesystem{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.Watch = true
+	cfg.WatchDebounce = 50 * time.Millisecond
+	cfg.RefreshInterval = 0
+	cfg.Paths = []string{dir}
+	cfg.AllowedFileExtensions = []string{"proto"}
+
+	changes := make(chan []string, 10)
+	svc, err := NewService(cfg, zap.NewNop(), func(changedFiles []string) {
+		changes <- changedFiles
+	})
+	require.NoError(t, err)
+	require.NoError(t, svc.Start())
+
+	return svc, changes
+}
+
+func waitForChanges(t *testing.T, changes chan []string) []string {
+	t.Helper()
+
+	select {
+	case changed := <-changes:
+		return changed
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for changed files")
+		return nil
+	}
+}
+
+func TestService_Watch(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "orders.proto"), []byte("v1"), 0o600))
+
+	// Files are indexed by their absolute path without file extension
+	orders := filepath.Join(dir, "orders")
+	payments := filepath.Join(dir, "payments", "payments")
+
+	svc, changes := newWatchTestService(t, dir)
+	assert.Equal(t, []byte("v1"), svc.GetFileByFilename(orders).Payload)
+
+	// Files with other extensions must not trigger the hook
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("docs"), 0o600))
+
+	// Files in newly created directories are picked up as well
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "orders.proto"), []byte("v2"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "payments"), 0o700))
+	assert.Equal(t, []string{orders}, waitForChanges(t, changes))
+	assert.Equal(t, []byte("v2"), svc.GetFileByFilename(orders).Payload)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "payments", "payments.proto"), []byte("v1"), 0o600))
+	assert.Equal(t, []string{payments}, waitForChanges(t, changes))
+}
+
+func TestService_WatchSymlinkSwap(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks require elevated privileges on windows")
+	}
+
+	// Mimic the layout of a mounted Kubernetes ConfigMap, whose files are symlinks
+	// into a "..data" symlink that points to a timestamped directory.
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..2024_01_01"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "..2024_01_01", "orders.proto"), []byte("v1"), 0o600))
+	require.NoError(t, os.Symlink("..2024_01_01", filepath.Join(dir, "..data")))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "orders.proto"), filepath.Join(dir, "orders.proto")))
+
+	orders := filepath.Join(dir, "orders")
+	svc, changes := newWatchTestService(t, dir)
+	assert.Equal(t, []byte("v1"), svc.GetFileByFilename(orders).Payload)
+
+	// Atomically swap the data directory like the kubelet does
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..2024_01_02"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "..2024_01_02", "orders.proto"), []byte("v2"), 0o600))
+	require.NoError(t, os.Symlink("..2024_01_02", filepath.Join(dir, "..data_tmp")))
+	require.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "..2024_01_01")))
+
+	assert.Contains(t, waitForChanges(t, changes), orders)
+	assert.Equal(t, []byte("v2"), svc.GetFileByFilename(orders).Payload)
+}
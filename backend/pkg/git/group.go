@@ -0,0 +1,204 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+)
+
+// RepositoryStatus describes the sync state of a single repository.
+type RepositoryStatus struct {
+	URL           string    `json:"url"`
+	Reference     string    `json:"reference,omitempty"`
+	BaseDirectory string    `json:"baseDirectory"`
+	LastSyncedAt  time.Time `json:"lastSyncedAt"`
+	LastError     string    `json:"lastError,omitempty"`
+	Files         int       `json:"files"`
+}
+
+// FileConflict describes a file that exists in more than one repository. The file
+// of the first configured repository is used.
+type FileConflict struct {
+	Name         string   `json:"name"`
+	Repositories []string `json:"repositories"`
+}
+
+// GroupStatus describes the sync state of all repositories in a group along with
+// conflicting files.
+type GroupStatus struct {
+	Repositories []RepositoryStatus `json:"repositories"`
+	Conflicts    []FileConflict     `json:"conflicts"`
+}
+
+// Group serves the merged files of multiple Git repositories. Each repository is
+// synced independently with its own authentication, reference and refresh interval.
+// If multiple repositories contain a file with the same name, the file of the
+// repository that has been configured first wins and the conflict is reported.
+type Group struct {
+	services []*Service
+	logger   *zap.Logger
+
+	mutex       sync.RWMutex
+	filesByName map[string]filesystem.File
+	conflicts   []FileConflict
+
+	OnFilesUpdatedHook func()
+}
+
+// NewGroup creates a new Git service for each of the given repository configs.
+func NewGroup(cfgs []config.Git, logger *zap.Logger, onFilesUpdatedHook func()) (*Group, error) {
+	g := &Group{
+		services:           make([]*Service, 0, len(cfgs)),
+		logger:             logger,
+		filesByName:        make(map[string]filesystem.File),
+		conflicts:          make([]FileConflict, 0),
+		OnFilesUpdatedHook: onFilesUpdatedHook,
+	}
+
+	for i, cfg := range cfgs {
+		svc, err := NewService(cfg, logger, g.onFilesUpdated)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create git service for repository at index %d: %w", i, err)
+		}
+		g.services = append(g.services, svc)
+	}
+
+	return g, nil
+}
+
+// Start clones all repositories and starts their background syncs.
+func (g *Group) Start() error {
+	for _, svc := range g.services {
+		if err := svc.Start(); err != nil {
+			return fmt.Errorf("failed to start git service for repository %q: %w", svc.Cfg.Repository.URL, err)
+		}
+	}
+	return nil
+}
+
+// Refresh pulls all repositories immediately. This is used to react on webhooks
+// instead of waiting for the next periodic pull.
+func (g *Group) Refresh(ctx context.Context) error {
+	var errs []error
+	for _, svc := range g.services {
+		if err := svc.Pull(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to pull repository %q: %w", svc.Cfg.Repository.URL, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// onFilesUpdated is called whenever one of the repositories has new files. It merges
+// the files of all repositories and calls the group's OnFilesUpdatedHook.
+func (g *Group) onFilesUpdated() {
+	g.mergeFiles()
+
+	if g.OnFilesUpdatedHook != nil {
+		g.OnFilesUpdatedHook()
+	}
+}
+
+func (g *Group) mergeFiles() {
+	filesByName := make(map[string]filesystem.File)
+	repositoriesByName := make(map[string][]string)
+	for _, svc := range g.services {
+		for name, file := range svc.GetFilesByFilename() {
+			repositoriesByName[name] = append(repositoriesByName[name], svc.Cfg.Repository.URL)
+			if _, exists := filesByName[name]; exists {
+				continue
+			}
+			filesByName[name] = file
+		}
+	}
+
+	conflicts := make([]FileConflict, 0)
+	for name, repositories := range repositoriesByName {
+		if len(repositories) < 2 {
+			continue
+		}
+		conflicts = append(conflicts, FileConflict{Name: name, Repositories: repositories})
+		g.logger.Warn("file exists in multiple git repositories, using the file of the first repository",
+			zap.String("file", name),
+			zap.Strings("repositories", repositories))
+	}
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Name < conflicts[j].Name })
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.filesByName = filesByName
+	g.conflicts = conflicts
+}
+
+// GetFileByFilename returns the cached content for a given filename (without extension).
+// If there's no match an empty file will be returned.
+func (g *Group) GetFileByFilename(fileName string) filesystem.File {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return g.filesByName[fileName]
+}
+
+// GetFilesByFilename returns the merged files of all repositories.
+func (g *Group) GetFilesByFilename() map[string]filesystem.File {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return g.filesByName
+}
+
+// Status returns the sync state of all repositories and the conflicting files.
+func (g *Group) Status() GroupStatus {
+	repositories := make([]RepositoryStatus, len(g.services))
+	for i, svc := range g.services {
+		repositories[i] = svc.Status()
+	}
+
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	return GroupStatus{
+		Repositories: repositories,
+		Conflicts:    g.conflicts,
+	}
+}
+
+// WriteFile commits the content of the file with the given name (without extension)
+// to the repository that serves the file. New files are created in the base directory
+// of the first repository using the given file extension.
+func (g *Group) WriteFile(ctx context.Context, fileName, extension string, content []byte, opts CommitOptions) (*CommitResult, error) {
+	if len(g.services) == 0 {
+		return nil, errors.New("no git repository configured")
+	}
+
+	for _, svc := range g.services {
+		if file, exists := svc.GetFilesByFilename()[fileName]; exists {
+			filePath := file.Path
+			if svc.Cfg.IndexByFullFilepath {
+				// Paths are relative to the base directory if files are indexed by their full path
+				filePath = path.Join(svc.Cfg.Repository.BaseDirectory, filePath)
+			}
+			return svc.WriteFile(ctx, filePath, content, opts)
+		}
+	}
+
+	svc := g.services[0]
+	filePath := path.Join(svc.Cfg.Repository.BaseDirectory, fileName+"."+extension)
+	return svc.WriteFile(ctx, filePath, content, opts)
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+)
+
+func newTestService(url string, files map[string]filesystem.File) *Service {
+	cfg := config.Git{}
+	cfg.Repository.URL = url
+	return &Service{Cfg: cfg, filesByName: files}
+}
+
+func TestGroup_MergeFiles(t *testing.T) {
+	first := newTestService("https://example.com/first.git", map[string]filesystem.File{
+		"orders":   {Filename: "orders.md", Payload: []byte("first")},
+		"payments": {Filename: "payments.md", Payload: []byte("payments")},
+	})
+	second := newTestService("https://example.com/second.git", map[string]filesystem.File{
+		"orders":    {Filename: "orders.md", Payload: []byte("second")},
+		"customers": {Filename: "customers.md", Payload: []byte("customers")},
+	})
+
+	g := &Group{services: []*Service{first, second}, logger: zap.NewNop()}
+	g.mergeFiles()
+
+	files := g.GetFilesByFilename()
+	require.Len(t, files, 3)
+	assert.Equal(t, []byte("first"), g.GetFileByFilename("orders").Payload)
+	assert.Equal(t, []byte("customers"), g.GetFileByFilename("customers").Payload)
+
+	status := g.Status()
+	require.Len(t, status.Repositories, 2)
+	assert.Equal(t, 2, status.Repositories[0].Files)
+	assert.Equal(t, []FileConflict{{
+		Name:         "orders",
+		Repositories: []string{"https://example.com/first.git", "https://example.com/second.git"},
+	}}, status.Conflicts)
+}
@@ -47,6 +47,11 @@ type Service struct {
 	filesByName map[string]filesystem.File
 	mutex       sync.RWMutex
 
+	// pullMutex ensures that periodic and on-demand pulls do not run concurrently.
+	pullMutex    sync.Mutex
+	lastSyncedAt time.Time
+	lastSyncErr  error
+
 	OnFilesUpdatedHook func()
 }
 
@@ -104,15 +109,12 @@ func (c *Service) CloneRepository(ctx context.Context) error {
 	c.memFs = fs
 
 	// 1. Clone repository
-	var referenceName plumbing.ReferenceName
-	if c.Cfg.Repository.Branch != "" {
-		referenceName = plumbing.NewBranchReferenceName(c.Cfg.Repository.Branch)
-	}
 	c.logger.Info("cloning git repository", zap.String("url", c.Cfg.Repository.URL))
 	cloneOptions := &git.CloneOptions{
 		URL:           c.Cfg.Repository.URL,
 		Auth:          c.auth,
-		ReferenceName: referenceName,
+		ReferenceName: c.referenceName(),
+		SingleBranch:  c.Cfg.Repository.Tag != "",
 	}
 
 	if c.Cfg.CloneSubmodules {
@@ -122,6 +124,7 @@ func (c *Service) CloneRepository(ctx context.Context) error {
 
 	repo, err := git.CloneContext(ctx, memory.NewStorage(), fs, cloneOptions)
 	if err != nil {
+		c.setSyncResult(err)
 		return err
 	}
 	c.repo = repo
@@ -133,6 +136,7 @@ func (c *Service) CloneRepository(ctx context.Context) error {
 		return fmt.Errorf("failed to get files: %w", err)
 	}
 	c.setFileContents(files)
+	c.setSyncResult(nil)
 
 	c.logger.Info("successfully cloned git repository",
 		zap.String("base_directory", c.Cfg.Repository.BaseDirectory), zap.Int("read_files", len(files)))
@@ -152,10 +156,8 @@ func (c *Service) SyncRepo() {
 		c.logger.Info("refresh interval for sync is set to 0 (disabled)")
 		return
 	}
-
-	tree, err := c.repo.Worktree()
-	if err != nil {
-		c.logger.Error("failed to get work tree from repository. stopping git sync", zap.Error(err))
+	if c.Cfg.Repository.Tag != "" {
+		c.logger.Info("repository is pinned to a tag, periodic sync is disabled", zap.String("tag", c.Cfg.Repository.Tag))
 		return
 	}
 
@@ -170,35 +172,103 @@ func (c *Service) SyncRepo() {
 			c.logger.Info("stopped sync", zap.String("reason", "received signal"))
 			return
 		case <-ticker.C:
-			var referenceName plumbing.ReferenceName
-			if c.Cfg.Repository.Branch != "" {
-				referenceName = plumbing.NewBranchReferenceName(c.Cfg.Repository.Branch)
-			}
-			err := tree.Pull(&git.PullOptions{Auth: c.auth, ReferenceName: referenceName})
-			if err != nil {
-				if errors.Is(err, git.NoErrAlreadyUpToDate) {
-					continue
-				}
+			if err := c.Pull(context.Background()); err != nil {
 				c.logger.Error("pulling the repo has failed", zap.Error(err))
-				continue
 			}
+		}
+	}
+}
 
-			// Update cache with new markdowns
-			empty := make(map[string]filesystem.File)
-			files, err := c.readFiles(c.memFs, empty, c.Cfg.Repository.BaseDirectory, 5)
-			if err != nil {
-				c.logger.Error("failed to read files after pulling", zap.Error(err))
-				continue
-			}
-			c.setFileContents(files)
-			c.logger.Info("successfully pulled git repository",
-				zap.Int("read_files", len(files)))
+// Pull pulls the latest changes of the configured branch. If there are changes, the
+// file cache will be updated and the OnFilesUpdatedHook is called. Repositories that
+// are pinned to a tag are not pulled.
+func (c *Service) Pull(ctx context.Context) error {
+	if c.Cfg.Repository.Tag != "" {
+		return nil
+	}
+	if c.repo == nil {
+		return errors.New("repository has not been cloned yet")
+	}
 
-			if c.OnFilesUpdatedHook != nil {
-				c.OnFilesUpdatedHook()
-			}
+	c.pullMutex.Lock()
+	defer c.pullMutex.Unlock()
+
+	tree, err := c.repo.Worktree()
+	if err != nil {
+		c.setSyncResult(err)
+		return fmt.Errorf("failed to get work tree from repository: %w", err)
+	}
+
+	err = tree.PullContext(ctx, &git.PullOptions{Auth: c.auth, ReferenceName: c.referenceName()})
+	if err != nil {
+		if errors.Is(err, git.NoErrAlreadyUpToDate) {
+			c.setSyncResult(nil)
+			return nil
 		}
+		c.setSyncResult(err)
+		return err
+	}
+
+	// Update cache with new markdowns
+	empty := make(map[string]filesystem.File)
+	files, err := c.readFiles(c.memFs, empty, c.Cfg.Repository.BaseDirectory, 5)
+	if err != nil {
+		c.setSyncResult(err)
+		return fmt.Errorf("failed to read files after pulling: %w", err)
+	}
+	c.setFileContents(files)
+	c.setSyncResult(nil)
+	c.logger.Info("successfully pulled git repository",
+		zap.Int("read_files", len(files)))
+
+	if c.OnFilesUpdatedHook != nil {
+		c.OnFilesUpdatedHook()
+	}
+
+	return nil
+}
+
+// referenceName returns the configured branch or tag reference. An empty reference
+// name refers to the default branch.
+func (c *Service) referenceName() plumbing.ReferenceName {
+	switch {
+	case c.Cfg.Repository.Tag != "":
+		return plumbing.NewTagReferenceName(c.Cfg.Repository.Tag)
+	case c.Cfg.Repository.Branch != "":
+		return plumbing.NewBranchReferenceName(c.Cfg.Repository.Branch)
+	default:
+		return ""
+	}
+}
+
+func (c *Service) setSyncResult(err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.lastSyncedAt = time.Now()
+	c.lastSyncErr = err
+}
+
+// Status returns the current sync state of the repository.
+func (c *Service) Status() RepositoryStatus {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	reference := c.Cfg.Repository.Branch
+	if c.Cfg.Repository.Tag != "" {
+		reference = c.Cfg.Repository.Tag
+	}
+	status := RepositoryStatus{
+		URL:           c.Cfg.Repository.URL,
+		Reference:     reference,
+		BaseDirectory: c.Cfg.Repository.BaseDirectory,
+		LastSyncedAt:  c.lastSyncedAt,
+		Files:         len(c.filesByName),
+	}
+	if c.lastSyncErr != nil {
+		status.LastError = c.lastSyncErr.Error()
 	}
+	return status
 }
 
 // setFileContents saves file contents into memory, so that they are accessible at any time.
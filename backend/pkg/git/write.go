@@ -0,0 +1,170 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+)
+
+// CommitOptions describe how a changed file is committed and pushed.
+type CommitOptions struct {
+	Message string
+	Author  object.Signature
+
+	// Branch is the name of a new branch that the commit is pushed to, so that the
+	// change can be reviewed before it's merged. If empty, the commit is pushed to
+	// the synced branch directly.
+	Branch string
+}
+
+// CommitResult describes a pushed commit.
+type CommitResult struct {
+	RepositoryURL string `json:"repositoryUrl"`
+	Branch        string `json:"branch"`
+	Path          string `json:"path"`
+	CommitHash    string `json:"commitHash"`
+}
+
+// WriteFile writes the content to the file at the given path, commits it and pushes
+// the commit. Commits that are pushed to the synced branch are reflected in the file
+// cache immediately.
+func (c *Service) WriteFile(ctx context.Context, filePath string, content []byte, opts CommitOptions) (*CommitResult, error) {
+	if c.Cfg.Repository.Tag != "" {
+		return nil, errors.New("repository is pinned to a tag and can't be written to")
+	}
+	if c.repo == nil {
+		return nil, errors.New("repository has not been cloned yet")
+	}
+
+	c.pullMutex.Lock()
+	defer c.pullMutex.Unlock()
+
+	tree, err := c.repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work tree from repository: %w", err)
+	}
+
+	// Pull first so that the push is not rejected because of changes we don't know yet
+	err = tree.PullContext(ctx, &git.PullOptions{Auth: c.auth, ReferenceName: c.referenceName()})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return nil, fmt.Errorf("failed to pull repository before committing: %w", err)
+	}
+
+	head, err := c.repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get head of repository: %w", err)
+	}
+	if !head.Name().IsBranch() {
+		return nil, fmt.Errorf("head of repository is not a branch: %s", head.Name())
+	}
+
+	targetBranch := head.Name()
+	if opts.Branch != "" {
+		targetBranch = plumbing.NewBranchReferenceName(opts.Branch)
+		if err := tree.Checkout(&git.CheckoutOptions{Branch: targetBranch, Create: true}); err != nil {
+			return nil, fmt.Errorf("failed to create branch %q: %w", opts.Branch, err)
+		}
+		// The change branch is only pushed, we always return to the synced branch.
+		defer func() {
+			if err := tree.Checkout(&git.CheckoutOptions{Branch: head.Name(), Force: true}); err != nil {
+				c.logger.Error("failed to checkout synced branch after pushing change branch", zap.Error(err))
+			}
+			if err := c.repo.Storer.RemoveReference(targetBranch); err != nil {
+				c.logger.Warn("failed to remove local change branch", zap.String("branch", opts.Branch), zap.Error(err))
+			}
+		}()
+	}
+
+	hash, err := c.commitFile(tree, filePath, content, opts)
+	if err != nil {
+		c.resetWorkTree(tree, head.Hash())
+		return nil, err
+	}
+
+	refSpec := gitconfig.RefSpec(fmt.Sprintf("%s:%s", targetBranch, targetBranch))
+	err = c.repo.PushContext(ctx, &git.PushOptions{Auth: c.auth, RefSpecs: []gitconfig.RefSpec{refSpec}})
+	if err != nil {
+		// Discard the local commit so that the clone matches the remote again
+		c.resetWorkTree(tree, head.Hash())
+		return nil, fmt.Errorf("failed to push commit: %w", err)
+	}
+
+	c.logger.Info("pushed commit to git repository",
+		zap.String("branch", targetBranch.Short()),
+		zap.String("path", filePath),
+		zap.String("commit", hash.String()))
+
+	if opts.Branch == "" {
+		files, err := c.readFiles(c.memFs, make(map[string]filesystem.File), c.Cfg.Repository.BaseDirectory, 5)
+		if err != nil {
+			c.logger.Error("failed to read files after pushing commit", zap.Error(err))
+		} else {
+			c.setFileContents(files)
+			if c.OnFilesUpdatedHook != nil {
+				c.OnFilesUpdatedHook()
+			}
+		}
+	}
+
+	return &CommitResult{
+		RepositoryURL: c.Cfg.Repository.URL,
+		Branch:        targetBranch.Short(),
+		Path:          filePath,
+		CommitHash:    hash.String(),
+	}, nil
+}
+
+func (c *Service) commitFile(tree *git.Worktree, filePath string, content []byte, opts CommitOptions) (plumbing.Hash, error) {
+	if err := c.memFs.MkdirAll(path.Dir(filePath), 0o755); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create directory: %w", err)
+	}
+	f, err := c.memFs.Create(filePath)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to create file: %w", err)
+	}
+	_, err = f.Write(content)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	if _, err := tree.Add(filePath); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to stage file: %w", err)
+	}
+	author := opts.Author
+	if author.When.IsZero() {
+		author.When = time.Now()
+	}
+	hash, err := tree.Commit(opts.Message, &git.CommitOptions{Author: &author})
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to commit file: %w", err)
+	}
+	return hash, nil
+}
+
+func (c *Service) resetWorkTree(tree *git.Worktree, hash plumbing.Hash) {
+	if err := tree.Reset(&git.ResetOptions{Commit: hash, Mode: git.HardReset}); err != nil {
+		c.logger.Error("failed to reset work tree", zap.String("commit", hash.String()), zap.Error(err))
+	}
+}
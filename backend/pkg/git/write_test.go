@@ -0,0 +1,156 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+var testSignature = object.Signature{Name: "Redpanda Console", Email: "console@example.com"}
+
+// newBareTestRepository creates a local bare repository with a single commit that
+// contains orders.md on the branch main.
+func newBareTestRepository(t *testing.T) string {
+	t.Helper()
+
+	// The file transport of go-git uses the git binaries for pushing and pulling
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git binary is required for pushing to local repositories")
+	}
+
+	bareDir := t.TempDir()
+	_, err := git.PlainInit(bareDir, true)
+	require.NoError(t, err)
+
+	seedDir := t.TempDir()
+	seed, err := git.PlainInitWithOptions(seedDir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(seedDir, "docs"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(seedDir, "docs", "orders.md"), []byte("# Orders"), 0o600))
+
+	tree, err := seed.Worktree()
+	require.NoError(t, err)
+	_, err = tree.Add("docs/orders.md")
+	require.NoError(t, err)
+	sig := testSignature
+	sig.When = time.Now()
+	_, err = tree.Commit("Initial commit", &git.CommitOptions{Author: &sig})
+	require.NoError(t, err)
+
+	_, err = seed.CreateRemote(&gitconfig.RemoteConfig{Name: "origin", URLs: []string{bareDir}})
+	require.NoError(t, err)
+	require.NoError(t, seed.Push(&git.PushOptions{RefSpecs: []gitconfig.RefSpec{"refs/heads/main:refs/heads/main"}}))
+
+	return bareDir
+}
+
+func newTestWriteService(t *testing.T, url string) *Service {
+	t.Helper()
+
+	cfg := config.Git{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.Repository.URL = url
+	cfg.Repository.Branch = "main"
+	cfg.Repository.BaseDirectory = "docs"
+	cfg.AllowedFileExtensions = []string{"md"}
+
+	svc, err := NewService(cfg, zap.NewNop(), nil)
+	require.NoError(t, err)
+	require.NoError(t, svc.CloneRepository(context.Background()))
+	return svc
+}
+
+func readRemoteFile(t *testing.T, url, branch, filePath string) string {
+	t.Helper()
+
+	repo, err := git.PlainClone(t.TempDir(), false, &git.CloneOptions{
+		URL:           url,
+		ReferenceName: plumbing.NewBranchReferenceName(branch),
+	})
+	require.NoError(t, err)
+	tree, err := repo.Worktree()
+	require.NoError(t, err)
+	content, err := os.ReadFile(filepath.Join(tree.Filesystem.Root(), filePath))
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestService_WriteFile(t *testing.T) {
+	url := newBareTestRepository(t)
+	svc := newTestWriteService(t, url)
+
+	updated := 0
+	svc.OnFilesUpdatedHook = func() { updated++ }
+
+	// Update an existing file on the synced branch
+	res, err := svc.WriteFile(context.Background(), "docs/orders.md", []byte("# Orders v2"), CommitOptions{
+		Message: "Update orders",
+		Author:  testSignature,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "main", res.Branch)
+	assert.Equal(t, "docs/orders.md", res.Path)
+	assert.NotEmpty(t, res.CommitHash)
+	assert.Equal(t, "# Orders v2", readRemoteFile(t, url, "main", "docs/orders.md"))
+	assert.Equal(t, []byte("# Orders v2"), svc.GetFileByFilename("orders").Payload)
+	assert.Equal(t, 1, updated)
+
+	// Push a new file to a change branch, the synced branch must remain untouched
+	res, err = svc.WriteFile(context.Background(), "docs/payments.md", []byte("# Payments"), CommitOptions{
+		Message: "Add payments",
+		Author:  testSignature,
+		Branch:  "docs/payments",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "docs/payments", res.Branch)
+	assert.Equal(t, "# Payments", readRemoteFile(t, url, "docs/payments", "docs/payments.md"))
+	assert.Nil(t, svc.GetFileByFilename("payments").Payload)
+	assert.Equal(t, 1, updated)
+
+	head, err := svc.repo.Head()
+	require.NoError(t, err)
+	assert.Equal(t, plumbing.NewBranchReferenceName("main"), head.Name())
+	_, err = svc.memFs.Stat("docs/payments.md")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestGroup_WriteFile(t *testing.T) {
+	url := newBareTestRepository(t)
+	svc := newTestWriteService(t, url)
+	g := &Group{services: []*Service{svc}, logger: zap.NewNop()}
+	svc.OnFilesUpdatedHook = g.onFilesUpdated
+
+	// New files are created in the base directory of the first repository
+	res, err := g.WriteFile(context.Background(), "payments", "md", []byte("# Payments"), CommitOptions{
+		Message: "Add payments",
+		Author:  testSignature,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "docs/payments.md", res.Path)
+	assert.Equal(t, []byte("# Payments"), g.GetFileByFilename("payments").Payload)
+}
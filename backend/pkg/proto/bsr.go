@@ -0,0 +1,160 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package proto
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jhump/protoreflect/desc"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// bsrClient downloads module descriptors from a Buf Schema Registry compatible API
+// by using the Buf reflection API (buf.reflect.v1beta1.FileDescriptorSetService).
+type bsrClient struct {
+	client *resty.Client
+}
+
+// bsrError is the Connect error format returned by the BSR.
+type bsrError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *bsrError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+type getFileDescriptorSetRequest struct {
+	Module  string `json:"module"`
+	Version string `json:"version,omitempty"`
+}
+
+type getFileDescriptorSetResponse struct {
+	FileDescriptorSet json.RawMessage `json:"fileDescriptorSet"`
+	Version           string          `json:"version"`
+}
+
+func newBSRClient(cfg config.ProtoBSR) *bsrClient {
+	client := resty.New().
+		SetBaseURL(strings.TrimSuffix(cfg.URL, "/")).
+		SetHeader("User-Agent", "Redpanda Console").
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Connect-Protocol-Version", "1").
+		SetTimeout(cfg.Timeout).
+		SetError(&bsrError{})
+	if cfg.Token != "" {
+		client.SetAuthToken(cfg.Token)
+	}
+
+	return &bsrClient{client: client}
+}
+
+// GetFileDescriptorSet downloads the self-contained file descriptor set of the given
+// module. It returns the descriptors along with the resolved module version.
+func (c *bsrClient) GetFileDescriptorSet(ctx context.Context, module config.ProtoBSRModule) ([]*desc.FileDescriptor, string, error) {
+	var response getFileDescriptorSetResponse
+	res, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&response).
+		SetBody(getFileDescriptorSetRequest{Module: module.Name, Version: module.Version}).
+		Post("/buf.reflect.v1beta1.FileDescriptorSetService/GetFileDescriptorSet")
+	if err != nil {
+		return nil, "", fmt.Errorf("get file descriptor set request failed: %w", err)
+	}
+
+	if res.IsError() {
+		bsrErr, ok := res.Error().(*bsrError)
+		if !ok || bsrErr.Code == "" {
+			return nil, "", fmt.Errorf("get file descriptor set request failed: Status code %d", res.StatusCode())
+		}
+		return nil, "", bsrErr
+	}
+
+	var fds descriptorpb.FileDescriptorSet
+	if err := protojson.Unmarshal(response.FileDescriptorSet, &fds); err != nil {
+		return nil, "", fmt.Errorf("failed to decode file descriptor set: %w", err)
+	}
+
+	descriptors, err := fileDescriptorSetToDescriptors(&fds)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return descriptors, response.Version, nil
+}
+
+// bsrModuleState is the result of the last download of a BSR module.
+type bsrModuleState struct {
+	descriptors []*desc.FileDescriptor
+	version     string
+	err         error
+}
+
+// refreshBSRDescriptors downloads all configured modules from the BSR. Modules that
+// fail to download keep their previously downloaded descriptors, so that a temporarily
+// unavailable registry does not remove known types.
+func (s *Service) refreshBSRDescriptors(ctx context.Context) {
+	for _, module := range s.cfg.BSR.Modules {
+		descriptors, version, err := s.bsrClient.GetFileDescriptorSet(ctx, module)
+
+		s.bsrModulesMutex.Lock()
+		state, exists := s.bsrModules[module.Name]
+		if !exists {
+			state = &bsrModuleState{}
+			s.bsrModules[module.Name] = state
+		}
+		state.err = err
+		if err == nil {
+			state.descriptors = descriptors
+			state.version = version
+		}
+		s.bsrModulesMutex.Unlock()
+
+		if err != nil {
+			s.logger.Error("failed to download module from buf schema registry",
+				zap.String("module", module.Name),
+				zap.String("version", module.Version),
+				zap.Error(err))
+			continue
+		}
+
+		s.logger.Info("downloaded module from buf schema registry",
+			zap.String("module", module.Name),
+			zap.String("resolved_version", version),
+			zap.Int("file_descriptors", len(descriptors)))
+	}
+}
+
+func (s *Service) getBSRDescriptors(diag *Diagnostics) []*desc.FileDescriptor {
+	s.bsrModulesMutex.RLock()
+	defer s.bsrModulesMutex.RUnlock()
+
+	descriptors := make([]*desc.FileDescriptor, 0)
+	for name, state := range s.bsrModules {
+		diag.addFile(FileDiagnostics{
+			Name:         name,
+			Source:       FileSourceBSR,
+			Version:      state.version,
+			MessageTypes: messageTypeNames(state.descriptors...),
+			Errors:       errorStrings(state.err),
+		})
+		descriptors = append(descriptors, state.descriptors...)
+	}
+	return descriptors
+}
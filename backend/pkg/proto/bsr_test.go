@@ -0,0 +1,75 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package proto
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestBSRClient_GetFileDescriptorSet(t *testing.T) {
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto),
+		},
+	}
+	fdsJSON, err := protojson.Marshal(fds)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/buf.reflect.v1beta1.FileDescriptorSetService/GetFileDescriptorSet", r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(bsrError{Code: "unauthenticated", Message: "invalid token"})
+			return
+		}
+
+		var req getFileDescriptorSetRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "buf.build/acme/payments", req.Module)
+		assert.Equal(t, "v1.2.0", req.Version)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(getFileDescriptorSetResponse{
+			FileDescriptorSet: fdsJSON,
+			Version:           "3f4a2b",
+		})
+	}))
+	defer server.Close()
+
+	module := config.ProtoBSRModule{Name: "buf.build/acme/payments", Version: "v1.2.0"}
+
+	client := newBSRClient(config.ProtoBSR{URL: server.URL, Token: "secret", Timeout: time.Second})
+	descriptors, version, err := client.GetFileDescriptorSet(context.Background(), module)
+	require.NoError(t, err)
+	assert.Equal(t, "3f4a2b", version)
+	require.Len(t, descriptors, 1)
+	assert.NotNil(t, descriptors[0].FindMessage("google.protobuf.Timestamp"))
+
+	unauthenticated := newBSRClient(config.ProtoBSR{URL: server.URL, Token: "wrong", Timeout: time.Second})
+	_, _, err = unauthenticated.GetFileDescriptorSet(context.Background(), module)
+	var bsrErr *bsrError
+	require.ErrorAs(t, err, &bsrErr)
+	assert.Equal(t, "unauthenticated", bsrErr.Code)
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package proto
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"go.uber.org/zap"
+	v2proto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+)
+
+// splitDescriptorSetFiles separates pre-compiled descriptor set binaries from
+// .proto source files based on the configured descriptor set file extensions.
+func (s *Service) splitDescriptorSetFiles(files map[string]filesystem.File) (protoFiles, descriptorSetFiles map[string]filesystem.File) {
+	protoFiles = make(map[string]filesystem.File)
+	descriptorSetFiles = make(map[string]filesystem.File)
+
+	for name, file := range files {
+		if s.isDescriptorSetFile(file.Path) {
+			descriptorSetFiles[name] = file
+			continue
+		}
+		protoFiles[name] = file
+	}
+
+	return protoFiles, descriptorSetFiles
+}
+
+func (s *Service) isDescriptorSetFile(path string) bool {
+	ext := strings.TrimPrefix(filepath.Ext(path), ".")
+	for _, descriptorSetExt := range s.cfg.DescriptorSetFileExtensions {
+		if strings.EqualFold(ext, strings.TrimPrefix(descriptorSetExt, ".")) {
+			return true
+		}
+	}
+	return false
+}
+
+// descriptorSetFilesToDescriptors parses binary FileDescriptorSets. Descriptor sets
+// that cannot be parsed or linked are logged and skipped, so that a single broken
+// file does not prevent all other types from being registered.
+func (s *Service) descriptorSetFilesToDescriptors(files map[string]filesystem.File, sources map[string]FileSource, diag *Diagnostics) []*desc.FileDescriptor {
+	descriptors := make([]*desc.FileDescriptor, 0)
+	for name, file := range files {
+		fds, err := parseFileDescriptorSet(file.Payload)
+		diag.addFile(FileDiagnostics{
+			Name:         file.Path,
+			Source:       sources[name],
+			MessageTypes: messageTypeNames(fds...),
+			Errors:       errorStrings(err),
+		})
+		if err != nil {
+			s.logger.Warn("failed to load descriptor set",
+				zap.String("file", file.Path),
+				zap.Error(err))
+			continue
+		}
+		descriptors = append(descriptors, fds...)
+	}
+	return descriptors
+}
+
+// parseFileDescriptorSet unmarshals a binary FileDescriptorSet and links all
+// contained files. The set must be self-contained, which means it must include
+// all imported files (this is the default for `buf build`).
+func parseFileDescriptorSet(payload []byte) ([]*desc.FileDescriptor, error) {
+	var fds descriptorpb.FileDescriptorSet
+	if err := v2proto.Unmarshal(payload, &fds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal file descriptor set: %w", err)
+	}
+	return fileDescriptorSetToDescriptors(&fds)
+}
+
+func fileDescriptorSetToDescriptors(fds *descriptorpb.FileDescriptorSet) ([]*desc.FileDescriptor, error) {
+	descriptorsByName, err := desc.CreateFileDescriptorsFromSet(fds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to link file descriptor set: %w", err)
+	}
+
+	descriptors := make([]*desc.FileDescriptor, 0, len(descriptorsByName))
+	for _, fd := range descriptorsByName {
+		descriptors = append(descriptors, fd)
+	}
+	return descriptors, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package proto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	v2proto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+)
+
+func testFileDescriptorSet(t *testing.T) []byte {
+	t.Helper()
+
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto),
+		},
+	}
+	payload, err := v2proto.Marshal(fds)
+	require.NoError(t, err)
+	return payload
+}
+
+func TestService_splitDescriptorSetFiles(t *testing.T) {
+	svc := Service{cfg: config.Proto{DescriptorSetFileExtensions: []string{"binpb", ".desc"}}}
+
+	protoFiles, descriptorSetFiles := svc.splitDescriptorSetFiles(map[string]filesystem.File{
+		"a.proto": {Path: "protos/a.proto"},
+		"b.binpb": {Path: "build/b.binpb"},
+		"c.DESC":  {Path: "build/c.DESC"},
+	})
+	assert.Len(t, protoFiles, 1)
+	assert.Contains(t, protoFiles, "a.proto")
+	assert.Len(t, descriptorSetFiles, 2)
+}
+
+func TestService_descriptorSetFilesToDescriptors(t *testing.T) {
+	svc := Service{logger: zap.NewNop()}
+	diag := newDiagnostics()
+
+	descriptors := svc.descriptorSetFilesToDescriptors(map[string]filesystem.File{
+		"image.binpb":  {Path: "image.binpb", Payload: testFileDescriptorSet(t)},
+		"broken.binpb": {Path: "broken.binpb", Payload: []byte("not a descriptor set")},
+	}, map[string]FileSource{
+		"image.binpb":  FileSourceGit,
+		"broken.binpb": FileSourceGit,
+	}, diag)
+	require.Len(t, descriptors, 1)
+	assert.NotNil(t, descriptors[0].FindMessage("google.protobuf.Timestamp"))
+
+	require.Len(t, diag.Files, 2)
+	for _, file := range diag.Files {
+		assert.Equal(t, FileSourceGit, file.Source)
+		if file.Name == "broken.binpb" {
+			assert.Len(t, file.Errors, 1)
+			continue
+		}
+		assert.Equal(t, []string{"google.protobuf.Timestamp"}, file.MessageTypes)
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package proto
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jhump/protoreflect/desc"
+
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+	"github.com/redpanda-data/console/backend/pkg/git"
+)
+
+// FileSource describes the provider a proto file has been loaded from.
+type FileSource string
+
+const (
+	// FileSourceGit is a file loaded from the configured Git repository.
+	FileSourceGit FileSource = "git"
+	// FileSourceFilesystem is a file loaded from the local filesystem.
+	FileSourceFilesystem FileSource = "filesystem"
+	// FileSourceSchemaRegistry is a schema loaded from the schema registry.
+	FileSourceSchemaRegistry FileSource = "schemaRegistry"
+	// FileSourceBSR is a module downloaded from a Buf Schema Registry.
+	FileSourceBSR FileSource = "bsr"
+)
+
+// Diagnostics describes the state of the proto registry after the last refresh.
+// It is meant to help users understand why records can't be decoded.
+type Diagnostics struct {
+	LastRefreshedAt   time.Time            `json:"lastRefreshedAt"`
+	RefreshDurationMs int64                `json:"refreshDurationMs"`
+	RefreshError      string               `json:"refreshError,omitempty"`
+	Files             []FileDiagnostics    `json:"files"`
+	MessageTypes      []string             `json:"messageTypes"`
+	Mappings          []MappingDiagnostics `json:"mappings"`
+	// Git describes the sync state of all Git repositories and conflicting files.
+	Git *git.GroupStatus `json:"git,omitempty"`
+
+	startedAt time.Time
+}
+
+// FileDiagnostics describes a single loaded proto file, descriptor set or module.
+type FileDiagnostics struct {
+	Name   string     `json:"name"`
+	Source FileSource `json:"source"`
+	// SchemaID is set for files from the schema registry.
+	SchemaID int `json:"schemaId,omitempty"`
+	// Version is the resolved version of modules from a Buf Schema Registry.
+	Version      string   `json:"version,omitempty"`
+	MessageTypes []string `json:"messageTypes"`
+	Errors       []string `json:"errors,omitempty"`
+}
+
+// MappingDiagnostics describes a configured topic mapping and whether the
+// mapped types exist in the proto registry.
+type MappingDiagnostics struct {
+	TopicName              string `json:"topicName"`
+	KeyProtoType           string `json:"keyProtoType,omitempty"`
+	KeyProtoTypeResolved   bool   `json:"keyProtoTypeResolved"`
+	ValueProtoType         string `json:"valueProtoType,omitempty"`
+	ValueProtoTypeResolved bool   `json:"valueProtoTypeResolved"`
+}
+
+func newDiagnostics() *Diagnostics {
+	return &Diagnostics{
+		Files:        make([]FileDiagnostics, 0),
+		MessageTypes: make([]string, 0),
+		Mappings:     make([]MappingDiagnostics, 0),
+		startedAt:    time.Now(),
+	}
+}
+
+func (d *Diagnostics) addFile(file FileDiagnostics) {
+	if d == nil {
+		return
+	}
+	d.Files = append(d.Files, file)
+}
+
+// addProtoFiles records the compiled .proto files along with their compile errors.
+// The file names match the names used by the compiler, which are relative to the
+// configured import paths.
+func (d *Diagnostics) addProtoFiles(
+	files map[string]filesystem.File,
+	sources map[string]FileSource,
+	importPaths []string,
+	descriptors []*desc.FileDescriptor,
+	compileErrors map[string][]string,
+) {
+	descriptorsByName := make(map[string]*desc.FileDescriptor, len(descriptors))
+	for _, fd := range descriptors {
+		descriptorsByName[fd.GetName()] = fd
+	}
+
+	for name, file := range files {
+		compiledName, ok := compiledFileName(file.Path, importPaths)
+		if !ok {
+			continue
+		}
+
+		var messageTypes []string
+		if fd, exists := descriptorsByName[compiledName]; exists {
+			messageTypes = messageTypeNames(fd)
+		} else {
+			messageTypes = make([]string, 0)
+		}
+		d.addFile(FileDiagnostics{
+			Name:         compiledName,
+			Source:       sources[name],
+			MessageTypes: messageTypes,
+			Errors:       compileErrors[compiledName],
+		})
+	}
+}
+
+func (d *Diagnostics) addSchemaRegistryFiles(descriptorsBySchemaID map[int]*desc.FileDescriptor) {
+	for schemaID, fd := range descriptorsBySchemaID {
+		d.addFile(FileDiagnostics{
+			Name:         fd.GetName(),
+			Source:       FileSourceSchemaRegistry,
+			SchemaID:     schemaID,
+			MessageTypes: messageTypeNames(fd),
+		})
+	}
+}
+
+func (d *Diagnostics) setMessageTypes(descriptors []*desc.FileDescriptor) {
+	d.MessageTypes = messageTypeNames(descriptors...)
+}
+
+// compiledFileName returns the name that the compiler uses for the file at the
+// given path. It returns false if the file is not located in any of the import paths.
+func compiledFileName(path string, importPaths []string) (string, bool) {
+	trimmedFilepath := strings.TrimPrefix(path, "/")
+	if len(importPaths) == 0 {
+		return trimmedFilepath, true
+	}
+	for _, prefix := range importPaths {
+		if strings.HasPrefix(trimmedFilepath, prefix) {
+			return strings.TrimPrefix(strings.TrimPrefix(trimmedFilepath, prefix), "/"), true
+		}
+	}
+	return "", false
+}
+
+// messageTypeNames returns the sorted and deduplicated fully qualified names of
+// all messages, including nested messages, declared in the given files.
+func messageTypeNames(fds ...*desc.FileDescriptor) []string {
+	seen := make(map[string]struct{})
+	var collect func(mds []*desc.MessageDescriptor)
+	collect = func(mds []*desc.MessageDescriptor) {
+		for _, md := range mds {
+			seen[md.GetFullyQualifiedName()] = struct{}{}
+			collect(md.GetNestedMessageTypes())
+		}
+	}
+	for _, fd := range fds {
+		collect(fd.GetMessageTypes())
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func errorStrings(err error) []string {
+	if err == nil {
+		return nil
+	}
+	return []string{err.Error()}
+}
+
+func (s *Service) storeDiagnostics(diag *Diagnostics, err error) {
+	diag.LastRefreshedAt = time.Now()
+	diag.RefreshDurationMs = time.Since(diag.startedAt).Milliseconds()
+	if err != nil {
+		diag.RefreshError = err.Error()
+	}
+	sort.Slice(diag.Files, func(i, j int) bool {
+		if diag.Files[i].Source != diag.Files[j].Source {
+			return diag.Files[i].Source < diag.Files[j].Source
+		}
+		return diag.Files[i].Name < diag.Files[j].Name
+	})
+
+	s.diagnosticsMutex.Lock()
+	defer s.diagnosticsMutex.Unlock()
+	s.diagnostics = diag
+}
+
+// GetDiagnostics returns the state of the proto registry after the last refresh.
+// The returned diagnostics must not be modified.
+func (s *Service) GetDiagnostics() *Diagnostics {
+	s.diagnosticsMutex.RLock()
+	defer s.diagnosticsMutex.RUnlock()
+
+	if s.diagnostics == nil {
+		return newDiagnostics()
+	}
+	return s.diagnostics
+}
+
+// DecodeResult is the result of decoding a payload with a specific message type.
+type DecodeResult struct {
+	MessageType string          `json:"messageType,omitempty"`
+	SchemaID    int             `json:"schemaId,omitempty"`
+	JSON        json.RawMessage `json:"json,omitempty"`
+	Error       string          `json:"error,omitempty"`
+}
+
+// TestDecode decodes the payload using the given message type from the proto
+// registry. If no message type is given, the payload is expected to use the
+// schema registry wire format. Decoding errors are returned as part of the result.
+func (s *Service) TestDecode(payload []byte, messageType string) *DecodeResult {
+	result := &DecodeResult{MessageType: messageType}
+
+	md, payload, err := s.testDecodeMessageDescriptor(payload, messageType, result)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.MessageType = md.GetFullyQualifiedName()
+
+	jsonBytes, err := s.DeserializeProtobufMessageToJSON(payload, md)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.JSON = jsonBytes
+
+	return result
+}
+
+func (s *Service) testDecodeMessageDescriptor(payload []byte, messageType string, result *DecodeResult) (*desc.MessageDescriptor, []byte, error) {
+	if messageType == "" {
+		if !s.cfg.SchemaRegistry.Enabled {
+			return nil, nil, errors.New("no message type specified and schema registry is not enabled for protobuf")
+		}
+		wrapper, err := s.decodeConfluentBinaryWrapper(payload)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode schema registry wire format: %w", err)
+		}
+		result.SchemaID = int(wrapper.SchemaID)
+
+		md, err := s.GetMessageDescriptorForSchema(int(wrapper.SchemaID), wrapper.IndexArray)
+		if err != nil {
+			return nil, nil, err
+		}
+		return md, wrapper.ProtoPayload, nil
+	}
+
+	s.registryMutex.RLock()
+	defer s.registryMutex.RUnlock()
+	if s.registry == nil {
+		return nil, nil, errors.New("proto registry has not been created yet")
+	}
+	md, err := s.registry.FindMessageTypeByUrl(messageType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find the proto type %s in the proto registry: %w", messageType, err)
+	}
+	if md == nil {
+		return nil, nil, fmt.Errorf("proto type %s does not exist in the proto registry", messageType)
+	}
+	return md, payload, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package proto
+
+import (
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic/msgregistry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v2proto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+)
+
+func TestCompiledFileName(t *testing.T) {
+	name, ok := compiledFileName("/protos/shop/order.proto", nil)
+	assert.True(t, ok)
+	assert.Equal(t, "protos/shop/order.proto", name)
+
+	name, ok = compiledFileName("/protos/shop/order.proto", []string{"vendor", "protos"})
+	assert.True(t, ok)
+	assert.Equal(t, "shop/order.proto", name)
+
+	_, ok = compiledFileName("/other/order.proto", []string{"protos"})
+	assert.False(t, ok)
+}
+
+func TestDiagnostics_addProtoFiles(t *testing.T) {
+	fd, err := desc.LoadFileDescriptor("google/protobuf/timestamp.proto")
+	require.NoError(t, err)
+
+	diag := newDiagnostics()
+	diag.addProtoFiles(
+		map[string]filesystem.File{
+			"timestamp.proto": {Path: "/google/protobuf/timestamp.proto"},
+			"broken.proto":    {Path: "/broken.proto"},
+		},
+		map[string]FileSource{"timestamp.proto": FileSourceGit, "broken.proto": FileSourceFilesystem},
+		nil,
+		[]*desc.FileDescriptor{fd},
+		map[string][]string{"broken.proto": {"broken.proto:1:1: syntax error"}},
+	)
+
+	require.Len(t, diag.Files, 2)
+	filesByName := make(map[string]FileDiagnostics)
+	for _, file := range diag.Files {
+		filesByName[file.Name] = file
+	}
+	assert.Equal(t, []string{"google.protobuf.Timestamp"}, filesByName["google/protobuf/timestamp.proto"].MessageTypes)
+	assert.Equal(t, FileSourceGit, filesByName["google/protobuf/timestamp.proto"].Source)
+	assert.Equal(t, []string{"broken.proto:1:1: syntax error"}, filesByName["broken.proto"].Errors)
+	assert.Empty(t, filesByName["broken.proto"].MessageTypes)
+}
+
+func TestService_TestDecode(t *testing.T) {
+	fd, err := desc.LoadFileDescriptor("google/protobuf/timestamp.proto")
+	require.NoError(t, err)
+	registry := msgregistry.NewMessageRegistryWithDefaults()
+	registry.AddFile("", fd)
+	svc := Service{registry: registry}
+
+	payload, err := v2proto.Marshal(&timestamppb.Timestamp{Seconds: 1700000000})
+	require.NoError(t, err)
+
+	t.Run("decodes payload", func(t *testing.T) {
+		res := svc.TestDecode(payload, "google.protobuf.Timestamp")
+		assert.Empty(t, res.Error)
+		assert.Equal(t, "google.protobuf.Timestamp", res.MessageType)
+		assert.JSONEq(t, `"2023-11-14T22:13:20Z"`, string(res.JSON))
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		res := svc.TestDecode(payload, "shop.Order")
+		assert.Contains(t, res.Error, "shop.Order")
+		assert.Nil(t, res.JSON)
+	})
+
+	t.Run("schema registry disabled", func(t *testing.T) {
+		res := svc.TestDecode(payload, "")
+		assert.NotEmpty(t, res.Error)
+	})
+}
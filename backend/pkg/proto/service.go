@@ -55,9 +55,14 @@ type Service struct {
 	logger *zap.Logger
 
 	mappingsByTopic map[string]config.ProtoTopicMapping
-	gitSvc          *git.Service
+	gitSvc          *git.Group
 	fsSvc           *filesystem.Service
 	schemaSvc       *schema.Service
+	bsrClient       *bsrClient
+
+	// bsrModules caches the last download result of each configured BSR module.
+	bsrModules      map[string]*bsrModuleState
+	bsrModulesMutex sync.RWMutex
 
 	// fileDescriptorsBySchemaID are used to find the right schema type for messages at deserialization time. The type
 	// index is encoded as part of the serialized message.
@@ -67,6 +72,9 @@ type Service struct {
 	registryMutex sync.RWMutex
 	registry      *msgregistry.MessageRegistry
 
+	diagnosticsMutex sync.RWMutex
+	diagnostics      *Diagnostics
+
 	sfGroup singleflight.Group
 }
 
@@ -74,9 +82,12 @@ type Service struct {
 func NewService(cfg config.Proto, logger *zap.Logger, schemaSvc *schema.Service) (*Service, error) {
 	var err error
 
-	var gitSvc *git.Service
-	if cfg.Git.Enabled {
-		gitSvc, err = git.NewService(cfg.Git, logger, nil)
+	var gitSvc *git.Group
+	if gitSources := cfg.GitSources(); len(gitSources) > 0 {
+		for i := range gitSources {
+			gitSources[i].AllowedFileExtensions = withDescriptorSetExtensions(gitSources[i].AllowedFileExtensions, cfg.DescriptorSetFileExtensions)
+		}
+		gitSvc, err = git.NewGroup(gitSources, logger, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create new git service: %w", err)
 		}
@@ -84,7 +95,9 @@ func NewService(cfg config.Proto, logger *zap.Logger, schemaSvc *schema.Service)
 
 	var fsSvc *filesystem.Service
 	if cfg.FileSystem.Enabled {
-		fsSvc, err = filesystem.NewService(cfg.FileSystem, logger, nil)
+		fsCfg := cfg.FileSystem
+		fsCfg.AllowedFileExtensions = withDescriptorSetExtensions(cfg.FileSystem.AllowedFileExtensions, cfg.DescriptorSetFileExtensions)
+		fsSvc, err = filesystem.NewService(fsCfg, logger, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create new filesystem service: %w", err)
 		}
@@ -112,6 +125,11 @@ func NewService(cfg config.Proto, logger *zap.Logger, schemaSvc *schema.Service)
 		}
 	}
 
+	var bsr *bsrClient
+	if cfg.BSR.Enabled {
+		bsr = newBSRClient(cfg.BSR)
+	}
+
 	mappingsByTopic := make(map[string]config.ProtoTopicMapping)
 	for _, mapping := range cfg.Mappings {
 		mappingsByTopic[mapping.TopicName.String()] = mapping
@@ -125,6 +143,9 @@ func NewService(cfg config.Proto, logger *zap.Logger, schemaSvc *schema.Service)
 		gitSvc:          gitSvc,
 		fsSvc:           fsSvc,
 		schemaSvc:       schemaSvc,
+		bsrClient:       bsr,
+
+		bsrModules: make(map[string]*bsrModuleState),
 
 		// registry has to be created afterwards
 		registry: nil,
@@ -167,11 +188,17 @@ func (s *Service) Start() error {
 	}
 
 	if s.fsSvc != nil {
+		// The filesystem service only calls the hook if proto files or descriptor sets
+		// have been added, modified or removed.
+		s.fsSvc.OnFilesUpdatedHook = func(changedFiles []string) {
+			s.logger.Info("proto files on filesystem have changed, rebuilding proto registry",
+				zap.Strings("changed_files", changedFiles))
+			s.tryCreateProtoRegistry()
+		}
 		err := s.fsSvc.Start()
 		if err != nil {
 			return fmt.Errorf("failed to start filesystem service: %w", err)
 		}
-		s.fsSvc.OnFilesUpdatedHook = s.tryCreateProtoRegistry
 	}
 
 	if s.schemaSvc != nil {
@@ -179,6 +206,16 @@ func (s *Service) Start() error {
 		go triggerRefresh(s.cfg.SchemaRegistry.RefreshInterval, s.tryCreateProtoRegistry)
 	}
 
+	if s.bsrClient != nil {
+		s.refreshBSRDescriptors(context.Background())
+		if s.cfg.BSR.RefreshInterval > 0 {
+			go triggerRefresh(s.cfg.BSR.RefreshInterval, func() {
+				s.refreshBSRDescriptors(context.Background())
+				s.tryCreateProtoRegistry()
+			})
+		}
+	}
+
 	err := s.createProtoRegistry(context.Background())
 	if err != nil {
 		return fmt.Errorf("failed to create proto registry: %w", err)
@@ -187,6 +224,25 @@ func (s *Service) Start() error {
 	return nil
 }
 
+// RefreshGitRepositories pulls all Git repositories immediately. If there are new
+// changes the proto registry will be rebuilt.
+func (s *Service) RefreshGitRepositories(ctx context.Context) error {
+	if s.gitSvc == nil {
+		return nil
+	}
+	return s.gitSvc.Refresh(ctx)
+}
+
+// GitStatus returns the sync state of all Git repositories that proto files are
+// loaded from. It returns nil if no Git repository is configured.
+func (s *Service) GitStatus() *git.GroupStatus {
+	if s.gitSvc == nil {
+		return nil
+	}
+	status := s.gitSvc.Status()
+	return &status
+}
+
 func (s *Service) unmarshalConfluentMessage(payload []byte) ([]byte, int, error) {
 	// 1. If schema registry for protobuf is enabled, let's check if this message has been serialized utilizing
 	// Confluent's KafakProtobuf serialization format.
@@ -461,30 +517,60 @@ func (s *Service) tryCreateProtoRegistry() {
 }
 
 func (s *Service) createProtoRegistry(ctx context.Context) error {
+	diag := newDiagnostics()
+	err := s.buildProtoRegistry(ctx, diag)
+	s.storeDiagnostics(diag, err)
+	return err
+}
+
+// buildProtoRegistry loads all types from the configured sources and replaces the
+// current registry. Loaded files, compile errors and resolved mappings are recorded
+// in the given diagnostics.
+func (s *Service) buildProtoRegistry(ctx context.Context, diag *Diagnostics) error {
 	startTime := time.Now()
 
 	files := make(map[string]filesystem.File)
+	sources := make(map[string]FileSource)
 
 	if s.gitSvc != nil {
 		for name, file := range s.gitSvc.GetFilesByFilename() {
 			files[name] = file
+			sources[name] = FileSourceGit
 		}
 		s.logger.Debug("fetched .proto files from git service cache",
 			zap.Int("fetched_proto_files", len(files)))
+		gitStatus := s.gitSvc.Status()
+		diag.Git = &gitStatus
 	}
 	if s.fsSvc != nil {
 		for name, file := range s.fsSvc.GetFilesByFilename() {
 			files[name] = file
+			sources[name] = FileSourceFilesystem
 		}
 		s.logger.Debug("fetched .proto files from filesystem service cache",
 			zap.Int("fetched_proto_files", len(files)))
 	}
 
-	fileDescriptors, err := s.protoFileToDescriptor(files)
+	protoFiles, descriptorSetFiles := s.splitDescriptorSetFiles(files)
+	fileDescriptors, compileErrors, err := s.protoFileToDescriptor(protoFiles)
+	diag.addProtoFiles(protoFiles, sources, s.cfg.ImportPaths, fileDescriptors, compileErrors)
 	if err != nil {
 		return fmt.Errorf("failed to compile proto files to descriptors: %w", err)
 	}
 
+	// Pre-compiled descriptor sets and BSR modules are already linked and do not
+	// need to be compiled.
+	if len(descriptorSetFiles) > 0 {
+		descriptors := s.descriptorSetFilesToDescriptors(descriptorSetFiles, sources, diag)
+		s.logger.Debug("loaded descriptor sets",
+			zap.Int("descriptor_set_files", len(descriptorSetFiles)),
+			zap.Int("file_descriptors", len(descriptors)))
+		fileDescriptors = append(fileDescriptors, descriptors...)
+	}
+	if s.bsrClient != nil {
+		fileDescriptors = append(fileDescriptors, s.getBSRDescriptors(diag)...)
+	}
+
 	// Merge proto descriptors from schema registry into the existing proto descriptors
 	if s.schemaSvc != nil {
 		descriptors, err := s.schemaSvc.GetProtoDescriptors(ctx)
@@ -492,6 +578,7 @@ func (s *Service) createProtoRegistry(ctx context.Context) error {
 			s.logger.Error("failed to get proto descriptors from schema registry", zap.Error(err))
 		}
 		s.setFileDescriptorsBySchemaID(descriptors)
+		diag.addSchemaRegistryFiles(descriptors)
 		s.logger.Info("fetched proto schemas from schema registry", zap.Int("fetched_subjects", len(descriptors)))
 	}
 
@@ -500,6 +587,7 @@ func (s *Service) createProtoRegistry(ctx context.Context) error {
 	for _, descriptor := range fileDescriptors {
 		registry.AddFile("", descriptor)
 	}
+	diag.setMessageTypes(fileDescriptors)
 	s.logger.Info("registered proto types in Console's local proto registry", zap.Int("registered_types", len(fileDescriptors)))
 
 	s.registryMutex.Lock()
@@ -510,6 +598,11 @@ func (s *Service) createProtoRegistry(ctx context.Context) error {
 	foundTypes := 0
 	missingTypes := 0
 	for _, mapping := range s.cfg.Mappings {
+		mappingDiag := MappingDiagnostics{
+			TopicName:      mapping.TopicName.String(),
+			KeyProtoType:   mapping.KeyProtoType,
+			ValueProtoType: mapping.ValueProtoType,
+		}
 		if mapping.ValueProtoType != "" {
 			messageDesc, err := s.registry.FindMessageTypeByUrl(mapping.ValueProtoType)
 			if err != nil {
@@ -521,6 +614,7 @@ func (s *Service) createProtoRegistry(ctx context.Context) error {
 					zap.String("value_proto_type", mapping.ValueProtoType))
 				missingTypes++
 			} else {
+				mappingDiag.ValueProtoTypeResolved = true
 				foundTypes++
 			}
 		}
@@ -535,9 +629,11 @@ func (s *Service) createProtoRegistry(ctx context.Context) error {
 					zap.String("key_proto_type", mapping.KeyProtoType))
 				missingTypes++
 			} else {
+				mappingDiag.KeyProtoTypeResolved = true
 				foundTypes++
 			}
 		}
+		diag.Mappings = append(diag.Mappings, mappingDiag)
 	}
 
 	totalDuration := time.Since(startTime)
@@ -554,7 +650,8 @@ func (s *Service) createProtoRegistry(ctx context.Context) error {
 // protoFileToDescriptorWithBinary parses a .proto file and compiles it to a descriptor using the protoc binary. Protoc must
 // be available as command or this will fail.
 // Imported dependencies (such as Protobuf timestamp) are included so that the descriptors are self-contained.
-func (s *Service) protoFileToDescriptor(files map[string]filesystem.File) ([]*desc.FileDescriptor, error) {
+// Compile errors are returned per file, so that they can be reported in the diagnostics.
+func (s *Service) protoFileToDescriptor(files map[string]filesystem.File) ([]*desc.FileDescriptor, map[string][]string, error) {
 	filesStr := make(map[string]string, len(files))
 	filePaths := make([]string, 0, len(filesStr))
 	for _, file := range files {
@@ -586,7 +683,7 @@ func (s *Service) protoFileToDescriptor(files map[string]filesystem.File) ([]*de
 	// These are added in the embed package, and here we add them to the map for parsing.
 	commonProtoMap, err := embed.CommonProtoFileMap()
 	if err != nil {
-		return nil, fmt.Errorf("failed to load common protobuf types: %w", err)
+		return nil, nil, fmt.Errorf("failed to load common protobuf types: %w", err)
 	}
 
 	for commonPath, commonSchema := range commonProtoMap {
@@ -595,8 +692,10 @@ func (s *Service) protoFileToDescriptor(files map[string]filesystem.File) ([]*de
 		}
 	}
 
+	compileErrors := make(map[string][]string)
 	errorReporter := func(err protoparse.ErrorWithPos) error {
 		position := err.GetPosition()
+		compileErrors[position.Filename] = append(compileErrors[position.Filename], err.Error())
 		s.logger.Warn("failed to parse proto file to descriptor",
 			zap.String("file", position.Filename),
 			zap.Int("line", position.Line),
@@ -614,10 +713,21 @@ func (s *Service) protoFileToDescriptor(files map[string]filesystem.File) ([]*de
 	}
 	descriptors, err := parser.ParseFiles(filePaths...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse proto files to descriptors: %w", err)
+		return nil, compileErrors, fmt.Errorf("failed to parse proto files to descriptors: %w", err)
 	}
 
-	return descriptors, nil
+	return descriptors, compileErrors, nil
+}
+
+// withDescriptorSetExtensions returns the allowed file extensions of a file provider
+// extended by the descriptor set file extensions.
+func withDescriptorSetExtensions(allowed, descriptorSetExtensions []string) []string {
+	extensions := make([]string, 0, len(allowed)+len(descriptorSetExtensions))
+	extensions = append(extensions, allowed...)
+	for _, ext := range descriptorSetExtensions {
+		extensions = append(extensions, strings.TrimPrefix(ext, "."))
+	}
+	return extensions
 }
 
 func (s *Service) setFileDescriptorsBySchemaID(descriptors map[int]*desc.FileDescriptor) {
@@ -81,26 +81,66 @@ const (
 	// KafkaConnectServiceResetConnectorTopicsProcedure is the fully-qualified name of the
 	// KafkaConnectService's ResetConnectorTopics RPC.
 	KafkaConnectServiceResetConnectorTopicsProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorTopics"
+	// KafkaConnectServiceListConnectLoggersProcedure is the fully-qualified name of the
+	// KafkaConnectService's ListConnectLoggers RPC.
+	KafkaConnectServiceListConnectLoggersProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectLoggers"
+	// KafkaConnectServiceGetConnectLoggerProcedure is the fully-qualified name of the
+	// KafkaConnectService's GetConnectLogger RPC.
+	KafkaConnectServiceGetConnectLoggerProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectLogger"
+	// KafkaConnectServiceSetConnectLoggerLevelProcedure is the fully-qualified name of the
+	// KafkaConnectService's SetConnectLoggerLevel RPC.
+	KafkaConnectServiceSetConnectLoggerLevelProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/SetConnectLoggerLevel"
+	// KafkaConnectServiceListConnectorHistoryProcedure is the fully-qualified name of the
+	// KafkaConnectService's ListConnectorHistory RPC.
+	KafkaConnectServiceListConnectorHistoryProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorHistory"
+	// KafkaConnectServiceDiffConnectorHistoryProcedure is the fully-qualified name of the
+	// KafkaConnectService's DiffConnectorHistory RPC.
+	KafkaConnectServiceDiffConnectorHistoryProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/DiffConnectorHistory"
+	// KafkaConnectServiceRollbackConnectorProcedure is the fully-qualified name of the
+	// KafkaConnectService's RollbackConnector RPC.
+	KafkaConnectServiceRollbackConnectorProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RollbackConnector"
+	// KafkaConnectServiceGetConnectGitOpsStatusProcedure is the fully-qualified name of the
+	// KafkaConnectService's GetConnectGitOpsStatus RPC.
+	KafkaConnectServiceGetConnectGitOpsStatusProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectGitOpsStatus"
+	// KafkaConnectServiceListConnectorOffsetsProcedure is the fully-qualified name of the
+	// KafkaConnectService's ListConnectorOffsets RPC.
+	KafkaConnectServiceListConnectorOffsetsProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorOffsets"
+	// KafkaConnectServiceAlterConnectorOffsetsProcedure is the fully-qualified name of the
+	// KafkaConnectService's AlterConnectorOffsets RPC.
+	KafkaConnectServiceAlterConnectorOffsetsProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/AlterConnectorOffsets"
+	// KafkaConnectServiceResetConnectorOffsetsProcedure is the fully-qualified name of the
+	// KafkaConnectService's ResetConnectorOffsets RPC.
+	KafkaConnectServiceResetConnectorOffsetsProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorOffsets"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	kafkaConnectServiceServiceDescriptor                    = v1alpha1.File_redpanda_api_dataplane_v1alpha1_kafka_connect_proto.Services().ByName("KafkaConnectService")
-	kafkaConnectServiceListConnectClustersMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectClusters")
-	kafkaConnectServiceGetConnectClusterMethodDescriptor    = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectCluster")
-	kafkaConnectServiceListConnectorsMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectors")
-	kafkaConnectServiceCreateConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("CreateConnector")
-	kafkaConnectServiceRestartConnectorMethodDescriptor     = kafkaConnectServiceServiceDescriptor.Methods().ByName("RestartConnector")
-	kafkaConnectServiceGetConnectorMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnector")
-	kafkaConnectServiceGetConnectorStatusMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorStatus")
-	kafkaConnectServicePauseConnectorMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("PauseConnector")
-	kafkaConnectServiceResumeConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResumeConnector")
-	kafkaConnectServiceStopConnectorMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("StopConnector")
-	kafkaConnectServiceDeleteConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("DeleteConnector")
-	kafkaConnectServiceUpsertConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("UpsertConnector")
-	kafkaConnectServiceGetConnectorConfigMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorConfig")
-	kafkaConnectServiceListConnectorTopicsMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectorTopics")
-	kafkaConnectServiceResetConnectorTopicsMethodDescriptor = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResetConnectorTopics")
+	kafkaConnectServiceServiceDescriptor                      = v1alpha1.File_redpanda_api_dataplane_v1alpha1_kafka_connect_proto.Services().ByName("KafkaConnectService")
+	kafkaConnectServiceListConnectClustersMethodDescriptor    = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectClusters")
+	kafkaConnectServiceGetConnectClusterMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectCluster")
+	kafkaConnectServiceListConnectorsMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectors")
+	kafkaConnectServiceCreateConnectorMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("CreateConnector")
+	kafkaConnectServiceRestartConnectorMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("RestartConnector")
+	kafkaConnectServiceGetConnectorMethodDescriptor           = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnector")
+	kafkaConnectServiceGetConnectorStatusMethodDescriptor     = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorStatus")
+	kafkaConnectServicePauseConnectorMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("PauseConnector")
+	kafkaConnectServiceResumeConnectorMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResumeConnector")
+	kafkaConnectServiceStopConnectorMethodDescriptor          = kafkaConnectServiceServiceDescriptor.Methods().ByName("StopConnector")
+	kafkaConnectServiceDeleteConnectorMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("DeleteConnector")
+	kafkaConnectServiceUpsertConnectorMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("UpsertConnector")
+	kafkaConnectServiceGetConnectorConfigMethodDescriptor     = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorConfig")
+	kafkaConnectServiceListConnectorTopicsMethodDescriptor    = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectorTopics")
+	kafkaConnectServiceResetConnectorTopicsMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResetConnectorTopics")
+	kafkaConnectServiceListConnectLoggersMethodDescriptor     = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectLoggers")
+	kafkaConnectServiceGetConnectLoggerMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectLogger")
+	kafkaConnectServiceSetConnectLoggerLevelMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("SetConnectLoggerLevel")
+	kafkaConnectServiceListConnectorHistoryMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectorHistory")
+	kafkaConnectServiceDiffConnectorHistoryMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("DiffConnectorHistory")
+	kafkaConnectServiceRollbackConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("RollbackConnector")
+	kafkaConnectServiceGetConnectGitOpsStatusMethodDescriptor = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectGitOpsStatus")
+	kafkaConnectServiceListConnectorOffsetsMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectorOffsets")
+	kafkaConnectServiceAlterConnectorOffsetsMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("AlterConnectorOffsets")
+	kafkaConnectServiceResetConnectorOffsetsMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResetConnectorOffsets")
 )
 
 // KafkaConnectServiceClient is a client for the redpanda.api.dataplane.v1alpha1.KafkaConnectService
@@ -153,6 +193,30 @@ type KafkaConnectServiceClient interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(context.Context, *connect.Request[v1alpha1.ResetConnectorTopicsRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListConnectLoggers lists the loggers of the connect workers and their levels
+	ListConnectLoggers(context.Context, *connect.Request[v1alpha1.ListConnectLoggersRequest]) (*connect.Response[v1alpha1.ListConnectLoggersResponse], error)
+	// GetConnectLogger gets the level of a single logger of the connect workers
+	GetConnectLogger(context.Context, *connect.Request[v1alpha1.GetConnectLoggerRequest]) (*connect.Response[v1alpha1.GetConnectLoggerResponse], error)
+	// SetConnectLoggerLevel sets the level of a logger on all workers of the
+	// connect cluster, the level is reverted after the given TTL
+	SetConnectLoggerLevel(context.Context, *connect.Request[v1alpha1.SetConnectLoggerLevelRequest]) (*connect.Response[v1alpha1.SetConnectLoggerLevelResponse], error)
+	// ListConnectorHistory lists the recorded config changes of a connector
+	ListConnectorHistory(context.Context, *connect.Request[v1alpha1.ListConnectorHistoryRequest]) (*connect.Response[v1alpha1.ListConnectorHistoryResponse], error)
+	// DiffConnectorHistory compares two recorded versions of a connector config
+	DiffConnectorHistory(context.Context, *connect.Request[v1alpha1.DiffConnectorHistoryRequest]) (*connect.Response[v1alpha1.DiffConnectorHistoryResponse], error)
+	// RollbackConnector applies the config of a recorded version of a connector
+	// again and restarts the connector and its tasks
+	RollbackConnector(context.Context, *connect.Request[v1alpha1.RollbackConnectorRequest]) (*connect.Response[v1alpha1.RollbackConnectorResponse], error)
+	// GetConnectGitOpsStatus returns the drift between the connectors declared in
+	// the GitOps repository and the connectors in all connect clusters
+	GetConnectGitOpsStatus(context.Context, *connect.Request[v1alpha1.GetConnectGitOpsStatusRequest]) (*connect.Response[v1alpha1.GetConnectGitOpsStatusResponse], error)
+	// ListConnectorOffsets lists the offsets of a connector, requires Kafka
+	// Connect 3.6 or newer
+	ListConnectorOffsets(context.Context, *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error)
+	// AlterConnectorOffsets alters the offsets of a stopped connector
+	AlterConnectorOffsets(context.Context, *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error)
+	// ResetConnectorOffsets resets all offsets of a stopped connector
+	ResetConnectorOffsets(context.Context, *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error)
 }
 
 // NewKafkaConnectServiceClient constructs a client for the
@@ -256,26 +320,96 @@ func NewKafkaConnectServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(kafkaConnectServiceResetConnectorTopicsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listConnectLoggers: connect.NewClient[v1alpha1.ListConnectLoggersRequest, v1alpha1.ListConnectLoggersResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceListConnectLoggersProcedure,
+			connect.WithSchema(kafkaConnectServiceListConnectLoggersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getConnectLogger: connect.NewClient[v1alpha1.GetConnectLoggerRequest, v1alpha1.GetConnectLoggerResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceGetConnectLoggerProcedure,
+			connect.WithSchema(kafkaConnectServiceGetConnectLoggerMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setConnectLoggerLevel: connect.NewClient[v1alpha1.SetConnectLoggerLevelRequest, v1alpha1.SetConnectLoggerLevelResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceSetConnectLoggerLevelProcedure,
+			connect.WithSchema(kafkaConnectServiceSetConnectLoggerLevelMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listConnectorHistory: connect.NewClient[v1alpha1.ListConnectorHistoryRequest, v1alpha1.ListConnectorHistoryResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceListConnectorHistoryProcedure,
+			connect.WithSchema(kafkaConnectServiceListConnectorHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		diffConnectorHistory: connect.NewClient[v1alpha1.DiffConnectorHistoryRequest, v1alpha1.DiffConnectorHistoryResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceDiffConnectorHistoryProcedure,
+			connect.WithSchema(kafkaConnectServiceDiffConnectorHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackConnector: connect.NewClient[v1alpha1.RollbackConnectorRequest, v1alpha1.RollbackConnectorResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceRollbackConnectorProcedure,
+			connect.WithSchema(kafkaConnectServiceRollbackConnectorMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getConnectGitOpsStatus: connect.NewClient[v1alpha1.GetConnectGitOpsStatusRequest, v1alpha1.GetConnectGitOpsStatusResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceGetConnectGitOpsStatusProcedure,
+			connect.WithSchema(kafkaConnectServiceGetConnectGitOpsStatusMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listConnectorOffsets: connect.NewClient[v1alpha1.ListConnectorOffsetsRequest, v1alpha1.ListConnectorOffsetsResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceListConnectorOffsetsProcedure,
+			connect.WithSchema(kafkaConnectServiceListConnectorOffsetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		alterConnectorOffsets: connect.NewClient[v1alpha1.AlterConnectorOffsetsRequest, v1alpha1.AlterConnectorOffsetsResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceAlterConnectorOffsetsProcedure,
+			connect.WithSchema(kafkaConnectServiceAlterConnectorOffsetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resetConnectorOffsets: connect.NewClient[v1alpha1.ResetConnectorOffsetsRequest, v1alpha1.ResetConnectorOffsetsResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceResetConnectorOffsetsProcedure,
+			connect.WithSchema(kafkaConnectServiceResetConnectorOffsetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // kafkaConnectServiceClient implements KafkaConnectServiceClient.
 type kafkaConnectServiceClient struct {
-	listConnectClusters  *connect.Client[v1alpha1.ListConnectClustersRequest, v1alpha1.ListConnectClustersResponse]
-	getConnectCluster    *connect.Client[v1alpha1.GetConnectClusterRequest, v1alpha1.GetConnectClusterResponse]
-	listConnectors       *connect.Client[v1alpha1.ListConnectorsRequest, v1alpha1.ListConnectorsResponse]
-	createConnector      *connect.Client[v1alpha1.CreateConnectorRequest, v1alpha1.CreateConnectorResponse]
-	restartConnector     *connect.Client[v1alpha1.RestartConnectorRequest, emptypb.Empty]
-	getConnector         *connect.Client[v1alpha1.GetConnectorRequest, v1alpha1.GetConnectorResponse]
-	getConnectorStatus   *connect.Client[v1alpha1.GetConnectorStatusRequest, v1alpha1.GetConnectorStatusResponse]
-	pauseConnector       *connect.Client[v1alpha1.PauseConnectorRequest, emptypb.Empty]
-	resumeConnector      *connect.Client[v1alpha1.ResumeConnectorRequest, emptypb.Empty]
-	stopConnector        *connect.Client[v1alpha1.StopConnectorRequest, emptypb.Empty]
-	deleteConnector      *connect.Client[v1alpha1.DeleteConnectorRequest, emptypb.Empty]
-	upsertConnector      *connect.Client[v1alpha1.UpsertConnectorRequest, v1alpha1.UpsertConnectorResponse]
-	getConnectorConfig   *connect.Client[v1alpha1.GetConnectorConfigRequest, v1alpha1.GetConnectorConfigResponse]
-	listConnectorTopics  *connect.Client[v1alpha1.ListConnectorTopicsRequest, v1alpha1.ListConnectorTopicsResponse]
-	resetConnectorTopics *connect.Client[v1alpha1.ResetConnectorTopicsRequest, emptypb.Empty]
+	listConnectClusters    *connect.Client[v1alpha1.ListConnectClustersRequest, v1alpha1.ListConnectClustersResponse]
+	getConnectCluster      *connect.Client[v1alpha1.GetConnectClusterRequest, v1alpha1.GetConnectClusterResponse]
+	listConnectors         *connect.Client[v1alpha1.ListConnectorsRequest, v1alpha1.ListConnectorsResponse]
+	createConnector        *connect.Client[v1alpha1.CreateConnectorRequest, v1alpha1.CreateConnectorResponse]
+	restartConnector       *connect.Client[v1alpha1.RestartConnectorRequest, emptypb.Empty]
+	getConnector           *connect.Client[v1alpha1.GetConnectorRequest, v1alpha1.GetConnectorResponse]
+	getConnectorStatus     *connect.Client[v1alpha1.GetConnectorStatusRequest, v1alpha1.GetConnectorStatusResponse]
+	pauseConnector         *connect.Client[v1alpha1.PauseConnectorRequest, emptypb.Empty]
+	resumeConnector        *connect.Client[v1alpha1.ResumeConnectorRequest, emptypb.Empty]
+	stopConnector          *connect.Client[v1alpha1.StopConnectorRequest, emptypb.Empty]
+	deleteConnector        *connect.Client[v1alpha1.DeleteConnectorRequest, emptypb.Empty]
+	upsertConnector        *connect.Client[v1alpha1.UpsertConnectorRequest, v1alpha1.UpsertConnectorResponse]
+	getConnectorConfig     *connect.Client[v1alpha1.GetConnectorConfigRequest, v1alpha1.GetConnectorConfigResponse]
+	listConnectorTopics    *connect.Client[v1alpha1.ListConnectorTopicsRequest, v1alpha1.ListConnectorTopicsResponse]
+	resetConnectorTopics   *connect.Client[v1alpha1.ResetConnectorTopicsRequest, emptypb.Empty]
+	listConnectLoggers     *connect.Client[v1alpha1.ListConnectLoggersRequest, v1alpha1.ListConnectLoggersResponse]
+	getConnectLogger       *connect.Client[v1alpha1.GetConnectLoggerRequest, v1alpha1.GetConnectLoggerResponse]
+	setConnectLoggerLevel  *connect.Client[v1alpha1.SetConnectLoggerLevelRequest, v1alpha1.SetConnectLoggerLevelResponse]
+	listConnectorHistory   *connect.Client[v1alpha1.ListConnectorHistoryRequest, v1alpha1.ListConnectorHistoryResponse]
+	diffConnectorHistory   *connect.Client[v1alpha1.DiffConnectorHistoryRequest, v1alpha1.DiffConnectorHistoryResponse]
+	rollbackConnector      *connect.Client[v1alpha1.RollbackConnectorRequest, v1alpha1.RollbackConnectorResponse]
+	getConnectGitOpsStatus *connect.Client[v1alpha1.GetConnectGitOpsStatusRequest, v1alpha1.GetConnectGitOpsStatusResponse]
+	listConnectorOffsets   *connect.Client[v1alpha1.ListConnectorOffsetsRequest, v1alpha1.ListConnectorOffsetsResponse]
+	alterConnectorOffsets  *connect.Client[v1alpha1.AlterConnectorOffsetsRequest, v1alpha1.AlterConnectorOffsetsResponse]
+	resetConnectorOffsets  *connect.Client[v1alpha1.ResetConnectorOffsetsRequest, v1alpha1.ResetConnectorOffsetsResponse]
 }
 
 // ListConnectClusters calls
@@ -356,6 +490,63 @@ func (c *kafkaConnectServiceClient) ResetConnectorTopics(ctx context.Context, re
 	return c.resetConnectorTopics.CallUnary(ctx, req)
 }
 
+// ListConnectLoggers calls redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectLoggers.
+func (c *kafkaConnectServiceClient) ListConnectLoggers(ctx context.Context, req *connect.Request[v1alpha1.ListConnectLoggersRequest]) (*connect.Response[v1alpha1.ListConnectLoggersResponse], error) {
+	return c.listConnectLoggers.CallUnary(ctx, req)
+}
+
+// GetConnectLogger calls redpanda.api.dataplane.v1alpha1.KafkaConnectService.GetConnectLogger.
+func (c *kafkaConnectServiceClient) GetConnectLogger(ctx context.Context, req *connect.Request[v1alpha1.GetConnectLoggerRequest]) (*connect.Response[v1alpha1.GetConnectLoggerResponse], error) {
+	return c.getConnectLogger.CallUnary(ctx, req)
+}
+
+// SetConnectLoggerLevel calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.SetConnectLoggerLevel.
+func (c *kafkaConnectServiceClient) SetConnectLoggerLevel(ctx context.Context, req *connect.Request[v1alpha1.SetConnectLoggerLevelRequest]) (*connect.Response[v1alpha1.SetConnectLoggerLevelResponse], error) {
+	return c.setConnectLoggerLevel.CallUnary(ctx, req)
+}
+
+// ListConnectorHistory calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectorHistory.
+func (c *kafkaConnectServiceClient) ListConnectorHistory(ctx context.Context, req *connect.Request[v1alpha1.ListConnectorHistoryRequest]) (*connect.Response[v1alpha1.ListConnectorHistoryResponse], error) {
+	return c.listConnectorHistory.CallUnary(ctx, req)
+}
+
+// DiffConnectorHistory calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.DiffConnectorHistory.
+func (c *kafkaConnectServiceClient) DiffConnectorHistory(ctx context.Context, req *connect.Request[v1alpha1.DiffConnectorHistoryRequest]) (*connect.Response[v1alpha1.DiffConnectorHistoryResponse], error) {
+	return c.diffConnectorHistory.CallUnary(ctx, req)
+}
+
+// RollbackConnector calls redpanda.api.dataplane.v1alpha1.KafkaConnectService.RollbackConnector.
+func (c *kafkaConnectServiceClient) RollbackConnector(ctx context.Context, req *connect.Request[v1alpha1.RollbackConnectorRequest]) (*connect.Response[v1alpha1.RollbackConnectorResponse], error) {
+	return c.rollbackConnector.CallUnary(ctx, req)
+}
+
+// GetConnectGitOpsStatus calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.GetConnectGitOpsStatus.
+func (c *kafkaConnectServiceClient) GetConnectGitOpsStatus(ctx context.Context, req *connect.Request[v1alpha1.GetConnectGitOpsStatusRequest]) (*connect.Response[v1alpha1.GetConnectGitOpsStatusResponse], error) {
+	return c.getConnectGitOpsStatus.CallUnary(ctx, req)
+}
+
+// ListConnectorOffsets calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectorOffsets.
+func (c *kafkaConnectServiceClient) ListConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error) {
+	return c.listConnectorOffsets.CallUnary(ctx, req)
+}
+
+// AlterConnectorOffsets calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.AlterConnectorOffsets.
+func (c *kafkaConnectServiceClient) AlterConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error) {
+	return c.alterConnectorOffsets.CallUnary(ctx, req)
+}
+
+// ResetConnectorOffsets calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.ResetConnectorOffsets.
+func (c *kafkaConnectServiceClient) ResetConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error) {
+	return c.resetConnectorOffsets.CallUnary(ctx, req)
+}
+
 // KafkaConnectServiceHandler is an implementation of the
 // redpanda.api.dataplane.v1alpha1.KafkaConnectService service.
 type KafkaConnectServiceHandler interface {
@@ -406,6 +597,30 @@ type KafkaConnectServiceHandler interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(context.Context, *connect.Request[v1alpha1.ResetConnectorTopicsRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListConnectLoggers lists the loggers of the connect workers and their levels
+	ListConnectLoggers(context.Context, *connect.Request[v1alpha1.ListConnectLoggersRequest]) (*connect.Response[v1alpha1.ListConnectLoggersResponse], error)
+	// GetConnectLogger gets the level of a single logger of the connect workers
+	GetConnectLogger(context.Context, *connect.Request[v1alpha1.GetConnectLoggerRequest]) (*connect.Response[v1alpha1.GetConnectLoggerResponse], error)
+	// SetConnectLoggerLevel sets the level of a logger on all workers of the
+	// connect cluster, the level is reverted after the given TTL
+	SetConnectLoggerLevel(context.Context, *connect.Request[v1alpha1.SetConnectLoggerLevelRequest]) (*connect.Response[v1alpha1.SetConnectLoggerLevelResponse], error)
+	// ListConnectorHistory lists the recorded config changes of a connector
+	ListConnectorHistory(context.Context, *connect.Request[v1alpha1.ListConnectorHistoryRequest]) (*connect.Response[v1alpha1.ListConnectorHistoryResponse], error)
+	// DiffConnectorHistory compares two recorded versions of a connector config
+	DiffConnectorHistory(context.Context, *connect.Request[v1alpha1.DiffConnectorHistoryRequest]) (*connect.Response[v1alpha1.DiffConnectorHistoryResponse], error)
+	// RollbackConnector applies the config of a recorded version of a connector
+	// again and restarts the connector and its tasks
+	RollbackConnector(context.Context, *connect.Request[v1alpha1.RollbackConnectorRequest]) (*connect.Response[v1alpha1.RollbackConnectorResponse], error)
+	// GetConnectGitOpsStatus returns the drift between the connectors declared in
+	// the GitOps repository and the connectors in all connect clusters
+	GetConnectGitOpsStatus(context.Context, *connect.Request[v1alpha1.GetConnectGitOpsStatusRequest]) (*connect.Response[v1alpha1.GetConnectGitOpsStatusResponse], error)
+	// ListConnectorOffsets lists the offsets of a connector, requires Kafka
+	// Connect 3.6 or newer
+	ListConnectorOffsets(context.Context, *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error)
+	// AlterConnectorOffsets alters the offsets of a stopped connector
+	AlterConnectorOffsets(context.Context, *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error)
+	// ResetConnectorOffsets resets all offsets of a stopped connector
+	ResetConnectorOffsets(context.Context, *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error)
 }
 
 // NewKafkaConnectServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -504,6 +719,66 @@ func NewKafkaConnectServiceHandler(svc KafkaConnectServiceHandler, opts ...conne
 		connect.WithSchema(kafkaConnectServiceResetConnectorTopicsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kafkaConnectServiceListConnectLoggersHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceListConnectLoggersProcedure,
+		svc.ListConnectLoggers,
+		connect.WithSchema(kafkaConnectServiceListConnectLoggersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceGetConnectLoggerHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceGetConnectLoggerProcedure,
+		svc.GetConnectLogger,
+		connect.WithSchema(kafkaConnectServiceGetConnectLoggerMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceSetConnectLoggerLevelHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceSetConnectLoggerLevelProcedure,
+		svc.SetConnectLoggerLevel,
+		connect.WithSchema(kafkaConnectServiceSetConnectLoggerLevelMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceListConnectorHistoryHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceListConnectorHistoryProcedure,
+		svc.ListConnectorHistory,
+		connect.WithSchema(kafkaConnectServiceListConnectorHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceDiffConnectorHistoryHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceDiffConnectorHistoryProcedure,
+		svc.DiffConnectorHistory,
+		connect.WithSchema(kafkaConnectServiceDiffConnectorHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceRollbackConnectorHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceRollbackConnectorProcedure,
+		svc.RollbackConnector,
+		connect.WithSchema(kafkaConnectServiceRollbackConnectorMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceGetConnectGitOpsStatusHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceGetConnectGitOpsStatusProcedure,
+		svc.GetConnectGitOpsStatus,
+		connect.WithSchema(kafkaConnectServiceGetConnectGitOpsStatusMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceListConnectorOffsetsHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceListConnectorOffsetsProcedure,
+		svc.ListConnectorOffsets,
+		connect.WithSchema(kafkaConnectServiceListConnectorOffsetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceAlterConnectorOffsetsHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceAlterConnectorOffsetsProcedure,
+		svc.AlterConnectorOffsets,
+		connect.WithSchema(kafkaConnectServiceAlterConnectorOffsetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceResetConnectorOffsetsHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceResetConnectorOffsetsProcedure,
+		svc.ResetConnectorOffsets,
+		connect.WithSchema(kafkaConnectServiceResetConnectorOffsetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case KafkaConnectServiceListConnectClustersProcedure:
@@ -536,6 +811,26 @@ func NewKafkaConnectServiceHandler(svc KafkaConnectServiceHandler, opts ...conne
 			kafkaConnectServiceListConnectorTopicsHandler.ServeHTTP(w, r)
 		case KafkaConnectServiceResetConnectorTopicsProcedure:
 			kafkaConnectServiceResetConnectorTopicsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceListConnectLoggersProcedure:
+			kafkaConnectServiceListConnectLoggersHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceGetConnectLoggerProcedure:
+			kafkaConnectServiceGetConnectLoggerHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceSetConnectLoggerLevelProcedure:
+			kafkaConnectServiceSetConnectLoggerLevelHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceListConnectorHistoryProcedure:
+			kafkaConnectServiceListConnectorHistoryHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceDiffConnectorHistoryProcedure:
+			kafkaConnectServiceDiffConnectorHistoryHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceRollbackConnectorProcedure:
+			kafkaConnectServiceRollbackConnectorHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceGetConnectGitOpsStatusProcedure:
+			kafkaConnectServiceGetConnectGitOpsStatusHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceListConnectorOffsetsProcedure:
+			kafkaConnectServiceListConnectorOffsetsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceAlterConnectorOffsetsProcedure:
+			kafkaConnectServiceAlterConnectorOffsetsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceResetConnectorOffsetsProcedure:
+			kafkaConnectServiceResetConnectorOffsetsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -604,3 +899,43 @@ func (UnimplementedKafkaConnectServiceHandler) ListConnectorTopics(context.Conte
 func (UnimplementedKafkaConnectServiceHandler) ResetConnectorTopics(context.Context, *connect.Request[v1alpha1.ResetConnectorTopicsRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.ResetConnectorTopics is not implemented"))
 }
+
+func (UnimplementedKafkaConnectServiceHandler) ListConnectLoggers(context.Context, *connect.Request[v1alpha1.ListConnectLoggersRequest]) (*connect.Response[v1alpha1.ListConnectLoggersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectLoggers is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) GetConnectLogger(context.Context, *connect.Request[v1alpha1.GetConnectLoggerRequest]) (*connect.Response[v1alpha1.GetConnectLoggerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.GetConnectLogger is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) SetConnectLoggerLevel(context.Context, *connect.Request[v1alpha1.SetConnectLoggerLevelRequest]) (*connect.Response[v1alpha1.SetConnectLoggerLevelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.SetConnectLoggerLevel is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) ListConnectorHistory(context.Context, *connect.Request[v1alpha1.ListConnectorHistoryRequest]) (*connect.Response[v1alpha1.ListConnectorHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectorHistory is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) DiffConnectorHistory(context.Context, *connect.Request[v1alpha1.DiffConnectorHistoryRequest]) (*connect.Response[v1alpha1.DiffConnectorHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.DiffConnectorHistory is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) RollbackConnector(context.Context, *connect.Request[v1alpha1.RollbackConnectorRequest]) (*connect.Response[v1alpha1.RollbackConnectorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.RollbackConnector is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) GetConnectGitOpsStatus(context.Context, *connect.Request[v1alpha1.GetConnectGitOpsStatusRequest]) (*connect.Response[v1alpha1.GetConnectGitOpsStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.GetConnectGitOpsStatus is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) ListConnectorOffsets(context.Context, *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectorOffsets is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) AlterConnectorOffsets(context.Context, *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.AlterConnectorOffsets is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) ResetConnectorOffsets(context.Context, *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.ResetConnectorOffsets is not implemented"))
+}
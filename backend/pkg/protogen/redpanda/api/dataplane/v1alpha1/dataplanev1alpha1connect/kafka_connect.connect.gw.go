@@ -19,42 +19,62 @@ import (
 // service.
 type KafkaConnectServiceGatewayServer struct {
 	v1alpha1.UnimplementedKafkaConnectServiceServer
-	listConnectClusters  connect_gateway.UnaryHandler[v1alpha1.ListConnectClustersRequest, v1alpha1.ListConnectClustersResponse]
-	getConnectCluster    connect_gateway.UnaryHandler[v1alpha1.GetConnectClusterRequest, v1alpha1.GetConnectClusterResponse]
-	listConnectors       connect_gateway.UnaryHandler[v1alpha1.ListConnectorsRequest, v1alpha1.ListConnectorsResponse]
-	createConnector      connect_gateway.UnaryHandler[v1alpha1.CreateConnectorRequest, v1alpha1.CreateConnectorResponse]
-	restartConnector     connect_gateway.UnaryHandler[v1alpha1.RestartConnectorRequest, emptypb.Empty]
-	getConnector         connect_gateway.UnaryHandler[v1alpha1.GetConnectorRequest, v1alpha1.GetConnectorResponse]
-	getConnectorStatus   connect_gateway.UnaryHandler[v1alpha1.GetConnectorStatusRequest, v1alpha1.GetConnectorStatusResponse]
-	pauseConnector       connect_gateway.UnaryHandler[v1alpha1.PauseConnectorRequest, emptypb.Empty]
-	resumeConnector      connect_gateway.UnaryHandler[v1alpha1.ResumeConnectorRequest, emptypb.Empty]
-	stopConnector        connect_gateway.UnaryHandler[v1alpha1.StopConnectorRequest, emptypb.Empty]
-	deleteConnector      connect_gateway.UnaryHandler[v1alpha1.DeleteConnectorRequest, emptypb.Empty]
-	upsertConnector      connect_gateway.UnaryHandler[v1alpha1.UpsertConnectorRequest, v1alpha1.UpsertConnectorResponse]
-	getConnectorConfig   connect_gateway.UnaryHandler[v1alpha1.GetConnectorConfigRequest, v1alpha1.GetConnectorConfigResponse]
-	listConnectorTopics  connect_gateway.UnaryHandler[v1alpha1.ListConnectorTopicsRequest, v1alpha1.ListConnectorTopicsResponse]
-	resetConnectorTopics connect_gateway.UnaryHandler[v1alpha1.ResetConnectorTopicsRequest, emptypb.Empty]
+	listConnectClusters    connect_gateway.UnaryHandler[v1alpha1.ListConnectClustersRequest, v1alpha1.ListConnectClustersResponse]
+	getConnectCluster      connect_gateway.UnaryHandler[v1alpha1.GetConnectClusterRequest, v1alpha1.GetConnectClusterResponse]
+	listConnectors         connect_gateway.UnaryHandler[v1alpha1.ListConnectorsRequest, v1alpha1.ListConnectorsResponse]
+	createConnector        connect_gateway.UnaryHandler[v1alpha1.CreateConnectorRequest, v1alpha1.CreateConnectorResponse]
+	restartConnector       connect_gateway.UnaryHandler[v1alpha1.RestartConnectorRequest, emptypb.Empty]
+	getConnector           connect_gateway.UnaryHandler[v1alpha1.GetConnectorRequest, v1alpha1.GetConnectorResponse]
+	getConnectorStatus     connect_gateway.UnaryHandler[v1alpha1.GetConnectorStatusRequest, v1alpha1.GetConnectorStatusResponse]
+	pauseConnector         connect_gateway.UnaryHandler[v1alpha1.PauseConnectorRequest, emptypb.Empty]
+	resumeConnector        connect_gateway.UnaryHandler[v1alpha1.ResumeConnectorRequest, emptypb.Empty]
+	stopConnector          connect_gateway.UnaryHandler[v1alpha1.StopConnectorRequest, emptypb.Empty]
+	deleteConnector        connect_gateway.UnaryHandler[v1alpha1.DeleteConnectorRequest, emptypb.Empty]
+	upsertConnector        connect_gateway.UnaryHandler[v1alpha1.UpsertConnectorRequest, v1alpha1.UpsertConnectorResponse]
+	getConnectorConfig     connect_gateway.UnaryHandler[v1alpha1.GetConnectorConfigRequest, v1alpha1.GetConnectorConfigResponse]
+	listConnectorTopics    connect_gateway.UnaryHandler[v1alpha1.ListConnectorTopicsRequest, v1alpha1.ListConnectorTopicsResponse]
+	resetConnectorTopics   connect_gateway.UnaryHandler[v1alpha1.ResetConnectorTopicsRequest, emptypb.Empty]
+	listConnectLoggers     connect_gateway.UnaryHandler[v1alpha1.ListConnectLoggersRequest, v1alpha1.ListConnectLoggersResponse]
+	getConnectLogger       connect_gateway.UnaryHandler[v1alpha1.GetConnectLoggerRequest, v1alpha1.GetConnectLoggerResponse]
+	setConnectLoggerLevel  connect_gateway.UnaryHandler[v1alpha1.SetConnectLoggerLevelRequest, v1alpha1.SetConnectLoggerLevelResponse]
+	listConnectorHistory   connect_gateway.UnaryHandler[v1alpha1.ListConnectorHistoryRequest, v1alpha1.ListConnectorHistoryResponse]
+	diffConnectorHistory   connect_gateway.UnaryHandler[v1alpha1.DiffConnectorHistoryRequest, v1alpha1.DiffConnectorHistoryResponse]
+	rollbackConnector      connect_gateway.UnaryHandler[v1alpha1.RollbackConnectorRequest, v1alpha1.RollbackConnectorResponse]
+	getConnectGitOpsStatus connect_gateway.UnaryHandler[v1alpha1.GetConnectGitOpsStatusRequest, v1alpha1.GetConnectGitOpsStatusResponse]
+	listConnectorOffsets   connect_gateway.UnaryHandler[v1alpha1.ListConnectorOffsetsRequest, v1alpha1.ListConnectorOffsetsResponse]
+	alterConnectorOffsets  connect_gateway.UnaryHandler[v1alpha1.AlterConnectorOffsetsRequest, v1alpha1.AlterConnectorOffsetsResponse]
+	resetConnectorOffsets  connect_gateway.UnaryHandler[v1alpha1.ResetConnectorOffsetsRequest, v1alpha1.ResetConnectorOffsetsResponse]
 }
 
 // NewKafkaConnectServiceGatewayServer constructs a Connect-Gateway gRPC server for the
 // KafkaConnectService service.
 func NewKafkaConnectServiceGatewayServer(svc KafkaConnectServiceHandler, opts ...connect_gateway.HandlerOption) *KafkaConnectServiceGatewayServer {
 	return &KafkaConnectServiceGatewayServer{
-		listConnectClusters:  connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectClustersProcedure, svc.ListConnectClusters, opts...),
-		getConnectCluster:    connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectClusterProcedure, svc.GetConnectCluster, opts...),
-		listConnectors:       connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorsProcedure, svc.ListConnectors, opts...),
-		createConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceCreateConnectorProcedure, svc.CreateConnector, opts...),
-		restartConnector:     connect_gateway.NewUnaryHandler(KafkaConnectServiceRestartConnectorProcedure, svc.RestartConnector, opts...),
-		getConnector:         connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorProcedure, svc.GetConnector, opts...),
-		getConnectorStatus:   connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorStatusProcedure, svc.GetConnectorStatus, opts...),
-		pauseConnector:       connect_gateway.NewUnaryHandler(KafkaConnectServicePauseConnectorProcedure, svc.PauseConnector, opts...),
-		resumeConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceResumeConnectorProcedure, svc.ResumeConnector, opts...),
-		stopConnector:        connect_gateway.NewUnaryHandler(KafkaConnectServiceStopConnectorProcedure, svc.StopConnector, opts...),
-		deleteConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceDeleteConnectorProcedure, svc.DeleteConnector, opts...),
-		upsertConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceUpsertConnectorProcedure, svc.UpsertConnector, opts...),
-		getConnectorConfig:   connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorConfigProcedure, svc.GetConnectorConfig, opts...),
-		listConnectorTopics:  connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorTopicsProcedure, svc.ListConnectorTopics, opts...),
-		resetConnectorTopics: connect_gateway.NewUnaryHandler(KafkaConnectServiceResetConnectorTopicsProcedure, svc.ResetConnectorTopics, opts...),
+		listConnectClusters:    connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectClustersProcedure, svc.ListConnectClusters, opts...),
+		getConnectCluster:      connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectClusterProcedure, svc.GetConnectCluster, opts...),
+		listConnectors:         connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorsProcedure, svc.ListConnectors, opts...),
+		createConnector:        connect_gateway.NewUnaryHandler(KafkaConnectServiceCreateConnectorProcedure, svc.CreateConnector, opts...),
+		restartConnector:       connect_gateway.NewUnaryHandler(KafkaConnectServiceRestartConnectorProcedure, svc.RestartConnector, opts...),
+		getConnector:           connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorProcedure, svc.GetConnector, opts...),
+		getConnectorStatus:     connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorStatusProcedure, svc.GetConnectorStatus, opts...),
+		pauseConnector:         connect_gateway.NewUnaryHandler(KafkaConnectServicePauseConnectorProcedure, svc.PauseConnector, opts...),
+		resumeConnector:        connect_gateway.NewUnaryHandler(KafkaConnectServiceResumeConnectorProcedure, svc.ResumeConnector, opts...),
+		stopConnector:          connect_gateway.NewUnaryHandler(KafkaConnectServiceStopConnectorProcedure, svc.StopConnector, opts...),
+		deleteConnector:        connect_gateway.NewUnaryHandler(KafkaConnectServiceDeleteConnectorProcedure, svc.DeleteConnector, opts...),
+		upsertConnector:        connect_gateway.NewUnaryHandler(KafkaConnectServiceUpsertConnectorProcedure, svc.UpsertConnector, opts...),
+		getConnectorConfig:     connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorConfigProcedure, svc.GetConnectorConfig, opts...),
+		listConnectorTopics:    connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorTopicsProcedure, svc.ListConnectorTopics, opts...),
+		resetConnectorTopics:   connect_gateway.NewUnaryHandler(KafkaConnectServiceResetConnectorTopicsProcedure, svc.ResetConnectorTopics, opts...),
+		listConnectLoggers:     connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectLoggersProcedure, svc.ListConnectLoggers, opts...),
+		getConnectLogger:       connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectLoggerProcedure, svc.GetConnectLogger, opts...),
+		setConnectLoggerLevel:  connect_gateway.NewUnaryHandler(KafkaConnectServiceSetConnectLoggerLevelProcedure, svc.SetConnectLoggerLevel, opts...),
+		listConnectorHistory:   connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorHistoryProcedure, svc.ListConnectorHistory, opts...),
+		diffConnectorHistory:   connect_gateway.NewUnaryHandler(KafkaConnectServiceDiffConnectorHistoryProcedure, svc.DiffConnectorHistory, opts...),
+		rollbackConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceRollbackConnectorProcedure, svc.RollbackConnector, opts...),
+		getConnectGitOpsStatus: connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectGitOpsStatusProcedure, svc.GetConnectGitOpsStatus, opts...),
+		listConnectorOffsets:   connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorOffsetsProcedure, svc.ListConnectorOffsets, opts...),
+		alterConnectorOffsets:  connect_gateway.NewUnaryHandler(KafkaConnectServiceAlterConnectorOffsetsProcedure, svc.AlterConnectorOffsets, opts...),
+		resetConnectorOffsets:  connect_gateway.NewUnaryHandler(KafkaConnectServiceResetConnectorOffsetsProcedure, svc.ResetConnectorOffsets, opts...),
 	}
 }
 
@@ -118,6 +138,46 @@ func (s *KafkaConnectServiceGatewayServer) ResetConnectorTopics(ctx context.Cont
 	return s.resetConnectorTopics(ctx, req)
 }
 
+func (s *KafkaConnectServiceGatewayServer) ListConnectLoggers(ctx context.Context, req *v1alpha1.ListConnectLoggersRequest) (*v1alpha1.ListConnectLoggersResponse, error) {
+	return s.listConnectLoggers(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) GetConnectLogger(ctx context.Context, req *v1alpha1.GetConnectLoggerRequest) (*v1alpha1.GetConnectLoggerResponse, error) {
+	return s.getConnectLogger(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) SetConnectLoggerLevel(ctx context.Context, req *v1alpha1.SetConnectLoggerLevelRequest) (*v1alpha1.SetConnectLoggerLevelResponse, error) {
+	return s.setConnectLoggerLevel(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) ListConnectorHistory(ctx context.Context, req *v1alpha1.ListConnectorHistoryRequest) (*v1alpha1.ListConnectorHistoryResponse, error) {
+	return s.listConnectorHistory(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) DiffConnectorHistory(ctx context.Context, req *v1alpha1.DiffConnectorHistoryRequest) (*v1alpha1.DiffConnectorHistoryResponse, error) {
+	return s.diffConnectorHistory(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) RollbackConnector(ctx context.Context, req *v1alpha1.RollbackConnectorRequest) (*v1alpha1.RollbackConnectorResponse, error) {
+	return s.rollbackConnector(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) GetConnectGitOpsStatus(ctx context.Context, req *v1alpha1.GetConnectGitOpsStatusRequest) (*v1alpha1.GetConnectGitOpsStatusResponse, error) {
+	return s.getConnectGitOpsStatus(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) ListConnectorOffsets(ctx context.Context, req *v1alpha1.ListConnectorOffsetsRequest) (*v1alpha1.ListConnectorOffsetsResponse, error) {
+	return s.listConnectorOffsets(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) AlterConnectorOffsets(ctx context.Context, req *v1alpha1.AlterConnectorOffsetsRequest) (*v1alpha1.AlterConnectorOffsetsResponse, error) {
+	return s.alterConnectorOffsets(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) ResetConnectorOffsets(ctx context.Context, req *v1alpha1.ResetConnectorOffsetsRequest) (*v1alpha1.ResetConnectorOffsetsResponse, error) {
+	return s.resetConnectorOffsets(ctx, req)
+}
+
 // RegisterKafkaConnectServiceHandlerGatewayServer registers the Connect handlers for the
 // KafkaConnectService "svc" to "mux".
 func RegisterKafkaConnectServiceHandlerGatewayServer(mux *runtime.ServeMux, svc KafkaConnectServiceHandler, opts ...connect_gateway.HandlerOption) {
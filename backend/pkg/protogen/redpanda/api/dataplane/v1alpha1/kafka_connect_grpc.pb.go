@@ -21,21 +21,31 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	KafkaConnectService_ListConnectClusters_FullMethodName  = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectClusters"
-	KafkaConnectService_GetConnectCluster_FullMethodName    = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectCluster"
-	KafkaConnectService_ListConnectors_FullMethodName       = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectors"
-	KafkaConnectService_CreateConnector_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/CreateConnector"
-	KafkaConnectService_RestartConnector_FullMethodName     = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RestartConnector"
-	KafkaConnectService_GetConnector_FullMethodName         = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnector"
-	KafkaConnectService_GetConnectorStatus_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorStatus"
-	KafkaConnectService_PauseConnector_FullMethodName       = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/PauseConnector"
-	KafkaConnectService_ResumeConnector_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResumeConnector"
-	KafkaConnectService_StopConnector_FullMethodName        = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/StopConnector"
-	KafkaConnectService_DeleteConnector_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/DeleteConnector"
-	KafkaConnectService_UpsertConnector_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/UpsertConnector"
-	KafkaConnectService_GetConnectorConfig_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorConfig"
-	KafkaConnectService_ListConnectorTopics_FullMethodName  = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorTopics"
-	KafkaConnectService_ResetConnectorTopics_FullMethodName = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorTopics"
+	KafkaConnectService_ListConnectClusters_FullMethodName    = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectClusters"
+	KafkaConnectService_GetConnectCluster_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectCluster"
+	KafkaConnectService_ListConnectors_FullMethodName         = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectors"
+	KafkaConnectService_CreateConnector_FullMethodName        = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/CreateConnector"
+	KafkaConnectService_RestartConnector_FullMethodName       = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RestartConnector"
+	KafkaConnectService_GetConnector_FullMethodName           = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnector"
+	KafkaConnectService_GetConnectorStatus_FullMethodName     = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorStatus"
+	KafkaConnectService_PauseConnector_FullMethodName         = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/PauseConnector"
+	KafkaConnectService_ResumeConnector_FullMethodName        = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResumeConnector"
+	KafkaConnectService_StopConnector_FullMethodName          = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/StopConnector"
+	KafkaConnectService_DeleteConnector_FullMethodName        = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/DeleteConnector"
+	KafkaConnectService_UpsertConnector_FullMethodName        = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/UpsertConnector"
+	KafkaConnectService_GetConnectorConfig_FullMethodName     = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorConfig"
+	KafkaConnectService_ListConnectorTopics_FullMethodName    = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorTopics"
+	KafkaConnectService_ResetConnectorTopics_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorTopics"
+	KafkaConnectService_ListConnectLoggers_FullMethodName     = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectLoggers"
+	KafkaConnectService_GetConnectLogger_FullMethodName       = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectLogger"
+	KafkaConnectService_SetConnectLoggerLevel_FullMethodName  = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/SetConnectLoggerLevel"
+	KafkaConnectService_ListConnectorHistory_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorHistory"
+	KafkaConnectService_DiffConnectorHistory_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/DiffConnectorHistory"
+	KafkaConnectService_RollbackConnector_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RollbackConnector"
+	KafkaConnectService_GetConnectGitOpsStatus_FullMethodName = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectGitOpsStatus"
+	KafkaConnectService_ListConnectorOffsets_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorOffsets"
+	KafkaConnectService_AlterConnectorOffsets_FullMethodName  = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/AlterConnectorOffsets"
+	KafkaConnectService_ResetConnectorOffsets_FullMethodName  = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorOffsets"
 )
 
 // KafkaConnectServiceClient is the client API for KafkaConnectService service.
@@ -89,6 +99,30 @@ type KafkaConnectServiceClient interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(ctx context.Context, in *ResetConnectorTopicsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListConnectLoggers lists the loggers of the connect workers and their levels
+	ListConnectLoggers(ctx context.Context, in *ListConnectLoggersRequest, opts ...grpc.CallOption) (*ListConnectLoggersResponse, error)
+	// GetConnectLogger gets the level of a single logger of the connect workers
+	GetConnectLogger(ctx context.Context, in *GetConnectLoggerRequest, opts ...grpc.CallOption) (*GetConnectLoggerResponse, error)
+	// SetConnectLoggerLevel sets the level of a logger on all workers of the
+	// connect cluster, the level is reverted after the given TTL
+	SetConnectLoggerLevel(ctx context.Context, in *SetConnectLoggerLevelRequest, opts ...grpc.CallOption) (*SetConnectLoggerLevelResponse, error)
+	// ListConnectorHistory lists the recorded config changes of a connector
+	ListConnectorHistory(ctx context.Context, in *ListConnectorHistoryRequest, opts ...grpc.CallOption) (*ListConnectorHistoryResponse, error)
+	// DiffConnectorHistory compares two recorded versions of a connector config
+	DiffConnectorHistory(ctx context.Context, in *DiffConnectorHistoryRequest, opts ...grpc.CallOption) (*DiffConnectorHistoryResponse, error)
+	// RollbackConnector applies the config of a recorded version of a connector
+	// again and restarts the connector and its tasks
+	RollbackConnector(ctx context.Context, in *RollbackConnectorRequest, opts ...grpc.CallOption) (*RollbackConnectorResponse, error)
+	// GetConnectGitOpsStatus returns the drift between the connectors declared in
+	// the GitOps repository and the connectors in all connect clusters
+	GetConnectGitOpsStatus(ctx context.Context, in *GetConnectGitOpsStatusRequest, opts ...grpc.CallOption) (*GetConnectGitOpsStatusResponse, error)
+	// ListConnectorOffsets lists the offsets of a connector, requires Kafka
+	// Connect 3.6 or newer
+	ListConnectorOffsets(ctx context.Context, in *ListConnectorOffsetsRequest, opts ...grpc.CallOption) (*ListConnectorOffsetsResponse, error)
+	// AlterConnectorOffsets alters the offsets of a stopped connector
+	AlterConnectorOffsets(ctx context.Context, in *AlterConnectorOffsetsRequest, opts ...grpc.CallOption) (*AlterConnectorOffsetsResponse, error)
+	// ResetConnectorOffsets resets all offsets of a stopped connector
+	ResetConnectorOffsets(ctx context.Context, in *ResetConnectorOffsetsRequest, opts ...grpc.CallOption) (*ResetConnectorOffsetsResponse, error)
 }
 
 type kafkaConnectServiceClient struct {
@@ -234,6 +268,96 @@ func (c *kafkaConnectServiceClient) ResetConnectorTopics(ctx context.Context, in
 	return out, nil
 }
 
+func (c *kafkaConnectServiceClient) ListConnectLoggers(ctx context.Context, in *ListConnectLoggersRequest, opts ...grpc.CallOption) (*ListConnectLoggersResponse, error) {
+	out := new(ListConnectLoggersResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_ListConnectLoggers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) GetConnectLogger(ctx context.Context, in *GetConnectLoggerRequest, opts ...grpc.CallOption) (*GetConnectLoggerResponse, error) {
+	out := new(GetConnectLoggerResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_GetConnectLogger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) SetConnectLoggerLevel(ctx context.Context, in *SetConnectLoggerLevelRequest, opts ...grpc.CallOption) (*SetConnectLoggerLevelResponse, error) {
+	out := new(SetConnectLoggerLevelResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_SetConnectLoggerLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) ListConnectorHistory(ctx context.Context, in *ListConnectorHistoryRequest, opts ...grpc.CallOption) (*ListConnectorHistoryResponse, error) {
+	out := new(ListConnectorHistoryResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_ListConnectorHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) DiffConnectorHistory(ctx context.Context, in *DiffConnectorHistoryRequest, opts ...grpc.CallOption) (*DiffConnectorHistoryResponse, error) {
+	out := new(DiffConnectorHistoryResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_DiffConnectorHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) RollbackConnector(ctx context.Context, in *RollbackConnectorRequest, opts ...grpc.CallOption) (*RollbackConnectorResponse, error) {
+	out := new(RollbackConnectorResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_RollbackConnector_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) GetConnectGitOpsStatus(ctx context.Context, in *GetConnectGitOpsStatusRequest, opts ...grpc.CallOption) (*GetConnectGitOpsStatusResponse, error) {
+	out := new(GetConnectGitOpsStatusResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_GetConnectGitOpsStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) ListConnectorOffsets(ctx context.Context, in *ListConnectorOffsetsRequest, opts ...grpc.CallOption) (*ListConnectorOffsetsResponse, error) {
+	out := new(ListConnectorOffsetsResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_ListConnectorOffsets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) AlterConnectorOffsets(ctx context.Context, in *AlterConnectorOffsetsRequest, opts ...grpc.CallOption) (*AlterConnectorOffsetsResponse, error) {
+	out := new(AlterConnectorOffsetsResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_AlterConnectorOffsets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) ResetConnectorOffsets(ctx context.Context, in *ResetConnectorOffsetsRequest, opts ...grpc.CallOption) (*ResetConnectorOffsetsResponse, error) {
+	out := new(ResetConnectorOffsetsResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_ResetConnectorOffsets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KafkaConnectServiceServer is the server API for KafkaConnectService service.
 // All implementations must embed UnimplementedKafkaConnectServiceServer
 // for forward compatibility
@@ -285,6 +409,30 @@ type KafkaConnectServiceServer interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(context.Context, *ResetConnectorTopicsRequest) (*emptypb.Empty, error)
+	// ListConnectLoggers lists the loggers of the connect workers and their levels
+	ListConnectLoggers(context.Context, *ListConnectLoggersRequest) (*ListConnectLoggersResponse, error)
+	// GetConnectLogger gets the level of a single logger of the connect workers
+	GetConnectLogger(context.Context, *GetConnectLoggerRequest) (*GetConnectLoggerResponse, error)
+	// SetConnectLoggerLevel sets the level of a logger on all workers of the
+	// connect cluster, the level is reverted after the given TTL
+	SetConnectLoggerLevel(context.Context, *SetConnectLoggerLevelRequest) (*SetConnectLoggerLevelResponse, error)
+	// ListConnectorHistory lists the recorded config changes of a connector
+	ListConnectorHistory(context.Context, *ListConnectorHistoryRequest) (*ListConnectorHistoryResponse, error)
+	// DiffConnectorHistory compares two recorded versions of a connector config
+	DiffConnectorHistory(context.Context, *DiffConnectorHistoryRequest) (*DiffConnectorHistoryResponse, error)
+	// RollbackConnector applies the config of a recorded version of a connector
+	// again and restarts the connector and its tasks
+	RollbackConnector(context.Context, *RollbackConnectorRequest) (*RollbackConnectorResponse, error)
+	// GetConnectGitOpsStatus returns the drift between the connectors declared in
+	// the GitOps repository and the connectors in all connect clusters
+	GetConnectGitOpsStatus(context.Context, *GetConnectGitOpsStatusRequest) (*GetConnectGitOpsStatusResponse, error)
+	// ListConnectorOffsets lists the offsets of a connector, requires Kafka
+	// Connect 3.6 or newer
+	ListConnectorOffsets(context.Context, *ListConnectorOffsetsRequest) (*ListConnectorOffsetsResponse, error)
+	// AlterConnectorOffsets alters the offsets of a stopped connector
+	AlterConnectorOffsets(context.Context, *AlterConnectorOffsetsRequest) (*AlterConnectorOffsetsResponse, error)
+	// ResetConnectorOffsets resets all offsets of a stopped connector
+	ResetConnectorOffsets(context.Context, *ResetConnectorOffsetsRequest) (*ResetConnectorOffsetsResponse, error)
 	mustEmbedUnimplementedKafkaConnectServiceServer()
 }
 
@@ -337,6 +485,36 @@ func (UnimplementedKafkaConnectServiceServer) ListConnectorTopics(context.Contex
 func (UnimplementedKafkaConnectServiceServer) ResetConnectorTopics(context.Context, *ResetConnectorTopicsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetConnectorTopics not implemented")
 }
+func (UnimplementedKafkaConnectServiceServer) ListConnectLoggers(context.Context, *ListConnectLoggersRequest) (*ListConnectLoggersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnectLoggers not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) GetConnectLogger(context.Context, *GetConnectLoggerRequest) (*GetConnectLoggerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectLogger not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) SetConnectLoggerLevel(context.Context, *SetConnectLoggerLevelRequest) (*SetConnectLoggerLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConnectLoggerLevel not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) ListConnectorHistory(context.Context, *ListConnectorHistoryRequest) (*ListConnectorHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnectorHistory not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) DiffConnectorHistory(context.Context, *DiffConnectorHistoryRequest) (*DiffConnectorHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffConnectorHistory not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) RollbackConnector(context.Context, *RollbackConnectorRequest) (*RollbackConnectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConnector not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) GetConnectGitOpsStatus(context.Context, *GetConnectGitOpsStatusRequest) (*GetConnectGitOpsStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectGitOpsStatus not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) ListConnectorOffsets(context.Context, *ListConnectorOffsetsRequest) (*ListConnectorOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnectorOffsets not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) AlterConnectorOffsets(context.Context, *AlterConnectorOffsetsRequest) (*AlterConnectorOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterConnectorOffsets not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) ResetConnectorOffsets(context.Context, *ResetConnectorOffsetsRequest) (*ResetConnectorOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetConnectorOffsets not implemented")
+}
 func (UnimplementedKafkaConnectServiceServer) mustEmbedUnimplementedKafkaConnectServiceServer() {}
 
 // UnsafeKafkaConnectServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -620,6 +798,186 @@ func _KafkaConnectService_ResetConnectorTopics_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KafkaConnectService_ListConnectLoggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectLoggersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).ListConnectLoggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_ListConnectLoggers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).ListConnectLoggers(ctx, req.(*ListConnectLoggersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_GetConnectLogger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectLoggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).GetConnectLogger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_GetConnectLogger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).GetConnectLogger(ctx, req.(*GetConnectLoggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_SetConnectLoggerLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConnectLoggerLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).SetConnectLoggerLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_SetConnectLoggerLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).SetConnectLoggerLevel(ctx, req.(*SetConnectLoggerLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_ListConnectorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectorHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).ListConnectorHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_ListConnectorHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).ListConnectorHistory(ctx, req.(*ListConnectorHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_DiffConnectorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffConnectorHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).DiffConnectorHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_DiffConnectorHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).DiffConnectorHistory(ctx, req.(*DiffConnectorHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_RollbackConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConnectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).RollbackConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_RollbackConnector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).RollbackConnector(ctx, req.(*RollbackConnectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_GetConnectGitOpsStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectGitOpsStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).GetConnectGitOpsStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_GetConnectGitOpsStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).GetConnectGitOpsStatus(ctx, req.(*GetConnectGitOpsStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_ListConnectorOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectorOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).ListConnectorOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_ListConnectorOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).ListConnectorOffsets(ctx, req.(*ListConnectorOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_AlterConnectorOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterConnectorOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).AlterConnectorOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_AlterConnectorOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).AlterConnectorOffsets(ctx, req.(*AlterConnectorOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_ResetConnectorOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetConnectorOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).ResetConnectorOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_ResetConnectorOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).ResetConnectorOffsets(ctx, req.(*ResetConnectorOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KafkaConnectService_ServiceDesc is the grpc.ServiceDesc for KafkaConnectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -687,6 +1045,46 @@ var KafkaConnectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetConnectorTopics",
 			Handler:    _KafkaConnectService_ResetConnectorTopics_Handler,
 		},
+		{
+			MethodName: "ListConnectLoggers",
+			Handler:    _KafkaConnectService_ListConnectLoggers_Handler,
+		},
+		{
+			MethodName: "GetConnectLogger",
+			Handler:    _KafkaConnectService_GetConnectLogger_Handler,
+		},
+		{
+			MethodName: "SetConnectLoggerLevel",
+			Handler:    _KafkaConnectService_SetConnectLoggerLevel_Handler,
+		},
+		{
+			MethodName: "ListConnectorHistory",
+			Handler:    _KafkaConnectService_ListConnectorHistory_Handler,
+		},
+		{
+			MethodName: "DiffConnectorHistory",
+			Handler:    _KafkaConnectService_DiffConnectorHistory_Handler,
+		},
+		{
+			MethodName: "RollbackConnector",
+			Handler:    _KafkaConnectService_RollbackConnector_Handler,
+		},
+		{
+			MethodName: "GetConnectGitOpsStatus",
+			Handler:    _KafkaConnectService_GetConnectGitOpsStatus_Handler,
+		},
+		{
+			MethodName: "ListConnectorOffsets",
+			Handler:    _KafkaConnectService_ListConnectorOffsets_Handler,
+		},
+		{
+			MethodName: "AlterConnectorOffsets",
+			Handler:    _KafkaConnectService_AlterConnectorOffsets_Handler,
+		},
+		{
+			MethodName: "ResetConnectorOffsets",
+			Handler:    _KafkaConnectService_ResetConnectorOffsets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "redpanda/api/dataplane/v1alpha1/kafka_connect.proto",
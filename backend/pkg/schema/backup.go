@@ -0,0 +1,431 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// BackupFormatVersion is the version of the backup archive format. It must be
+// incremented whenever a change to the archive is not backwards compatible.
+const BackupFormatVersion = 1
+
+// Backup is a portable snapshot of all subjects, schema versions, schema IDs,
+// references and compatibility levels of a schema registry.
+type Backup struct {
+	FormatVersion       int                `json:"formatVersion"`
+	CreatedAt           time.Time          `json:"createdAt"`
+	GlobalCompatibility CompatibilityLevel `json:"globalCompatibility"`
+	Subjects            []BackupSubject    `json:"subjects"`
+}
+
+// BackupSubject contains all active schema versions of a single subject.
+type BackupSubject struct {
+	Name string `json:"name"`
+	// Compatibility is only set if the subject has a subject-level compatibility configured.
+	Compatibility CompatibilityLevel    `json:"compatibility,omitempty"`
+	Versions      []BackupSchemaVersion `json:"versions"`
+}
+
+// BackupSchemaVersion is a single registered schema version.
+type BackupSchemaVersion struct {
+	Version    int               `json:"version"`
+	ID         int               `json:"id"`
+	Type       SchemaType        `json:"schemaType"`
+	Schema     string            `json:"schema"`
+	References []SchemaReference `json:"references,omitempty"`
+	Metadata   *Metadata         `json:"metadata,omitempty"`
+	RuleSet    *RuleSet          `json:"ruleSet,omitempty"`
+}
+
+// RestoreOptions configure how a backup is restored.
+type RestoreOptions struct {
+	// DryRun only reports the actions and conflicts without changing the target registry.
+	DryRun bool
+	// Force switches the target registry into IMPORT mode even if it contains schemas already.
+	Force bool
+}
+
+// RestoreAction describes what happens with a single schema version during the restore.
+type RestoreAction string
+
+const (
+	// RestoreActionImport is used for schema versions that will be imported.
+	RestoreActionImport RestoreAction = "IMPORT"
+	// RestoreActionSkip is used for schema versions that exist in the target registry already.
+	RestoreActionSkip RestoreAction = "SKIP"
+)
+
+// RestoreConflictKind describes why a schema version can not be restored.
+type RestoreConflictKind string
+
+const (
+	// RestoreConflictID is reported if the schema ID is used by a different schema in the target registry.
+	RestoreConflictID RestoreConflictKind = "ID_CONFLICT"
+	// RestoreConflictVersion is reported if the subject version exists with a different schema ID in the target registry.
+	RestoreConflictVersion RestoreConflictKind = "VERSION_CONFLICT"
+	// RestoreConflictMissingReference is reported if a referenced schema is neither part of the backup nor the target registry.
+	RestoreConflictMissingReference RestoreConflictKind = "MISSING_REFERENCE"
+)
+
+// RestoreStep is a single schema version in the order it is restored.
+type RestoreStep struct {
+	Subject string        `json:"subject"`
+	Version int           `json:"version"`
+	ID      int           `json:"id"`
+	Action  RestoreAction `json:"action"`
+}
+
+// RestoreConflict is a schema version that can not be restored without overwriting or
+// breaking existing schemas in the target registry.
+type RestoreConflict struct {
+	Subject string              `json:"subject"`
+	Version int                 `json:"version"`
+	ID      int                 `json:"id"`
+	Kind    RestoreConflictKind `json:"kind"`
+	Message string              `json:"message"`
+}
+
+// RestoreReport summarizes a (dry-run) restore.
+type RestoreReport struct {
+	DryRun    bool              `json:"dryRun"`
+	Steps     []RestoreStep     `json:"steps"`
+	Conflicts []RestoreConflict `json:"conflicts"`
+	// Imported is the number of schema versions that have actually been imported.
+	Imported int `json:"imported"`
+}
+
+// ExportBackup creates a backup of all active schema versions and compatibility levels.
+func (s *Service) ExportBackup(ctx context.Context) (*Backup, error) {
+	schemas, errs := s.registryClient.GetSchemas(ctx, false)
+	if len(errs) > 0 {
+		// A partial backup would silently lose schemas on restore, hence we fail.
+		return nil, fmt.Errorf("failed to retrieve schemas: %w", errors.Join(errs...))
+	}
+
+	globalConfig, err := s.registryClient.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve global compatibility: %w", err)
+	}
+
+	subjectsByName := make(map[string]*BackupSubject)
+	for _, sch := range schemas {
+		subject, exists := subjectsByName[sch.Subject]
+		if !exists {
+			subject = &BackupSubject{Name: sch.Subject}
+			subjectsByName[sch.Subject] = subject
+		}
+		subject.Versions = append(subject.Versions, BackupSchemaVersion{
+			Version:    sch.Version,
+			ID:         sch.SchemaID,
+			Type:       sch.Type,
+			Schema:     sch.Schema,
+			References: sch.References,
+			Metadata:   sch.Metadata,
+			RuleSet:    sch.RuleSet,
+		})
+	}
+
+	grp, grpCtx := errgroup.WithContext(ctx)
+	grp.SetLimit(10)
+	mutex := sync.Mutex{}
+	for _, subject := range subjectsByName {
+		subject := subject
+		grp.Go(func() error {
+			cfg, err := s.registryClient.GetSubjectConfig(grpCtx, subject.Name)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve compatibility of subject %q: %w", subject.Name, err)
+			}
+			if cfg.Compatibility != CompatDefault {
+				mutex.Lock()
+				subject.Compatibility = cfg.Compatibility
+				mutex.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := grp.Wait(); err != nil {
+		return nil, err
+	}
+
+	subjects := make([]BackupSubject, 0, len(subjectsByName))
+	for _, subject := range subjectsByName {
+		sort.Slice(subject.Versions, func(i, j int) bool {
+			return subject.Versions[i].Version < subject.Versions[j].Version
+		})
+		subjects = append(subjects, *subject)
+	}
+	sort.Slice(subjects, func(i, j int) bool {
+		return subjects[i].Name < subjects[j].Name
+	})
+
+	return &Backup{
+		FormatVersion:       BackupFormatVersion,
+		CreatedAt:           time.Now().UTC(),
+		GlobalCompatibility: globalConfig.Compatibility,
+		Subjects:            subjects,
+	}, nil
+}
+
+// RestoreBackup replays all schema versions of the backup into the schema registry. Schemas are
+// registered in dependency order using the IMPORT mode, so that schema IDs and versions are
+// preserved. Schema versions that exist already are skipped. If any conflict is detected, nothing
+// is imported and the report lists all conflicts. The previous global mode is restored afterwards.
+func (s *Service) RestoreBackup(ctx context.Context, backup *Backup, opts RestoreOptions) (*RestoreReport, error) {
+	if backup.FormatVersion != BackupFormatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %d, expected %d", backup.FormatVersion, BackupFormatVersion)
+	}
+
+	report, err := s.planRestore(ctx, backup)
+	if err != nil {
+		return nil, err
+	}
+	report.DryRun = opts.DryRun
+	if opts.DryRun || len(report.Conflicts) > 0 {
+		return report, nil
+	}
+
+	mode, err := s.registryClient.GetMode(ctx)
+	if err != nil {
+		return report, fmt.Errorf("failed to retrieve current mode: %w", err)
+	}
+	if Mode(mode.Mode) != ModeImport {
+		if _, err := s.registryClient.PutMode(ctx, ModeImport, opts.Force); err != nil {
+			return report, fmt.Errorf("failed to switch into import mode: %w", err)
+		}
+		defer func() {
+			// Use a new context, so that the mode is reset even if the restore was cancelled.
+			resetCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if _, err := s.registryClient.PutMode(resetCtx, Mode(mode.Mode), false); err != nil {
+				s.logger.Error("failed to reset schema registry mode after restore",
+					zap.String("mode", mode.Mode), zap.Error(err))
+			}
+		}()
+	}
+
+	versionsByKey := make(map[string]BackupSchemaVersion)
+	for _, subject := range backup.Subjects {
+		for _, v := range subject.Versions {
+			versionsByKey[subjectVersionKey(subject.Name, v.Version)] = v
+		}
+	}
+	for _, step := range report.Steps {
+		if step.Action != RestoreActionImport {
+			continue
+		}
+		v := versionsByKey[subjectVersionKey(step.Subject, step.Version)]
+		_, err := s.registryClient.ImportSchema(ctx, step.Subject, Schema{
+			Schema:     v.Schema,
+			Type:       v.Type,
+			References: v.References,
+			Metadata:   v.Metadata,
+			RuleSet:    v.RuleSet,
+		}, v.ID, v.Version)
+		if err != nil {
+			return report, fmt.Errorf("failed to import version %d of subject %q: %w", step.Version, step.Subject, err)
+		}
+		report.Imported++
+	}
+
+	for _, subject := range backup.Subjects {
+		if subject.Compatibility == 0 || subject.Compatibility == CompatDefault {
+			continue
+		}
+		if _, err := s.registryClient.PutSubjectConfig(ctx, subject.Name, subject.Compatibility); err != nil {
+			return report, fmt.Errorf("failed to set compatibility of subject %q: %w", subject.Name, err)
+		}
+	}
+	if backup.GlobalCompatibility != 0 {
+		if _, err := s.registryClient.PutConfig(ctx, backup.GlobalCompatibility); err != nil {
+			return report, fmt.Errorf("failed to set global compatibility: %w", err)
+		}
+	}
+
+	return report, nil
+}
+
+// planRestore compares the backup with the target registry and returns the restore
+// steps in dependency order along with all detected conflicts.
+func (s *Service) planRestore(ctx context.Context, backup *Backup) (*RestoreReport, error) {
+	// Soft-deleted schemas still occupy their IDs and versions, hence we have to include them.
+	existing, errs := s.registryClient.GetSchemas(ctx, true)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to retrieve schemas from target registry: %w", errors.Join(errs...))
+	}
+	existingByKey := make(map[string]*SchemaVersionedResponse, len(existing))
+	existingByID := make(map[int]*SchemaVersionedResponse, len(existing))
+	for _, sch := range existing {
+		existingByKey[subjectVersionKey(sch.Subject, sch.Version)] = sch
+		existingByID[sch.SchemaID] = sch
+	}
+
+	ordered, err := sortBackupByDependencies(backup)
+	if err != nil {
+		return nil, err
+	}
+
+	backupKeys := make(map[string]struct{}, len(ordered))
+	for _, v := range ordered {
+		backupKeys[subjectVersionKey(v.subject, v.Version)] = struct{}{}
+	}
+
+	report := &RestoreReport{
+		Steps:     make([]RestoreStep, 0, len(ordered)),
+		Conflicts: make([]RestoreConflict, 0),
+	}
+	for _, v := range ordered {
+		step := RestoreStep{Subject: v.subject, Version: v.Version, ID: v.ID, Action: RestoreActionImport}
+		conflict := RestoreConflict{Subject: v.subject, Version: v.Version, ID: v.ID}
+
+		if target, exists := existingByKey[subjectVersionKey(v.subject, v.Version)]; exists {
+			if target.SchemaID == v.ID {
+				step.Action = RestoreActionSkip
+				report.Steps = append(report.Steps, step)
+				continue
+			}
+			conflict.Kind = RestoreConflictVersion
+			conflict.Message = fmt.Sprintf("version %d exists in the target registry with schema id %d", v.Version, target.SchemaID)
+			report.Conflicts = append(report.Conflicts, conflict)
+		}
+
+		if target, exists := existingByID[v.ID]; exists && !schemasEqual(target.Schema, v.Schema) {
+			conflict.Kind = RestoreConflictID
+			conflict.Message = fmt.Sprintf("schema id %d is used by a different schema (subject %q, version %d) in the target registry",
+				v.ID, target.Subject, target.Version)
+			report.Conflicts = append(report.Conflicts, conflict)
+		}
+
+		for _, ref := range v.References {
+			key := subjectVersionKey(ref.Subject, ref.Version)
+			_, inBackup := backupKeys[key]
+			_, inTarget := existingByKey[key]
+			if !inBackup && !inTarget {
+				conflict.Kind = RestoreConflictMissingReference
+				conflict.Message = fmt.Sprintf("referenced version %d of subject %q does not exist", ref.Version, ref.Subject)
+				report.Conflicts = append(report.Conflicts, conflict)
+			}
+		}
+
+		report.Steps = append(report.Steps, step)
+	}
+
+	return report, nil
+}
+
+type backupSchemaVersionWithSubject struct {
+	BackupSchemaVersion
+	subject string
+}
+
+// sortBackupByDependencies returns all schema versions in an order so that referenced schemas
+// are registered before the schemas that reference them and versions of the same subject are
+// registered in ascending order. If there is a choice, lower schema IDs are registered first.
+func sortBackupByDependencies(backup *Backup) ([]backupSchemaVersionWithSubject, error) {
+	nodes := make(map[string]backupSchemaVersionWithSubject)
+	dependents := make(map[string][]string)
+	inDegree := make(map[string]int)
+
+	addEdge := func(from, to string) {
+		dependents[from] = append(dependents[from], to)
+		inDegree[to]++
+	}
+
+	for _, subject := range backup.Subjects {
+		versions := make([]BackupSchemaVersion, len(subject.Versions))
+		copy(versions, subject.Versions)
+		sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+
+		for i, v := range versions {
+			key := subjectVersionKey(subject.Name, v.Version)
+			if _, exists := nodes[key]; exists {
+				return nil, fmt.Errorf("backup contains version %d of subject %q more than once", v.Version, subject.Name)
+			}
+			nodes[key] = backupSchemaVersionWithSubject{BackupSchemaVersion: v, subject: subject.Name}
+			inDegree[key] += 0
+			if i > 0 {
+				addEdge(subjectVersionKey(subject.Name, versions[i-1].Version), key)
+			}
+		}
+	}
+
+	for key, node := range nodes {
+		for _, ref := range node.References {
+			refKey := subjectVersionKey(ref.Subject, ref.Version)
+			// References that are not part of the backup must exist in the target registry
+			if _, exists := nodes[refKey]; exists {
+				addEdge(refKey, key)
+			}
+		}
+	}
+
+	less := func(a, b string) bool {
+		if nodes[a].ID != nodes[b].ID {
+			return nodes[a].ID < nodes[b].ID
+		}
+		return a < b
+	}
+
+	ready := make([]string, 0)
+	for key, degree := range inDegree {
+		if degree == 0 {
+			ready = append(ready, key)
+		}
+	}
+
+	ordered := make([]backupSchemaVersionWithSubject, 0, len(nodes))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool { return less(ready[i], ready[j]) })
+		key := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, nodes[key])
+
+		for _, dependent := range dependents[key] {
+			inDegree[dependent]--
+			if inDegree[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(ordered) != len(nodes) {
+		return nil, fmt.Errorf("backup contains cyclic schema references")
+	}
+
+	return ordered, nil
+}
+
+func subjectVersionKey(subject string, version int) string {
+	return fmt.Sprintf("%s/%d", subject, version)
+}
+
+// schemasEqual compares two schema strings while ignoring insignificant whitespace
+// of JSON-based (Avro and JSON) schemas.
+func schemasEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, []byte(a)) == nil && json.Compact(&compactB, []byte(b)) == nil {
+		return compactA.String() == compactB.String()
+	}
+	return strings.TrimSpace(a) == strings.TrimSpace(b)
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBackup() *Backup {
+	return &Backup{
+		FormatVersion:       BackupFormatVersion,
+		GlobalCompatibility: CompatBackward,
+		Subjects: []BackupSubject{
+			{
+				Name:          "orders-value",
+				Compatibility: CompatFull,
+				Versions: []BackupSchemaVersion{
+					{Version: 2, ID: 3, Type: TypeProtobuf, Schema: "order v2", References: []SchemaReference{{Name: "customer.proto", Subject: "customer", Version: 1}}},
+					{Version: 1, ID: 1, Type: TypeProtobuf, Schema: "order v1"},
+				},
+			},
+			{
+				Name: "customer",
+				Versions: []BackupSchemaVersion{
+					{Version: 1, ID: 2, Type: TypeProtobuf, Schema: "customer v1"},
+				},
+			},
+		},
+	}
+}
+
+func TestSortBackupByDependencies(t *testing.T) {
+	ordered, err := sortBackupByDependencies(newTestBackup())
+	require.NoError(t, err)
+
+	keys := make([]string, len(ordered))
+	for i, v := range ordered {
+		keys[i] = subjectVersionKey(v.subject, v.Version)
+	}
+	assert.Equal(t, []string{"orders-value/1", "customer/1", "orders-value/2"}, keys)
+
+	cyclic := &Backup{
+		FormatVersion: BackupFormatVersion,
+		Subjects: []BackupSubject{
+			{Name: "a", Versions: []BackupSchemaVersion{{Version: 1, ID: 1, References: []SchemaReference{{Subject: "b", Version: 1}}}}},
+			{Name: "b", Versions: []BackupSchemaVersion{{Version: 1, ID: 2, References: []SchemaReference{{Subject: "a", Version: 1}}}}},
+		},
+	}
+	_, err = sortBackupByDependencies(cyclic)
+	assert.Error(t, err)
+}
+
+func TestService_RestoreBackup_DryRunConflicts(t *testing.T) {
+	s := newTestDiffService(t)
+	httpmock.ActivateNonDefault(s.registryClient.client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", testSchemaRegistryBaseURL+"/schemas",
+		func(*http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, []SchemaVersionedResponse{
+				{Subject: "orders-value", Version: 1, SchemaID: 1, Schema: "order v1"},
+				{Subject: "payments-value", Version: 1, SchemaID: 2, Schema: "payment v1"},
+			})
+		})
+
+	report, err := s.RestoreBackup(context.Background(), newTestBackup(), RestoreOptions{DryRun: true})
+	require.NoError(t, err)
+
+	assert.True(t, report.DryRun)
+	require.Len(t, report.Steps, 3)
+	assert.Equal(t, RestoreActionSkip, report.Steps[0].Action)
+	assert.Equal(t, RestoreActionImport, report.Steps[1].Action)
+	require.Len(t, report.Conflicts, 1)
+	assert.Equal(t, RestoreConflictID, report.Conflicts[0].Kind)
+	assert.Equal(t, "customer", report.Conflicts[0].Subject)
+}
+
+func TestService_RestoreBackup(t *testing.T) {
+	s := newTestDiffService(t)
+	httpmock.ActivateNonDefault(s.registryClient.client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", testSchemaRegistryBaseURL+"/schemas",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, []SchemaVersionedResponse{}))
+	httpmock.RegisterResponder("GET", testSchemaRegistryBaseURL+"/mode",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, ModeResponse{Mode: "READWRITE"}))
+
+	var modes []string
+	httpmock.RegisterResponder("PUT", testSchemaRegistryBaseURL+"/mode",
+		func(req *http.Request) (*http.Response, error) {
+			var body ModeResponse
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			modes = append(modes, body.Mode)
+			return httpmock.NewJsonResponse(http.StatusOK, body)
+		})
+
+	var imported []int
+	importResponder := func(req *http.Request) (*http.Response, error) {
+		var body struct {
+			ID      int `json:"id"`
+			Version int `json:"version"`
+		}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		imported = append(imported, body.ID)
+		return httpmock.NewJsonResponse(http.StatusOK, CreateSchemaResponse{ID: body.ID})
+	}
+	httpmock.RegisterResponder("POST", testSchemaRegistryBaseURL+"/subjects/orders-value/versions", importResponder)
+	httpmock.RegisterResponder("POST", testSchemaRegistryBaseURL+"/subjects/customer/versions", importResponder)
+	httpmock.RegisterResponder("PUT", testSchemaRegistryBaseURL+"/config/orders-value",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, PutConfigResponse{Compatibility: CompatFull}))
+	httpmock.RegisterResponder("PUT", testSchemaRegistryBaseURL+"/config",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, PutConfigResponse{Compatibility: CompatBackward}))
+
+	report, err := s.RestoreBackup(context.Background(), newTestBackup(), RestoreOptions{})
+	require.NoError(t, err)
+
+	assert.Empty(t, report.Conflicts)
+	assert.Equal(t, 3, report.Imported)
+	assert.Equal(t, []int{1, 2, 3}, imported)
+	assert.Equal(t, []string{"IMPORT", "READWRITE"}, modes)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["PUT "+testSchemaRegistryBaseURL+"/config/orders-value"])
+}
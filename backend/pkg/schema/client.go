@@ -84,6 +84,8 @@ func newClient(cfg config.Schema) (*Client, error) {
 type SchemaResponse struct {
 	Schema     string            `json:"schema"`
 	References []SchemaReference `json:"references,omitempty"`
+	Metadata   *Metadata         `json:"metadata,omitempty"`
+	RuleSet    *RuleSet          `json:"ruleSet,omitempty"`
 }
 
 // GetSchemaByID returns the schema string identified by the input ID.
@@ -126,6 +128,8 @@ type SchemaVersionedResponse struct {
 	Schema     string            `json:"schema"`
 	Type       SchemaType        `json:"schemaType"`
 	References []SchemaReference `json:"references"`
+	Metadata   *Metadata         `json:"metadata,omitempty"`
+	RuleSet    *RuleSet          `json:"ruleSet,omitempty"`
 }
 
 // GetSchemaBySubject returns the schema for the specified version of this subject. The unescaped schema only is returned.
@@ -193,6 +197,12 @@ type SubjectsResponse struct {
 
 // GetSubjects returns a list of registered subjects.
 func (c *Client) GetSubjects(ctx context.Context, showSoftDeleted bool) (*SubjectsResponse, error) {
+	return c.GetSubjectsByPrefix(ctx, "", showSoftDeleted)
+}
+
+// GetSubjectsByPrefix returns a list of registered subjects that start with the given
+// prefix. Use a context prefix such as ":.staging:" to list the subjects of a context.
+func (c *Client) GetSubjectsByPrefix(ctx context.Context, subjectPrefix string, showSoftDeleted bool) (*SubjectsResponse, error) {
 	req := c.client.R().
 		SetContext(ctx).
 		SetResult([]string{})
@@ -200,6 +210,9 @@ func (c *Client) GetSubjects(ctx context.Context, showSoftDeleted bool) (*Subjec
 	if showSoftDeleted {
 		req.SetQueryParam("deleted", "true")
 	}
+	if subjectPrefix != "" {
+		req.SetQueryParam("subjectPrefix", subjectPrefix)
+	}
 
 	res, err := req.Get("/subjects")
 	if err != nil {
@@ -289,6 +302,155 @@ func (c *Client) GetMode(ctx context.Context) (*ModeResponse, error) {
 	return parsed, nil
 }
 
+// PutMode sets the mode for Schema Registry at a global level. If force is set to true,
+// the IMPORT mode will be set even if schemas are already registered.
+func (c *Client) PutMode(ctx context.Context, mode Mode, force bool) (*ModeResponse, error) {
+	req := c.client.R().
+		SetContext(ctx).
+		SetResult(&ModeResponse{}).
+		SetBody(&ModeResponse{Mode: string(mode)})
+	if force {
+		req.SetQueryParam("force", "true")
+	}
+
+	res, err := req.Put("/mode")
+	if err != nil {
+		return nil, fmt.Errorf("put mode request failed: %w", err)
+	}
+
+	if res.IsError() {
+		restErr, ok := res.Error().(*RestError)
+		if !ok {
+			return nil, fmt.Errorf("put mode request failed: Status code %d", res.StatusCode())
+		}
+		return nil, restErr
+	}
+
+	parsed, ok := res.Result().(*ModeResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse mode response")
+	}
+
+	return parsed, nil
+}
+
+// GetSubjectMode returns the mode for the given subject. If the subject does not
+// have a subject-specific mode set, the global mode is returned.
+func (c *Client) GetSubjectMode(ctx context.Context, subject string) (*ModeResponse, error) {
+	res, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&ModeResponse{}).
+		SetPathParam("subject", subject).
+		SetQueryParam("defaultToGlobal", "true").
+		Get("/mode/{subject}")
+	if err != nil {
+		return nil, fmt.Errorf("get mode for subject failed: %w", err)
+	}
+
+	if res.IsError() {
+		restErr, ok := res.Error().(*RestError)
+		if !ok {
+			return nil, fmt.Errorf("get mode for subject failed: Status code %d", res.StatusCode())
+		}
+		return nil, restErr
+	}
+
+	parsed, ok := res.Result().(*ModeResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse mode for subject response")
+	}
+
+	return parsed, nil
+}
+
+// PutSubjectMode sets the mode for the given subject. If force is set to true,
+// the IMPORT mode will be set even if schemas are already registered.
+func (c *Client) PutSubjectMode(ctx context.Context, subject string, mode Mode, force bool) (*ModeResponse, error) {
+	req := c.client.R().
+		SetContext(ctx).
+		SetResult(&ModeResponse{}).
+		SetBody(&ModeResponse{Mode: string(mode)}).
+		SetPathParam("subject", subject)
+	if force {
+		req.SetQueryParam("force", "true")
+	}
+
+	res, err := req.Put("/mode/{subject}")
+	if err != nil {
+		return nil, fmt.Errorf("put mode for subject failed: %w", err)
+	}
+
+	if res.IsError() {
+		restErr, ok := res.Error().(*RestError)
+		if !ok {
+			return nil, fmt.Errorf("put mode for subject failed: Status code %d", res.StatusCode())
+		}
+		return nil, restErr
+	}
+
+	parsed, ok := res.Result().(*ModeResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse mode for subject response")
+	}
+
+	return parsed, nil
+}
+
+// DeleteSubjectMode deletes the subject-specific mode, so that the subject falls back
+// to the global mode again.
+func (c *Client) DeleteSubjectMode(ctx context.Context, subject string) (*ModeResponse, error) {
+	res, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&ModeResponse{}).
+		SetPathParam("subject", subject).
+		Delete("/mode/{subject}")
+	if err != nil {
+		return nil, fmt.Errorf("delete mode for subject failed: %w", err)
+	}
+
+	if res.IsError() {
+		restErr, ok := res.Error().(*RestError)
+		if !ok {
+			return nil, fmt.Errorf("delete mode for subject failed: Status code %d", res.StatusCode())
+		}
+		return nil, restErr
+	}
+
+	parsed, ok := res.Result().(*ModeResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse mode for subject response")
+	}
+
+	return parsed, nil
+}
+
+// GetContexts returns the names of all contexts that exist in the schema registry.
+// The default context is returned as ".".
+func (c *Client) GetContexts(ctx context.Context) ([]string, error) {
+	res, err := c.client.R().
+		SetContext(ctx).
+		SetResult([]string{}).
+		Get("/contexts")
+	if err != nil {
+		return nil, fmt.Errorf("get contexts request failed: %w", err)
+	}
+
+	if res.IsError() {
+		restErr, ok := res.Error().(*RestError)
+		if !ok {
+			return nil, fmt.Errorf("get contexts request failed: Status code %d", res.StatusCode())
+		}
+		return nil, restErr
+	}
+
+	parsed, ok := res.Result().(*[]string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse contexts response")
+	}
+
+	return *parsed, nil
+}
+
 // ConfigResponse is the response schema for the schema registry's /config endpoint.
 type ConfigResponse struct {
 	// Global compatibility level. Will be one of:
@@ -595,6 +757,12 @@ type Schema struct {
 	// References declares other schemas this schema references. See the
 	// docs on SchemaReference for more details.
 	References []SchemaReference `json:"references,omitempty"`
+
+	// Metadata contains optional tags and properties of the schema.
+	Metadata *Metadata `json:"metadata,omitempty"`
+
+	// RuleSet contains optional data contract rules of the schema.
+	RuleSet *RuleSet `json:"ruleSet,omitempty"`
 }
 
 // SchemaReference is a way for a one schema to reference another. The details
@@ -642,6 +810,39 @@ func (c *Client) CreateSchema(ctx context.Context, subjectName string, schema Sc
 	return &createSchemaRes, nil
 }
 
+// ImportSchema registers a schema under the specified subject while preserving the given
+// schema ID and version. This is only allowed if the schema registry or the subject is
+// in IMPORT mode. The schema is registered as is and not normalized.
+func (c *Client) ImportSchema(ctx context.Context, subjectName string, schema Schema, id, version int) (*CreateSchemaResponse, error) {
+	type requestPayload struct {
+		Schema
+		ID      int `json:"id"`
+		Version int `json:"version"`
+	}
+	payload := requestPayload{Schema: schema, ID: id, Version: version}
+
+	var createSchemaRes CreateSchemaResponse
+	res, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&createSchemaRes).
+		SetPathParam("subject", subjectName).
+		SetBody(&payload).
+		Post("/subjects/{subject}/versions")
+	if err != nil {
+		return nil, fmt.Errorf("import schema failed: %w", err)
+	}
+
+	if res.IsError() {
+		restErr, ok := res.Error().(*RestError)
+		if !ok {
+			return nil, fmt.Errorf("import schema failed: Status code %d", res.StatusCode())
+		}
+		return nil, restErr
+	}
+
+	return &createSchemaRes, nil
+}
+
 // GetSchemasIndividually returns all schemas by describing all schemas one by one. This may be used against
 // schema registry that don't support the /schemas endpoint that returns a list of all registered schemas.
 func (c *Client) GetSchemasIndividually(ctx context.Context, showSoftDeleted bool) ([]*SchemaVersionedResponse, []error) {
@@ -11,6 +11,7 @@ package schema
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -93,3 +94,134 @@ func TestClient_GetSubjectVersions(t *testing.T) {
 	assert.NoError(t, err, "expected no error when fetching subject versions")
 	assert.Equal(t, expected, actual)
 }
+
+func TestClient_GetSubjectsByPrefix(t *testing.T) {
+	baseURL := testSchemaRegistryBaseURL
+	c, _ := newClient(config.Schema{
+		Enabled: true,
+		URLs:    []string{baseURL},
+	})
+	httpClient := c.client.GetClient()
+	httpmock.ActivateNonDefault(httpClient)
+	defer httpmock.DeactivateAndReset()
+
+	subjects := []string{":.staging:orders-value"}
+	httpmock.RegisterResponderWithQuery("GET", baseURL+"/subjects", "subjectPrefix=:.staging:",
+		func(*http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, subjects)
+		})
+
+	expected := &SubjectsResponse{Subjects: subjects}
+	actual, err := c.GetSubjectsByPrefix(context.Background(), ContextSubjectPrefix("staging"), false)
+	assert.NoError(t, err, "expected no error when fetching subjects by prefix")
+	assert.Equal(t, expected, actual)
+}
+
+func TestClient_PutSubjectMode(t *testing.T) {
+	baseURL := testSchemaRegistryBaseURL
+	c, _ := newClient(config.Schema{
+		Enabled: true,
+		URLs:    []string{baseURL},
+	})
+	httpClient := c.client.GetClient()
+	httpmock.ActivateNonDefault(httpClient)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponderWithQuery("PUT", baseURL+"/mode/orders-value", "force=true",
+		func(req *http.Request) (*http.Response, error) {
+			var body ModeResponse
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, body)
+		})
+
+	actual, err := c.PutSubjectMode(context.Background(), "orders-value", ModeImport, true)
+	assert.NoError(t, err, "expected no error when setting subject mode")
+	assert.Equal(t, &ModeResponse{Mode: "IMPORT"}, actual)
+}
+
+func TestClient_PutMode_Rejected(t *testing.T) {
+	baseURL := testSchemaRegistryBaseURL
+	c, _ := newClient(config.Schema{
+		Enabled: true,
+		URLs:    []string{baseURL},
+	})
+	httpClient := c.client.GetClient()
+	httpmock.ActivateNonDefault(httpClient)
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("PUT", baseURL+"/mode",
+		func(*http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusUnprocessableEntity, RestError{
+				ErrorCode: 42205,
+				Message:   "Cannot import since found existing subjects",
+			})
+		})
+
+	_, err := c.PutMode(context.Background(), ModeImport, false)
+	var restErr *RestError
+	assert.ErrorAs(t, err, &restErr)
+	assert.Equal(t, 42205, restErr.ErrorCode)
+}
+
+func TestClient_GetContexts(t *testing.T) {
+	baseURL := testSchemaRegistryBaseURL
+	c, _ := newClient(config.Schema{
+		Enabled: true,
+		URLs:    []string{baseURL},
+	})
+	httpClient := c.client.GetClient()
+	httpmock.ActivateNonDefault(httpClient)
+	defer httpmock.DeactivateAndReset()
+
+	contexts := []string{".", ".staging"}
+	httpmock.RegisterResponder("GET", baseURL+"/contexts",
+		func(*http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, contexts)
+		})
+
+	actual, err := c.GetContexts(context.Background())
+	assert.NoError(t, err, "expected no error when fetching contexts")
+	assert.Equal(t, contexts, actual)
+}
+
+func TestClient_CreateSchema_WithRuleSet(t *testing.T) {
+	baseURL := testSchemaRegistryBaseURL
+	c, _ := newClient(config.Schema{
+		Enabled: true,
+		URLs:    []string{baseURL},
+	})
+	httpClient := c.client.GetClient()
+	httpmock.ActivateNonDefault(httpClient)
+	defer httpmock.DeactivateAndReset()
+
+	var received Schema
+	httpmock.RegisterResponder("POST", baseURL+"/subjects/orders-value/versions",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&received); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, CreateSchemaResponse{ID: 7})
+		})
+
+	sch := Schema{
+		Schema: `{"type":"record","name":"Order","fields":[{"name":"amount","type":"int"}]}`,
+		Metadata: &Metadata{
+			Properties: map[string]string{"owner": "payments"},
+		},
+		RuleSet: &RuleSet{
+			DomainRules: []Rule{{
+				Name: "positiveAmount",
+				Kind: RuleKindCondition,
+				Mode: RuleModeWrite,
+				Type: RuleTypeCEL,
+				Expr: "message.amount > 0",
+			}},
+		},
+	}
+	res, err := c.CreateSchema(context.Background(), "orders-value", sch)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, res.ID)
+	assert.Equal(t, sch, received)
+}
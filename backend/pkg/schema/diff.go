@@ -0,0 +1,248 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeKind describes what kind of change has been detected between two
+// versions of the same schema.
+type ChangeKind string
+
+const (
+	// ChangeFieldAdded is reported when a record field, message field or JSON property was added.
+	ChangeFieldAdded ChangeKind = "FIELD_ADDED"
+	// ChangeFieldRemoved is reported when a record field, message field or JSON property was removed.
+	ChangeFieldRemoved ChangeKind = "FIELD_REMOVED"
+	// ChangeFieldRenamed is reported when a protobuf field kept its number but changed its name.
+	ChangeFieldRenamed ChangeKind = "FIELD_RENAMED"
+	// ChangeTypeChanged is reported when the type of field changed.
+	ChangeTypeChanged ChangeKind = "TYPE_CHANGED"
+	// ChangeDefaultChanged is reported when the default value of a field changed.
+	ChangeDefaultChanged ChangeKind = "DEFAULT_CHANGED"
+	// ChangeRequiredChanged is reported when a field became required or optional.
+	ChangeRequiredChanged ChangeKind = "REQUIRED_CHANGED"
+	// ChangeEnumSymbolAdded is reported when an enum symbol was added.
+	ChangeEnumSymbolAdded ChangeKind = "ENUM_SYMBOL_ADDED"
+	// ChangeEnumSymbolRemoved is reported when an enum symbol was removed.
+	ChangeEnumSymbolRemoved ChangeKind = "ENUM_SYMBOL_REMOVED"
+	// ChangeUnionTypeAdded is reported when a type was added to a union.
+	ChangeUnionTypeAdded ChangeKind = "UNION_TYPE_ADDED"
+	// ChangeUnionTypeRemoved is reported when a type was removed from a union.
+	ChangeUnionTypeRemoved ChangeKind = "UNION_TYPE_REMOVED"
+	// ChangeTypeAdded is reported when a named type (record, message, enum) was added.
+	ChangeTypeAdded ChangeKind = "TYPE_ADDED"
+	// ChangeTypeRemoved is reported when a named type (record, message, enum) was removed.
+	ChangeTypeRemoved ChangeKind = "TYPE_REMOVED"
+	// ChangeFixedSizeChanged is reported when the size of an Avro fixed type changed.
+	ChangeFixedSizeChanged ChangeKind = "FIXED_SIZE_CHANGED"
+	// ChangePackageChanged is reported when the protobuf package changed.
+	ChangePackageChanged ChangeKind = "PACKAGE_CHANGED"
+	// ChangeOneofChanged is reported when a protobuf field moved into or out of a oneof.
+	ChangeOneofChanged ChangeKind = "ONEOF_CHANGED"
+	// ChangeContentModelChanged is reported when a JSON schema object was opened or
+	// closed for additional properties.
+	ChangeContentModelChanged ChangeKind = "CONTENT_MODEL_CHANGED"
+	// ChangeConstraintChanged is reported when a JSON schema validation keyword
+	// (e.g. maxLength, minimum) changed.
+	ChangeConstraintChanged ChangeKind = "CONSTRAINT_CHANGED"
+)
+
+// SchemaChange is a single difference between an existing schema (old) and
+// a newer schema (new).
+//
+//nolint:revive // Change would be too generic in this case.
+type SchemaChange struct {
+	// Path is the location of the change within the schema, e.g. "com.shop.Order.customer.id".
+	Path string     `json:"path"`
+	Kind ChangeKind `json:"kind"`
+	// OldValue and NewValue describe the changed attribute (e.g. the type) if applicable.
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+
+	// BreaksBackward is true if consumers using the new schema can no longer
+	// read data that has been written with the old schema.
+	BreaksBackward bool `json:"breaksBackward"`
+	// BreaksForward is true if consumers using the old schema can no longer
+	// read data that has been written with the new schema.
+	BreaksForward bool `json:"breaksForward"`
+
+	// Reason explains in plain words why this change is (in)compatible.
+	Reason string `json:"reason"`
+
+	// IncompatibleWith lists all compatibility levels this change violates.
+	IncompatibleWith []CompatibilityLevel `json:"incompatibleWith"`
+}
+
+// SchemaDiff is the result of comparing two schemas of the same type.
+//
+//nolint:revive // Diff would be too generic in this case.
+type SchemaDiff struct {
+	Type    SchemaType     `json:"type"`
+	Changes []SchemaChange `json:"changes"`
+}
+
+// IsCompatible returns whether none of the changes violate the given
+// compatibility level.
+func (d *SchemaDiff) IsCompatible(level CompatibilityLevel) bool {
+	return len(d.Violations(level)) == 0
+}
+
+// Violations returns all changes that violate the given compatibility level.
+func (d *SchemaDiff) Violations(level CompatibilityLevel) []SchemaChange {
+	violations := make([]SchemaChange, 0)
+	for _, change := range d.Changes {
+		if change.Violates(level) {
+			violations = append(violations, change)
+		}
+	}
+	return violations
+}
+
+// Violates returns whether the change is not allowed under the given
+// compatibility level. Transitive levels are treated like their
+// non-transitive counterpart, because a single change is always
+// evaluated between exactly two schema versions.
+func (c SchemaChange) Violates(level CompatibilityLevel) bool {
+	switch level {
+	case CompatBackward, CompatBackwardTransitive:
+		return c.BreaksBackward
+	case CompatForward, CompatForwardTransitive:
+		return c.BreaksForward
+	case CompatFull, CompatFullTransitive:
+		return c.BreaksBackward || c.BreaksForward
+	default:
+		return false
+	}
+}
+
+// IsTransitive returns whether the compatibility level requires checking
+// against all previously registered versions rather than only the latest.
+func (l CompatibilityLevel) IsTransitive() bool {
+	switch l {
+	case CompatBackwardTransitive, CompatForwardTransitive, CompatFullTransitive:
+		return true
+	default:
+		return false
+	}
+}
+
+// Explain describes why the change violates the given compatibility level.
+// An empty string is returned if the change does not violate the level.
+func (c SchemaChange) Explain(level CompatibilityLevel) string {
+	if !c.Violates(level) {
+		return ""
+	}
+
+	var rules []string
+	if c.BreaksBackward && level != CompatForward && level != CompatForwardTransitive {
+		rules = append(rules, "consumers using the new schema can no longer read data written with the old schema")
+	}
+	if c.BreaksForward && level != CompatBackward && level != CompatBackwardTransitive {
+		rules = append(rules, "consumers using the old schema can no longer read data written with the new schema")
+	}
+
+	return fmt.Sprintf("%s at %q violates %s: %s (%s)", c.Kind, c.Path, level.String(), strings.Join(rules, " and "), c.Reason)
+}
+
+// newChange creates a new schema change and computes the compatibility levels
+// it violates based on whether it breaks backward and/or forward compatibility.
+func newChange(path string, kind ChangeKind, oldValue, newValue string, breaksBackward, breaksForward bool, reason string) SchemaChange {
+	incompatibleWith := make([]CompatibilityLevel, 0)
+	if breaksBackward {
+		incompatibleWith = append(incompatibleWith, CompatBackward, CompatBackwardTransitive)
+	}
+	if breaksForward {
+		incompatibleWith = append(incompatibleWith, CompatForward, CompatForwardTransitive)
+	}
+	if breaksBackward || breaksForward {
+		incompatibleWith = append(incompatibleWith, CompatFull, CompatFullTransitive)
+	}
+
+	return SchemaChange{
+		Path:             path,
+		Kind:             kind,
+		OldValue:         oldValue,
+		NewValue:         newValue,
+		BreaksBackward:   breaksBackward,
+		BreaksForward:    breaksForward,
+		Reason:           reason,
+		IncompatibleWith: incompatibleWith,
+	}
+}
+
+// sortChanges sorts changes by path and kind, so that the output is stable.
+func sortChanges(changes []SchemaChange) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Path != changes[j].Path {
+			return changes[i].Path < changes[j].Path
+		}
+		return changes[i].Kind < changes[j].Kind
+	})
+}
+
+func joinPath(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+	return parent + "." + child
+}
+
+// DiffSchemas compares an existing schema (oldSchema) with a newer schema (newSchema)
+// and returns all detected changes. Both schemas must be of the same type. References
+// are resolved by fetching them from the schema registry.
+func (s *Service) DiffSchemas(ctx context.Context, subject string, oldSchema, newSchema Schema) (*SchemaDiff, error) {
+	if oldSchema.Type != newSchema.Type {
+		return nil, fmt.Errorf("can not diff schemas of different types (%s and %s)", oldSchema.Type, newSchema.Type)
+	}
+
+	var changes []SchemaChange
+	switch newSchema.Type {
+	case TypeAvro:
+		oldParsed, err := s.parseAvroSchemaIsolated(ctx, oldSchema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse old avro schema: %w", err)
+		}
+		newParsed, err := s.parseAvroSchemaIsolated(ctx, newSchema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse new avro schema: %w", err)
+		}
+		changes = diffAvro(oldParsed, newParsed)
+	case TypeProtobuf:
+		oldFD, err := s.compileProtobufSchema(ctx, subject, oldSchema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile old protobuf schema: %w", err)
+		}
+		newFD, err := s.compileProtobufSchema(ctx, subject, newSchema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile new protobuf schema: %w", err)
+		}
+		changes = diffProtobuf(oldFD, newFD)
+	case TypeJSON:
+		var err error
+		changes, err = diffJSONSchema(oldSchema.Schema, newSchema.Schema)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported schema type %d", newSchema.Type)
+	}
+
+	sortChanges(changes)
+
+	return &SchemaDiff{
+		Type:    newSchema.Type,
+		Changes: changes,
+	}, nil
+}
@@ -0,0 +1,383 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hamba/avro/v2"
+)
+
+// parseAvroSchemaIsolated parses the given avro schema along with its references
+// using a dedicated schema cache. Two versions of the same subject usually
+// declare named types with identical names, hence they must not share the
+// global avro schema cache.
+func (s *Service) parseAvroSchemaIsolated(ctx context.Context, sch Schema) (avro.Schema, error) {
+	cache := &avro.SchemaCache{}
+	for _, reference := range sch.References {
+		schemaRef, err := s.GetSchemaBySubjectAndVersion(ctx, reference.Subject, strconv.Itoa(reference.Version))
+		if err != nil {
+			return nil, err
+		}
+		if err := s.addAvroReferencesToCache(ctx, schemaRef, cache); err != nil {
+			return nil, fmt.Errorf("failed to parse schema reference (subject: %q, version %d): %w",
+				reference.Subject, reference.Version, err)
+		}
+	}
+
+	return avro.ParseWithCache(sch.Schema, "", cache)
+}
+
+func (s *Service) addAvroReferencesToCache(ctx context.Context, sch *SchemaVersionedResponse, cache *avro.SchemaCache) error {
+	for _, reference := range sch.References {
+		schemaRef, err := s.GetSchemaBySubjectAndVersion(ctx, reference.Subject, strconv.Itoa(reference.Version))
+		if err != nil {
+			return err
+		}
+		if err := s.addAvroReferencesToCache(ctx, schemaRef, cache); err != nil {
+			return err
+		}
+	}
+	_, err := avro.ParseWithCache(sch.Schema, "", cache)
+	return err
+}
+
+// diffAvro compares two parsed avro schemas. Compatibility is evaluated according to
+// the Avro schema resolution rules, where the consumer's schema is the reader and the
+// producer's schema is the writer schema.
+func diffAvro(oldSchema, newSchema avro.Schema) []SchemaChange {
+	d := avroDiffer{visited: make(map[string]struct{})}
+	d.diff(avroRootPath(newSchema), oldSchema, newSchema)
+	return d.changes
+}
+
+type avroDiffer struct {
+	changes []SchemaChange
+	// visited tracks named type pairs that have been compared already, so that
+	// recursive types do not cause an infinite loop.
+	visited map[string]struct{}
+}
+
+func avroRootPath(sch avro.Schema) string {
+	if named, ok := unwrapAvroRef(sch).(avro.NamedSchema); ok {
+		return named.FullName()
+	}
+	return ""
+}
+
+func unwrapAvroRef(sch avro.Schema) avro.Schema {
+	if ref, ok := sch.(*avro.RefSchema); ok {
+		return ref.Schema()
+	}
+	return sch
+}
+
+// avroTypeName returns a short, human-readable name for the given schema.
+func avroTypeName(sch avro.Schema) string {
+	sch = unwrapAvroRef(sch)
+	switch typed := sch.(type) {
+	case avro.NamedSchema:
+		return string(typed.Type()) + " " + typed.FullName()
+	case *avro.ArraySchema:
+		return "array<" + avroTypeName(typed.Items()) + ">"
+	case *avro.MapSchema:
+		return "map<" + avroTypeName(typed.Values()) + ">"
+	case *avro.UnionSchema:
+		names := make([]string, len(typed.Types()))
+		for i, t := range typed.Types() {
+			names[i] = avroTypeName(t)
+		}
+		return fmt.Sprintf("%v", names)
+	case *avro.PrimitiveSchema:
+		if typed.Logical() != nil {
+			return string(typed.Type()) + "(" + string(typed.Logical().Type()) + ")"
+		}
+		return string(typed.Type())
+	default:
+		return string(sch.Type())
+	}
+}
+
+// avroBranchKey identifies a union branch. Named types are matched by their full
+// name, all other types by their type.
+func avroBranchKey(sch avro.Schema) string {
+	sch = unwrapAvroRef(sch)
+	if named, ok := sch.(avro.NamedSchema); ok {
+		return named.FullName()
+	}
+	return string(sch.Type())
+}
+
+// avroCanPromote returns whether data written with the writer type can be read
+// with the reader type as per the Avro specification's promotion rules.
+func avroCanPromote(writer, reader avro.Type) bool {
+	if writer == reader {
+		return true
+	}
+	switch writer {
+	case avro.Int:
+		return reader == avro.Long || reader == avro.Float || reader == avro.Double
+	case avro.Long:
+		return reader == avro.Float || reader == avro.Double
+	case avro.Float:
+		return reader == avro.Double
+	case avro.String:
+		return reader == avro.Bytes
+	case avro.Bytes:
+		return reader == avro.String
+	default:
+		return false
+	}
+}
+
+//nolint:gocognit,cyclop // Avro has many types which must all be compared individually
+func (d *avroDiffer) diff(path string, oldSchema, newSchema avro.Schema) {
+	oldSchema = unwrapAvroRef(oldSchema)
+	newSchema = unwrapAvroRef(newSchema)
+
+	oldUnion, oldIsUnion := oldSchema.(*avro.UnionSchema)
+	newUnion, newIsUnion := newSchema.(*avro.UnionSchema)
+	switch {
+	case oldIsUnion && newIsUnion:
+		d.diffUnion(path, oldUnion, newUnion)
+		return
+	case newIsUnion:
+		// A reader union can read the writer's data if any branch matches the old type.
+		// The old reader however can't read data written with any other branch.
+		idx := slices.IndexFunc(newUnion.Types(), func(t avro.Schema) bool { return avroCanResolve(oldSchema, t) })
+		d.changes = append(d.changes, newChange(path, ChangeTypeChanged, avroTypeName(oldSchema), avroTypeName(newSchema),
+			idx == -1, true,
+			"type was changed to a union; the old type can only read data that is written with a matching branch"))
+		if idx != -1 {
+			d.diff(path, oldSchema, newUnion.Types()[idx])
+		}
+		return
+	case oldIsUnion:
+		idx := slices.IndexFunc(oldUnion.Types(), func(t avro.Schema) bool { return avroCanResolve(t, newSchema) })
+		d.changes = append(d.changes, newChange(path, ChangeTypeChanged, avroTypeName(oldSchema), avroTypeName(newSchema),
+			true, idx == -1,
+			"type was changed from a union to a single type; data written with the other union branches can no longer be read"))
+		if idx != -1 {
+			d.diff(path, oldUnion.Types()[idx], newSchema)
+		}
+		return
+	}
+
+	if oldSchema.Type() != newSchema.Type() {
+		oldPrimitive, oldOk := oldSchema.(*avro.PrimitiveSchema)
+		newPrimitive, newOk := newSchema.(*avro.PrimitiveSchema)
+		if oldOk && newOk {
+			breaksBackward := !avroCanPromote(oldPrimitive.Type(), newPrimitive.Type())
+			breaksForward := !avroCanPromote(newPrimitive.Type(), oldPrimitive.Type())
+			reason := "the types are not promotable to each other"
+			if !breaksBackward {
+				reason = fmt.Sprintf("%s can be promoted to %s, but not the other way around", oldPrimitive.Type(), newPrimitive.Type())
+			} else if !breaksForward {
+				reason = fmt.Sprintf("%s can be promoted to %s, but not the other way around", newPrimitive.Type(), oldPrimitive.Type())
+			}
+			d.changes = append(d.changes, newChange(path, ChangeTypeChanged, avroTypeName(oldSchema), avroTypeName(newSchema),
+				breaksBackward, breaksForward, reason))
+			return
+		}
+
+		d.changes = append(d.changes, newChange(path, ChangeTypeChanged, avroTypeName(oldSchema), avroTypeName(newSchema),
+			true, true, "the types are not compatible with each other"))
+		return
+	}
+
+	// Named types must have the same name or refer to the other name via alias
+	if oldNamed, ok := oldSchema.(avro.NamedSchema); ok {
+		newNamed := newSchema.(avro.NamedSchema) //nolint:forcetypeassert // same avro type, so must be named as well
+		if oldNamed.FullName() != newNamed.FullName() {
+			breaksBackward := !slices.Contains(newNamed.Aliases(), oldNamed.FullName())
+			breaksForward := !slices.Contains(oldNamed.Aliases(), newNamed.FullName())
+			d.changes = append(d.changes, newChange(path, ChangeTypeChanged, oldNamed.FullName(), newNamed.FullName(),
+				breaksBackward, breaksForward, "named types must have matching names or aliases to be resolved"))
+		}
+
+		visitedKey := oldNamed.FullName() + "|" + newNamed.FullName()
+		if _, exists := d.visited[visitedKey]; exists {
+			return
+		}
+		d.visited[visitedKey] = struct{}{}
+	}
+
+	switch oldTyped := oldSchema.(type) {
+	case *avro.RecordSchema:
+		d.diffRecord(path, oldTyped, newSchema.(*avro.RecordSchema)) //nolint:forcetypeassert // same avro type
+	case *avro.EnumSchema:
+		d.diffEnum(path, oldTyped, newSchema.(*avro.EnumSchema)) //nolint:forcetypeassert // same avro type
+	case *avro.FixedSchema:
+		newFixed := newSchema.(*avro.FixedSchema) //nolint:forcetypeassert // same avro type
+		if oldTyped.Size() != newFixed.Size() {
+			d.changes = append(d.changes, newChange(path, ChangeFixedSizeChanged, strconv.Itoa(oldTyped.Size()), strconv.Itoa(newFixed.Size()),
+				true, true, "fixed types must have the same size to be resolved"))
+		}
+	case *avro.ArraySchema:
+		d.diff(path+"[]", oldTyped.Items(), newSchema.(*avro.ArraySchema).Items()) //nolint:forcetypeassert // same avro type
+	case *avro.MapSchema:
+		d.diff(path+"{}", oldTyped.Values(), newSchema.(*avro.MapSchema).Values()) //nolint:forcetypeassert // same avro type
+	case *avro.PrimitiveSchema:
+		oldName, newName := avroTypeName(oldSchema), avroTypeName(newSchema)
+		if oldName != newName {
+			// Logical types share the underlying representation, so readers can always
+			// decode the data, but the interpretation of the value changes.
+			d.changes = append(d.changes, newChange(path, ChangeTypeChanged, oldName, newName,
+				false, false, "the logical type changed, the encoded representation remains compatible"))
+		}
+	}
+}
+
+// avroCanResolve returns whether data written with the writer schema can be read
+// using the reader schema, without considering nested fields.
+func avroCanResolve(writer, reader avro.Schema) bool {
+	writer, reader = unwrapAvroRef(writer), unwrapAvroRef(reader)
+	if avroBranchKey(writer) == avroBranchKey(reader) {
+		return true
+	}
+	return avroCanPromote(writer.Type(), reader.Type())
+}
+
+func (d *avroDiffer) diffUnion(path string, oldUnion, newUnion *avro.UnionSchema) {
+	oldBranches := make(map[string]avro.Schema, len(oldUnion.Types()))
+	for _, t := range oldUnion.Types() {
+		oldBranches[avroBranchKey(t)] = t
+	}
+	newBranches := make(map[string]avro.Schema, len(newUnion.Types()))
+	for _, t := range newUnion.Types() {
+		newBranches[avroBranchKey(t)] = t
+	}
+
+	for key, newBranch := range newBranches {
+		oldBranch, exists := oldBranches[key]
+		if !exists {
+			d.changes = append(d.changes, newChange(path, ChangeUnionTypeAdded, "", avroTypeName(newBranch),
+				false, true, "consumers using the old schema can not resolve data written with the new union branch"))
+			continue
+		}
+		d.diff(path, oldBranch, newBranch)
+	}
+	for key, oldBranch := range oldBranches {
+		if _, exists := newBranches[key]; !exists {
+			d.changes = append(d.changes, newChange(path, ChangeUnionTypeRemoved, avroTypeName(oldBranch), "",
+				true, false, "consumers using the new schema can not resolve data written with the removed union branch"))
+		}
+	}
+}
+
+func (d *avroDiffer) diffRecord(path string, oldRecord, newRecord *avro.RecordSchema) {
+	oldFields := make(map[string]*avro.Field, len(oldRecord.Fields()))
+	for _, f := range oldRecord.Fields() {
+		oldFields[f.Name()] = f
+	}
+
+	matchedOldFields := make(map[string]struct{}, len(oldRecord.Fields()))
+	for _, newField := range newRecord.Fields() {
+		fieldPath := joinPath(path, newField.Name())
+
+		// A field may be renamed if the new field declares the old name as alias
+		oldField, exists := oldFields[newField.Name()]
+		if !exists {
+			for _, alias := range newField.Aliases() {
+				if f, ok := oldFields[alias]; ok {
+					oldField, exists = f, true
+					break
+				}
+			}
+		}
+
+		if !exists {
+			if newField.HasDefault() {
+				d.changes = append(d.changes, newChange(fieldPath, ChangeFieldAdded, "", avroTypeName(newField.Type()),
+					false, false, "the field has a default value that is used when reading old data"))
+			} else {
+				d.changes = append(d.changes, newChange(fieldPath, ChangeFieldAdded, "", avroTypeName(newField.Type()),
+					true, false, "the field has no default value, so it can't be populated when reading old data"))
+			}
+			continue
+		}
+
+		matchedOldFields[oldField.Name()] = struct{}{}
+		d.diff(fieldPath, oldField.Type(), newField.Type())
+		d.diffFieldDefault(fieldPath, oldField, newField)
+	}
+
+	for _, oldField := range oldRecord.Fields() {
+		if _, matched := matchedOldFields[oldField.Name()]; matched {
+			continue
+		}
+		fieldPath := joinPath(path, oldField.Name())
+		if oldField.HasDefault() {
+			d.changes = append(d.changes, newChange(fieldPath, ChangeFieldRemoved, avroTypeName(oldField.Type()), "",
+				false, false, "the field had a default value that is used by old consumers when reading new data"))
+		} else {
+			d.changes = append(d.changes, newChange(fieldPath, ChangeFieldRemoved, avroTypeName(oldField.Type()), "",
+				false, true, "the field had no default value, so old consumers can't populate it when reading new data"))
+		}
+	}
+}
+
+func (d *avroDiffer) diffFieldDefault(path string, oldField, newField *avro.Field) {
+	oldDefault, newDefault := avroDefaultString(oldField), avroDefaultString(newField)
+	if oldDefault == newDefault {
+		return
+	}
+
+	switch {
+	case oldField.HasDefault() && !newField.HasDefault():
+		// Readers using the new schema no longer have a value if the field were missing,
+		// that is only relevant once the field is removed from a writer schema.
+		d.changes = append(d.changes, newChange(path, ChangeDefaultChanged, oldDefault, newDefault,
+			false, false, "the default value was removed, removing the field in a later version will break old consumers"))
+	default:
+		d.changes = append(d.changes, newChange(path, ChangeDefaultChanged, oldDefault, newDefault,
+			false, false, "default values are only used if the field is missing in the writer schema"))
+	}
+}
+
+func avroDefaultString(f *avro.Field) string {
+	if !f.HasDefault() {
+		return ""
+	}
+	b, err := json.Marshal(f.Default())
+	if err != nil {
+		return fmt.Sprintf("%v", f.Default())
+	}
+	return string(b)
+}
+
+func (d *avroDiffer) diffEnum(path string, oldEnum, newEnum *avro.EnumSchema) {
+	for _, symbol := range newEnum.Symbols() {
+		if slices.Contains(oldEnum.Symbols(), symbol) {
+			continue
+		}
+		reason := "consumers using the old schema can't read the new symbol, because the old enum has no default"
+		if oldEnum.HasDefault() {
+			reason = "consumers using the old schema will read the new symbol as the enum's default " + strconv.Quote(oldEnum.Default())
+		}
+		d.changes = append(d.changes, newChange(joinPath(path, symbol), ChangeEnumSymbolAdded, "", symbol,
+			false, !oldEnum.HasDefault(), reason))
+	}
+	for _, symbol := range oldEnum.Symbols() {
+		if slices.Contains(newEnum.Symbols(), symbol) {
+			continue
+		}
+		reason := "consumers using the new schema can't read the removed symbol, because the new enum has no default"
+		if newEnum.HasDefault() {
+			reason = "consumers using the new schema will read the removed symbol as the enum's default " + strconv.Quote(newEnum.Default())
+		}
+		d.changes = append(d.changes, newChange(joinPath(path, symbol), ChangeEnumSymbolRemoved, symbol, "",
+			!newEnum.HasDefault(), false, reason))
+	}
+}
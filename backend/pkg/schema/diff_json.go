@@ -0,0 +1,294 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonSchemaRootPath is the path that is used for changes at the root of a JSON schema.
+const jsonSchemaRootPath = "#"
+
+// jsonSchemaUpperBounds are validation keywords where a lower value is more restrictive.
+var jsonSchemaUpperBounds = []string{"maxLength", "maxItems", "maxProperties", "maximum", "exclusiveMaximum"}
+
+// jsonSchemaLowerBounds are validation keywords where a higher value is more restrictive.
+var jsonSchemaLowerBounds = []string{"minLength", "minItems", "minProperties", "minimum", "exclusiveMinimum"}
+
+// diffJSONSchema compares two JSON schemas. A JSON schema consumer is compatible
+// with a producer if the consumer's schema accepts all documents that are valid
+// according to the producer's schema. References ($ref) are compared by their value
+// and not resolved.
+func diffJSONSchema(oldSchema, newSchema string) ([]SchemaChange, error) {
+	var oldNode, newNode map[string]any
+	if err := json.Unmarshal([]byte(oldSchema), &oldNode); err != nil {
+		return nil, fmt.Errorf("failed to parse old json schema: %w", err)
+	}
+	if err := json.Unmarshal([]byte(newSchema), &newNode); err != nil {
+		return nil, fmt.Errorf("failed to parse new json schema: %w", err)
+	}
+
+	return diffJSONSchemaNode(jsonSchemaRootPath, oldNode, newNode), nil
+}
+
+//nolint:gocognit,cyclop // Each JSON schema keyword is compared on its own
+func diffJSONSchemaNode(path string, oldNode, newNode map[string]any) []SchemaChange {
+	var changes []SchemaChange
+
+	oldRef, _ := oldNode["$ref"].(string)
+	newRef, _ := newNode["$ref"].(string)
+	if oldRef != newRef {
+		changes = append(changes, newChange(path, ChangeTypeChanged, oldRef, newRef, true, true,
+			"the referenced schema changed"))
+	}
+
+	// Type
+	oldTypes, newTypes := jsonSchemaTypes(oldNode), jsonSchemaTypes(newNode)
+	if !slices.Equal(oldTypes, newTypes) {
+		widened := jsonSchemaTypesCover(newTypes, oldTypes)
+		narrowed := jsonSchemaTypesCover(oldTypes, newTypes)
+		reason := "the types accept different values"
+		switch {
+		case widened:
+			reason = "the new type accepts more values than the old type"
+		case narrowed:
+			reason = "the new type accepts fewer values than the old type"
+		}
+		changes = append(changes, newChange(path, ChangeTypeChanged, strings.Join(oldTypes, "|"), strings.Join(newTypes, "|"),
+			!widened, !narrowed, reason))
+	}
+
+	// Content model
+	oldClosed, newClosed := jsonSchemaIsClosed(oldNode), jsonSchemaIsClosed(newNode)
+	if oldClosed != newClosed {
+		if newClosed {
+			changes = append(changes, newChange(path, ChangeContentModelChanged, "open", "closed", true, false,
+				"additional properties are no longer allowed, but old data may contain them"))
+		} else {
+			changes = append(changes, newChange(path, ChangeContentModelChanged, "closed", "open", false, true,
+				"additional properties are allowed now, but old consumers reject them"))
+		}
+	}
+
+	// Properties
+	oldProps, newProps := jsonSchemaObject(oldNode, "properties"), jsonSchemaObject(newNode, "properties")
+	oldRequired, newRequired := jsonSchemaStrings(oldNode, "required"), jsonSchemaStrings(newNode, "required")
+	for _, name := range sortedKeys(newProps) {
+		propPath := joinPath(path, name)
+		newProp, _ := newProps[name].(map[string]any)
+		oldPropAny, exists := oldProps[name]
+		if !exists {
+			isRequired := slices.Contains(newRequired, name)
+			var reasons []string
+			if isRequired {
+				reasons = append(reasons, "the property is required, but old data does not contain it")
+			}
+			if oldClosed {
+				reasons = append(reasons, "the old schema does not allow additional properties")
+			} else {
+				reasons = append(reasons, "the old schema allowed arbitrary additional properties, so old data may contain it with a different type")
+			}
+			changes = append(changes, newChange(propPath, ChangeFieldAdded, "", strings.Join(jsonSchemaTypes(newProp), "|"),
+				isRequired || !oldClosed, oldClosed, strings.Join(reasons, "; ")))
+			continue
+		}
+
+		oldProp, _ := oldPropAny.(map[string]any)
+		changes = append(changes, diffJSONSchemaNode(propPath, oldProp, newProp)...)
+
+		wasRequired, isRequired := slices.Contains(oldRequired, name), slices.Contains(newRequired, name)
+		switch {
+		case !wasRequired && isRequired:
+			changes = append(changes, newChange(propPath, ChangeRequiredChanged, "optional", "required", true, false,
+				"old data may not contain the property"))
+		case wasRequired && !isRequired:
+			changes = append(changes, newChange(propPath, ChangeRequiredChanged, "required", "optional", false, true,
+				"new data may not contain the property that old consumers require"))
+		}
+	}
+	for _, name := range sortedKeys(oldProps) {
+		if _, exists := newProps[name]; exists {
+			continue
+		}
+		oldProp, _ := oldProps[name].(map[string]any)
+		wasRequired := slices.Contains(oldRequired, name)
+		var reasons []string
+		if newClosed {
+			reasons = append(reasons, "the new schema does not allow additional properties, but old data contains it")
+		}
+		if wasRequired {
+			reasons = append(reasons, "the property was required by old consumers")
+		}
+		if len(reasons) == 0 {
+			reasons = append(reasons, "the new schema allows additional properties, so old data remains valid")
+		}
+		changes = append(changes, newChange(joinPath(path, name), ChangeFieldRemoved, strings.Join(jsonSchemaTypes(oldProp), "|"), "",
+			newClosed, wasRequired, strings.Join(reasons, "; ")))
+	}
+
+	// Array items
+	oldItems, oldHasItems := oldNode["items"].(map[string]any)
+	newItems, newHasItems := newNode["items"].(map[string]any)
+	if oldHasItems && newHasItems {
+		changes = append(changes, diffJSONSchemaNode(path+"[]", oldItems, newItems)...)
+	}
+
+	// Enum values
+	oldEnum, newEnum := jsonSchemaEnumValues(oldNode), jsonSchemaEnumValues(newNode)
+	if oldEnum != nil && newEnum != nil {
+		for _, v := range newEnum {
+			if !slices.Contains(oldEnum, v) {
+				changes = append(changes, newChange(joinPath(path, v), ChangeEnumSymbolAdded, "", v, false, true,
+					"old consumers reject the new enum value"))
+			}
+		}
+		for _, v := range oldEnum {
+			if !slices.Contains(newEnum, v) {
+				changes = append(changes, newChange(joinPath(path, v), ChangeEnumSymbolRemoved, v, "", true, false,
+					"old data may contain the removed enum value"))
+			}
+		}
+	}
+
+	// Validation keywords
+	for _, keyword := range jsonSchemaUpperBounds {
+		changes = append(changes, diffJSONSchemaBound(path, keyword, oldNode, newNode, true)...)
+	}
+	for _, keyword := range jsonSchemaLowerBounds {
+		changes = append(changes, diffJSONSchemaBound(path, keyword, oldNode, newNode, false)...)
+	}
+
+	return changes
+}
+
+// diffJSONSchemaBound compares numeric validation keywords. If isUpperBound is true
+// a lower value restricts the accepted values, otherwise a higher value does.
+func diffJSONSchemaBound(path, keyword string, oldNode, newNode map[string]any, isUpperBound bool) []SchemaChange {
+	oldValue, oldExists := oldNode[keyword].(float64)
+	newValue, newExists := newNode[keyword].(float64)
+	if oldExists == newExists && oldValue == newValue {
+		return nil
+	}
+
+	var tightened bool
+	switch {
+	case !oldExists:
+		tightened = true
+	case !newExists:
+		tightened = false
+	case isUpperBound:
+		tightened = newValue < oldValue
+	default:
+		tightened = newValue > oldValue
+	}
+
+	format := func(v float64, exists bool) string {
+		if !exists {
+			return ""
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	reason := fmt.Sprintf("%q was relaxed, old consumers may reject new data", keyword)
+	if tightened {
+		reason = fmt.Sprintf("%q was restricted, old data may no longer be valid", keyword)
+	}
+	return []SchemaChange{newChange(path, ChangeConstraintChanged, format(oldValue, oldExists), format(newValue, newExists),
+		tightened, !tightened, reason)}
+}
+
+func jsonSchemaTypes(node map[string]any) []string {
+	var types []string
+	switch t := node["type"].(type) {
+	case string:
+		types = []string{t}
+	case []any:
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				types = append(types, s)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// jsonSchemaTypesCover returns whether all values accepted by the inner types are
+// also accepted by the outer types. An empty type list accepts any value.
+func jsonSchemaTypesCover(outer, inner []string) bool {
+	if len(outer) == 0 {
+		return true
+	}
+	if len(inner) == 0 {
+		return false
+	}
+	for _, t := range inner {
+		if slices.Contains(outer, t) {
+			continue
+		}
+		if t == "integer" && slices.Contains(outer, "number") {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+func jsonSchemaIsClosed(node map[string]any) bool {
+	additional, ok := node["additionalProperties"].(bool)
+	return ok && !additional
+}
+
+func jsonSchemaObject(node map[string]any, key string) map[string]any {
+	obj, _ := node[key].(map[string]any)
+	return obj
+}
+
+func jsonSchemaStrings(node map[string]any, key string) []string {
+	values, _ := node[key].([]any)
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func jsonSchemaEnumValues(node map[string]any) []string {
+	values, ok := node["enum"].([]any)
+	if !ok {
+		return nil
+	}
+	res := make([]string, len(values))
+	for i, v := range values {
+		b, err := json.Marshal(v)
+		if err != nil {
+			res[i] = fmt.Sprintf("%v", v)
+			continue
+		}
+		res[i] = string(b)
+	}
+	return res
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,320 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// diffProtobuf compares two compiled protobuf schemas. Fields are matched by their
+// field number, as that's what is encoded on the wire. Messages and enums are
+// matched by their name relative to the package.
+func diffProtobuf(oldFD, newFD *desc.FileDescriptor) []SchemaChange {
+	var changes []SchemaChange
+
+	if oldFD.GetPackage() != newFD.GetPackage() {
+		changes = append(changes, newChange(newFD.GetPackage(), ChangePackageChanged, oldFD.GetPackage(), newFD.GetPackage(),
+			true, true, "the fully qualified names of all types change, which is what is used to resolve message types"))
+	}
+
+	oldMessages := protoMessagesByName(oldFD)
+	newMessages := protoMessagesByName(newFD)
+	for name, newMsg := range newMessages {
+		oldMsg, exists := oldMessages[name]
+		if !exists {
+			changes = append(changes, newChange(name, ChangeTypeAdded, "", "message", false, false,
+				"adding a message type does not affect existing data"))
+			continue
+		}
+		changes = append(changes, diffProtoMessage(name, oldMsg, newMsg)...)
+	}
+	for name := range oldMessages {
+		if _, exists := newMessages[name]; !exists {
+			changes = append(changes, newChange(name, ChangeTypeRemoved, "message", "", true, true,
+				"the message type can no longer be resolved by its name"))
+		}
+	}
+
+	oldEnums := protoEnumsByName(oldFD)
+	newEnums := protoEnumsByName(newFD)
+	for name, newEnum := range newEnums {
+		oldEnum, exists := oldEnums[name]
+		if !exists {
+			changes = append(changes, newChange(name, ChangeTypeAdded, "", "enum", false, false,
+				"adding an enum type does not affect existing data"))
+			continue
+		}
+		changes = append(changes, diffProtoEnum(name, oldEnum, newEnum)...)
+	}
+	for name := range oldEnums {
+		if _, exists := newEnums[name]; !exists {
+			changes = append(changes, newChange(name, ChangeTypeRemoved, "enum", "", true, true,
+				"the enum type can no longer be resolved by its name"))
+		}
+	}
+
+	return changes
+}
+
+// protoRelativeName returns the descriptor's name without the package prefix.
+func protoRelativeName(d desc.Descriptor) string {
+	pkg := d.GetFile().GetPackage()
+	if pkg == "" {
+		return d.GetFullyQualifiedName()
+	}
+	return strings.TrimPrefix(d.GetFullyQualifiedName(), pkg+".")
+}
+
+func protoMessagesByName(fd *desc.FileDescriptor) map[string]*desc.MessageDescriptor {
+	messages := make(map[string]*desc.MessageDescriptor)
+	var collect func(msgs []*desc.MessageDescriptor)
+	collect = func(msgs []*desc.MessageDescriptor) {
+		for _, msg := range msgs {
+			if msg.IsMapEntry() {
+				continue
+			}
+			messages[protoRelativeName(msg)] = msg
+			collect(msg.GetNestedMessageTypes())
+		}
+	}
+	collect(fd.GetMessageTypes())
+	return messages
+}
+
+func protoEnumsByName(fd *desc.FileDescriptor) map[string]*desc.EnumDescriptor {
+	enums := make(map[string]*desc.EnumDescriptor)
+	for _, enum := range fd.GetEnumTypes() {
+		enums[protoRelativeName(enum)] = enum
+	}
+	for _, msg := range protoMessagesByName(fd) {
+		for _, enum := range msg.GetNestedEnumTypes() {
+			enums[protoRelativeName(enum)] = enum
+		}
+	}
+	return enums
+}
+
+func diffProtoMessage(path string, oldMsg, newMsg *desc.MessageDescriptor) []SchemaChange {
+	var changes []SchemaChange
+
+	oldFields := make(map[int32]*desc.FieldDescriptor, len(oldMsg.GetFields()))
+	for _, f := range oldMsg.GetFields() {
+		oldFields[f.GetNumber()] = f
+	}
+
+	newFields := make(map[int32]*desc.FieldDescriptor, len(newMsg.GetFields()))
+	for _, newField := range newMsg.GetFields() {
+		newFields[newField.GetNumber()] = newField
+		fieldPath := joinPath(path, newField.GetName())
+
+		oldField, exists := oldFields[newField.GetNumber()]
+		if !exists {
+			if newField.IsRequired() {
+				changes = append(changes, newChange(fieldPath, ChangeFieldAdded, "", protoFieldTypeName(newField),
+					true, false, "the field is required, but data written with the old schema does not contain it"))
+			} else {
+				changes = append(changes, newChange(fieldPath, ChangeFieldAdded, "", protoFieldTypeName(newField),
+					false, false, "unknown fields are ignored by old consumers and missing fields use the default value"))
+			}
+			continue
+		}
+
+		changes = append(changes, diffProtoField(fieldPath, oldField, newField)...)
+	}
+
+	for number, oldField := range oldFields {
+		if _, exists := newFields[number]; exists {
+			continue
+		}
+		fieldPath := joinPath(path, oldField.GetName())
+		if oldField.IsRequired() {
+			changes = append(changes, newChange(fieldPath, ChangeFieldRemoved, protoFieldTypeName(oldField), "",
+				false, true, "the field is required by old consumers, but data written with the new schema does not contain it"))
+		} else {
+			changes = append(changes, newChange(fieldPath, ChangeFieldRemoved, protoFieldTypeName(oldField), "",
+				false, false, "missing fields use the default value; the field number "+strconv.Itoa(int(number))+" should be reserved so that it is never reused"))
+		}
+	}
+
+	return changes
+}
+
+func diffProtoField(path string, oldField, newField *desc.FieldDescriptor) []SchemaChange {
+	var changes []SchemaChange
+
+	if oldField.GetName() != newField.GetName() {
+		changes = append(changes, newChange(path, ChangeFieldRenamed, oldField.GetName(), newField.GetName(),
+			false, false, "the binary encoding only uses the field number, but the JSON representation of the data changes"))
+	}
+
+	oldType, newType := protoFieldTypeName(oldField), protoFieldTypeName(newField)
+	if oldType != newType {
+		if protoWireCompatible(oldField, newField) {
+			changes = append(changes, newChange(path, ChangeTypeChanged, oldType, newType,
+				false, false, "the types share the same wire encoding, but values may be truncated or interpreted differently"))
+		} else {
+			changes = append(changes, newChange(path, ChangeTypeChanged, oldType, newType,
+				true, true, "the types use incompatible wire encodings"))
+		}
+	}
+
+	if oldField.IsRepeated() != newField.IsRepeated() && !oldField.IsMap() && !newField.IsMap() {
+		if protoIsLengthDelimited(oldField.GetType()) && protoIsLengthDelimited(newField.GetType()) {
+			changes = append(changes, newChange(path, ChangeTypeChanged, protoLabelName(oldField), protoLabelName(newField),
+				false, false, "singular and repeated length-delimited fields are wire compatible, singular readers keep the last element"))
+		} else {
+			changes = append(changes, newChange(path, ChangeTypeChanged, protoLabelName(oldField), protoLabelName(newField),
+				true, true, "repeated scalar fields may be packed, which singular readers can not decode"))
+		}
+	}
+
+	if oldField.IsRequired() != newField.IsRequired() {
+		if newField.IsRequired() {
+			changes = append(changes, newChange(path, ChangeRequiredChanged, "optional", "required",
+				true, false, "data written with the old schema may not contain the field"))
+		} else {
+			changes = append(changes, newChange(path, ChangeRequiredChanged, "required", "optional",
+				false, true, "data written with the new schema may not contain the field that old consumers require"))
+		}
+	}
+
+	oldOneof, newOneof := protoOneofName(oldField), protoOneofName(newField)
+	if oldOneof != newOneof && newOneof != "" {
+		// Moving a field into a oneof that already existed changes the semantics of the existing
+		// oneof: setting the field clears the other members.
+		existed := false
+		for _, oneof := range oldField.GetOwner().GetOneOfs() {
+			if oneof.GetName() == newOneof {
+				existed = true
+				break
+			}
+		}
+		if existed {
+			changes = append(changes, newChange(path, ChangeOneofChanged, oldOneof, newOneof,
+				true, true, "the field was moved into an existing oneof, which may silently clear other members"))
+		} else {
+			changes = append(changes, newChange(path, ChangeOneofChanged, oldOneof, newOneof,
+				false, false, "the field was moved into a new oneof"))
+		}
+	} else if oldOneof != newOneof {
+		changes = append(changes, newChange(path, ChangeOneofChanged, oldOneof, newOneof,
+			false, false, "the field was moved out of a oneof"))
+	}
+
+	return changes
+}
+
+func diffProtoEnum(path string, oldEnum, newEnum *desc.EnumDescriptor) []SchemaChange {
+	var changes []SchemaChange
+
+	oldValues := make(map[int32]*desc.EnumValueDescriptor, len(oldEnum.GetValues()))
+	for _, v := range oldEnum.GetValues() {
+		oldValues[v.GetNumber()] = v
+	}
+	newValues := make(map[int32]*desc.EnumValueDescriptor, len(newEnum.GetValues()))
+	for _, newValue := range newEnum.GetValues() {
+		newValues[newValue.GetNumber()] = newValue
+		oldValue, exists := oldValues[newValue.GetNumber()]
+		if !exists {
+			changes = append(changes, newChange(joinPath(path, newValue.GetName()), ChangeEnumSymbolAdded, "", newValue.GetName(),
+				false, false, "unknown enum values are preserved as their number by old consumers"))
+			continue
+		}
+		if oldValue.GetName() != newValue.GetName() {
+			changes = append(changes, newChange(joinPath(path, newValue.GetName()), ChangeFieldRenamed, oldValue.GetName(), newValue.GetName(),
+				false, false, "the binary encoding only uses the enum number, but the JSON representation of the data changes"))
+		}
+	}
+	for number, oldValue := range oldValues {
+		if _, exists := newValues[number]; !exists {
+			changes = append(changes, newChange(joinPath(path, oldValue.GetName()), ChangeEnumSymbolRemoved, oldValue.GetName(), "",
+				false, false, "unknown enum values are preserved as their number by new consumers; the number should be reserved"))
+		}
+	}
+
+	return changes
+}
+
+func protoFieldTypeName(fd *desc.FieldDescriptor) string {
+	switch {
+	case fd.IsMap():
+		return "map<" + protoFieldTypeName(fd.GetMapKeyType()) + ", " + protoFieldTypeName(fd.GetMapValueType()) + ">"
+	case fd.GetMessageType() != nil:
+		return protoRelativeName(fd.GetMessageType())
+	case fd.GetEnumType() != nil:
+		return protoRelativeName(fd.GetEnumType())
+	default:
+		return strings.ToLower(strings.TrimPrefix(fd.GetType().String(), "TYPE_"))
+	}
+}
+
+func protoLabelName(fd *desc.FieldDescriptor) string {
+	if fd.IsRepeated() {
+		return "repeated " + protoFieldTypeName(fd)
+	}
+	return protoFieldTypeName(fd)
+}
+
+func protoOneofName(fd *desc.FieldDescriptor) string {
+	oneof := fd.GetOneOf()
+	if oneof == nil || oneof.IsSynthetic() {
+		return ""
+	}
+	return oneof.GetName()
+}
+
+func protoIsLengthDelimited(t descriptorpb.FieldDescriptorProto_Type) bool {
+	switch t {
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+		descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+		return true
+	default:
+		return false
+	}
+}
+
+// protoWireCompatible returns whether the two field types can be exchanged without
+// breaking the wire format. Message and enum types are only compatible if their
+// relative names match, which is checked by the caller already.
+func protoWireCompatible(oldField, newField *desc.FieldDescriptor) bool {
+	group := func(t descriptorpb.FieldDescriptorProto_Type) string {
+		switch t {
+		case descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_INT64,
+			descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+			descriptorpb.FieldDescriptorProto_TYPE_BOOL, descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+			return "varint"
+		case descriptorpb.FieldDescriptorProto_TYPE_SINT32, descriptorpb.FieldDescriptorProto_TYPE_SINT64:
+			return "zigzag"
+		case descriptorpb.FieldDescriptorProto_TYPE_FIXED32, descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+			return "fixed32"
+		case descriptorpb.FieldDescriptorProto_TYPE_FIXED64, descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+			return "fixed64"
+		case descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+			return "string"
+		default:
+			return t.String()
+		}
+	}
+
+	// Two different enum or message types are never compatible
+	if oldField.GetType() == newField.GetType() {
+		return oldField.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE &&
+			oldField.GetType() != descriptorpb.FieldDescriptorProto_TYPE_ENUM
+	}
+	if oldField.IsMap() || newField.IsMap() {
+		return false
+	}
+	return group(oldField.GetType()) == group(newField.GetType())
+}
@@ -0,0 +1,214 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func newTestDiffService(t *testing.T) *Service {
+	t.Helper()
+
+	s, err := NewService(config.Schema{
+		Enabled: true,
+		URLs:    []string{testSchemaRegistryBaseURL},
+	}, zap.NewNop())
+	require.NoError(t, err)
+	return s
+}
+
+func findChange(changes []SchemaChange, path string, kind ChangeKind) *SchemaChange {
+	for i := range changes {
+		if changes[i].Path == path && changes[i].Kind == kind {
+			return &changes[i]
+		}
+	}
+	return nil
+}
+
+func TestService_DiffSchemas_Avro(t *testing.T) {
+	s := newTestDiffService(t)
+
+	oldSchema := Schema{
+		Type: TypeAvro,
+		Schema: `{"type":"record","name":"Order","namespace":"shop","fields":[
+			{"name":"id","type":"int"},
+			{"name":"note","type":"string"},
+			{"name":"status","type":{"type":"enum","name":"Status","symbols":["OPEN","CLOSED"]}}
+		]}`,
+	}
+	newSchema := Schema{
+		Type: TypeAvro,
+		Schema: `{"type":"record","name":"Order","namespace":"shop","fields":[
+			{"name":"id","type":"long"},
+			{"name":"customer","type":"string"},
+			{"name":"discount","type":"double","default":0},
+			{"name":"status","type":{"type":"enum","name":"Status","symbols":["OPEN","CLOSED","CANCELLED"]}}
+		]}`,
+	}
+
+	diff, err := s.DiffSchemas(context.Background(), "orders-value", oldSchema, newSchema)
+	require.NoError(t, err)
+
+	promoted := findChange(diff.Changes, "shop.Order.id", ChangeTypeChanged)
+	require.NotNil(t, promoted)
+	assert.False(t, promoted.BreaksBackward, "int can be promoted to long")
+	assert.True(t, promoted.BreaksForward, "long can not be read as int")
+
+	addedNoDefault := findChange(diff.Changes, "shop.Order.customer", ChangeFieldAdded)
+	require.NotNil(t, addedNoDefault)
+	assert.True(t, addedNoDefault.BreaksBackward)
+	assert.False(t, addedNoDefault.BreaksForward)
+
+	addedWithDefault := findChange(diff.Changes, "shop.Order.discount", ChangeFieldAdded)
+	require.NotNil(t, addedWithDefault)
+	assert.False(t, addedWithDefault.BreaksBackward)
+	assert.False(t, addedWithDefault.BreaksForward)
+
+	removed := findChange(diff.Changes, "shop.Order.note", ChangeFieldRemoved)
+	require.NotNil(t, removed)
+	assert.False(t, removed.BreaksBackward)
+	assert.True(t, removed.BreaksForward)
+
+	symbolAdded := findChange(diff.Changes, "shop.Order.status.CANCELLED", ChangeEnumSymbolAdded)
+	require.NotNil(t, symbolAdded)
+	assert.True(t, symbolAdded.BreaksForward)
+
+	assert.False(t, diff.IsCompatible(CompatBackward))
+	assert.False(t, diff.IsCompatible(CompatForward))
+	assert.True(t, diff.IsCompatible(CompatNone))
+	assert.Len(t, diff.Violations(CompatBackward), 1)
+	assert.Contains(t, addedNoDefault.IncompatibleWith, CompatFullTransitive)
+	assert.NotEmpty(t, addedNoDefault.Explain(CompatBackward))
+	assert.Empty(t, addedNoDefault.Explain(CompatForward))
+}
+
+func TestService_DiffSchemas_Protobuf(t *testing.T) {
+	s := newTestDiffService(t)
+
+	oldSchema := Schema{
+		Type: TypeProtobuf,
+		Schema: `syntax = "proto3";
+package shop;
+message Order {
+  int32 id = 1;
+  string note = 2;
+  string customer = 3;
+}`,
+	}
+	newSchema := Schema{
+		Type: TypeProtobuf,
+		Schema: `syntax = "proto3";
+package shop;
+message Order {
+  int64 id = 1;
+  bytes customer_name = 3;
+  double discount = 4;
+  Address address = 5;
+}
+message Address {
+  string street = 1;
+}`,
+	}
+
+	diff, err := s.DiffSchemas(context.Background(), "orders-value", oldSchema, newSchema)
+	require.NoError(t, err)
+
+	widened := findChange(diff.Changes, "Order.id", ChangeTypeChanged)
+	require.NotNil(t, widened)
+	assert.False(t, widened.BreaksBackward)
+	assert.False(t, widened.BreaksForward)
+
+	renamed := findChange(diff.Changes, "Order.customer_name", ChangeFieldRenamed)
+	require.NotNil(t, renamed)
+	assert.Equal(t, "customer", renamed.OldValue)
+
+	assert.NotNil(t, findChange(diff.Changes, "Order.note", ChangeFieldRemoved))
+	assert.NotNil(t, findChange(diff.Changes, "Order.discount", ChangeFieldAdded))
+	assert.NotNil(t, findChange(diff.Changes, "Address", ChangeTypeAdded))
+	assert.True(t, diff.IsCompatible(CompatFullTransitive))
+
+	incompatible := Schema{
+		Type: TypeProtobuf,
+		Schema: `syntax = "proto3";
+package shop;
+message Order {
+  string id = 1;
+}`,
+	}
+	diff, err = s.DiffSchemas(context.Background(), "orders-value", oldSchema, incompatible)
+	require.NoError(t, err)
+	typeChange := findChange(diff.Changes, "Order.id", ChangeTypeChanged)
+	require.NotNil(t, typeChange)
+	assert.True(t, typeChange.BreaksBackward)
+	assert.True(t, typeChange.BreaksForward)
+}
+
+func TestService_DiffSchemas_JSON(t *testing.T) {
+	s := newTestDiffService(t)
+
+	oldSchema := Schema{
+		Type: TypeJSON,
+		Schema: `{"type":"object","additionalProperties":false,"required":["id"],"properties":{
+			"id":{"type":"integer"},
+			"name":{"type":"string","maxLength":100},
+			"kind":{"enum":["a","b"]}
+		}}`,
+	}
+	newSchema := Schema{
+		Type: TypeJSON,
+		Schema: `{"type":"object","additionalProperties":false,"required":["id","name"],"properties":{
+			"id":{"type":"number"},
+			"name":{"type":"string","maxLength":50},
+			"kind":{"enum":["a","b","c"]},
+			"email":{"type":"string"}
+		}}`,
+	}
+
+	diff, err := s.DiffSchemas(context.Background(), "users-value", oldSchema, newSchema)
+	require.NoError(t, err)
+
+	widened := findChange(diff.Changes, "#.id", ChangeTypeChanged)
+	require.NotNil(t, widened)
+	assert.False(t, widened.BreaksBackward)
+	assert.True(t, widened.BreaksForward)
+
+	nowRequired := findChange(diff.Changes, "#.name", ChangeRequiredChanged)
+	require.NotNil(t, nowRequired)
+	assert.True(t, nowRequired.BreaksBackward)
+
+	tightened := findChange(diff.Changes, "#.name", ChangeConstraintChanged)
+	require.NotNil(t, tightened)
+	assert.True(t, tightened.BreaksBackward)
+	assert.False(t, tightened.BreaksForward)
+
+	added := findChange(diff.Changes, "#.email", ChangeFieldAdded)
+	require.NotNil(t, added)
+	assert.False(t, added.BreaksBackward, "closed content model can't contain unknown properties")
+	assert.True(t, added.BreaksForward)
+
+	assert.NotNil(t, findChange(diff.Changes, `#.kind."c"`, ChangeEnumSymbolAdded))
+}
+
+func TestService_DiffSchemas_DifferentTypes(t *testing.T) {
+	s := newTestDiffService(t)
+
+	_, err := s.DiffSchemas(context.Background(), "subject",
+		Schema{Type: TypeAvro, Schema: `"string"`},
+		Schema{Type: TypeJSON, Schema: `{"type":"string"}`})
+	assert.Error(t, err)
+}
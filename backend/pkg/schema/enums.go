@@ -148,3 +148,30 @@ func (l *CompatibilityLevel) UnmarshalText(text []byte) error {
 	}
 	return nil
 }
+
+// Mode as an enum representing the schema registry modes.
+type Mode string
+
+const (
+	// ModeReadWrite allows to register and delete schemas. This is the default mode.
+	ModeReadWrite Mode = "READWRITE"
+	// ModeReadOnly rejects all requests that would register or delete schemas.
+	ModeReadOnly Mode = "READONLY"
+	// ModeReadOnlyOverride is like ModeReadOnly, but it can not be overridden
+	// by a subject-level mode.
+	ModeReadOnlyOverride Mode = "READONLY_OVERRIDE"
+	// ModeImport allows to register schemas with a given id and version, which
+	// is required to migrate schemas from another schema registry.
+	ModeImport Mode = "IMPORT"
+)
+
+// UnmarshalText unmarshals the mode.
+func (m *Mode) UnmarshalText(text []byte) error {
+	switch s := Mode(strings.ToUpper(string(text))); s {
+	default:
+		return fmt.Errorf("unknown mode %q", s)
+	case ModeReadWrite, ModeReadOnly, ModeReadOnlyOverride, ModeImport:
+		*m = s
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+)
+
+// Metadata is the optional metadata that can be attached to a schema. Together
+// with a RuleSet it forms a data contract.
+type Metadata struct {
+	// Tags maps a path within the schema (e.g. a field name) to a list of tags.
+	Tags map[string][]string `json:"tags,omitempty"`
+	// Properties are arbitrary key value pairs such as owner or description.
+	Properties map[string]string `json:"properties,omitempty"`
+	// Sensitive lists property names whose values should not be displayed.
+	Sensitive []string `json:"sensitive,omitempty"`
+}
+
+// RuleSet groups the rules that are attached to a schema.
+type RuleSet struct {
+	// MigrationRules are applied when records are transformed between
+	// incompatible schema versions.
+	MigrationRules []Rule `json:"migrationRules,omitempty"`
+	// DomainRules are applied when records are written or read.
+	DomainRules []Rule `json:"domainRules,omitempty"`
+}
+
+// RuleKind describes whether a rule validates or transforms a record.
+type RuleKind string
+
+const (
+	// RuleKindCondition is a rule that must evaluate to true.
+	RuleKindCondition RuleKind = "CONDITION"
+	// RuleKindTransform is a rule that modifies the record.
+	RuleKindTransform RuleKind = "TRANSFORM"
+)
+
+// RuleMode describes when a rule is applied.
+type RuleMode string
+
+const (
+	// RuleModeUpgrade applies a migration rule when upgrading to a newer version.
+	RuleModeUpgrade RuleMode = "UPGRADE"
+	// RuleModeDowngrade applies a migration rule when downgrading to an older version.
+	RuleModeDowngrade RuleMode = "DOWNGRADE"
+	// RuleModeUpDown applies a migration rule in both directions.
+	RuleModeUpDown RuleMode = "UPDOWN"
+	// RuleModeWrite applies a domain rule when producing records.
+	RuleModeWrite RuleMode = "WRITE"
+	// RuleModeRead applies a domain rule when consuming records.
+	RuleModeRead RuleMode = "READ"
+	// RuleModeWriteRead applies a domain rule when producing and consuming records.
+	RuleModeWriteRead RuleMode = "WRITEREAD"
+)
+
+const (
+	// RuleTypeCEL is a rule whose expression is evaluated against the whole message.
+	RuleTypeCEL = "CEL"
+	// RuleTypeCELField is a rule whose expression is evaluated against individual fields.
+	RuleTypeCELField = "CEL_FIELD"
+
+	// ruleActionNone ignores the outcome of a rule.
+	ruleActionNone = "NONE"
+)
+
+// Rule is a single data contract rule.
+type Rule struct {
+	Name      string            `json:"name"`
+	Doc       string            `json:"doc,omitempty"`
+	Kind      RuleKind          `json:"kind,omitempty"`
+	Mode      RuleMode          `json:"mode,omitempty"`
+	Type      string            `json:"type,omitempty"`
+	Tags      []string          `json:"tags,omitempty"`
+	Params    map[string]string `json:"params,omitempty"`
+	Expr      string            `json:"expr,omitempty"`
+	OnSuccess string            `json:"onSuccess,omitempty"`
+	OnFailure string            `json:"onFailure,omitempty"`
+	Disabled  bool              `json:"disabled,omitempty"`
+}
+
+// appliesTo returns whether the rule's mode includes the given mode. Domain
+// rules without a mode apply to writes and reads.
+func (r *Rule) appliesTo(mode RuleMode) bool {
+	switch r.Mode {
+	case "", RuleModeWriteRead:
+		return mode == RuleModeWrite || mode == RuleModeRead
+	case RuleModeUpDown:
+		return mode == RuleModeUpgrade || mode == RuleModeDowngrade
+	default:
+		return r.Mode == mode
+	}
+}
+
+// RuleViolationError is returned if a record does not satisfy one or more
+// condition rules of a data contract.
+type RuleViolationError struct {
+	Violations []RuleViolation
+}
+
+// RuleViolation describes a single rule that was not satisfied.
+type RuleViolation struct {
+	RuleName string `json:"ruleName"`
+	Expr     string `json:"expr"`
+	Message  string `json:"message"`
+}
+
+func (e *RuleViolationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = fmt.Sprintf("rule %q: %s", v.RuleName, v.Message)
+	}
+	return "record violates data contract: " + strings.Join(msgs, "; ")
+}
+
+// EvaluateDomainConditions evaluates all enabled CEL condition domain rules that
+// apply to the given mode against the message. The message is exposed to the
+// expressions as the variable "message" and is usually the JSON decoded record.
+//
+// Only rules of type CEL are evaluated. Field level (CEL_FIELD) rules and
+// transforms require the schema aware rule executors of the client serializers
+// and are skipped.
+func (rs *RuleSet) EvaluateDomainConditions(mode RuleMode, message any) error {
+	if rs == nil {
+		return nil
+	}
+
+	var violations []RuleViolation
+	for i := range rs.DomainRules {
+		rule := &rs.DomainRules[i]
+		if rule.Disabled || rule.Type != RuleTypeCEL || !rule.appliesTo(mode) {
+			continue
+		}
+		if rule.Kind != "" && rule.Kind != RuleKindCondition {
+			continue
+		}
+
+		err := evaluateCELCondition(rule.Expr, message)
+		if err == nil || rule.OnFailure == ruleActionNone {
+			continue
+		}
+		violations = append(violations, RuleViolation{
+			RuleName: rule.Name,
+			Expr:     rule.Expr,
+			Message:  err.Error(),
+		})
+	}
+
+	if len(violations) > 0 {
+		return &RuleViolationError{Violations: violations}
+	}
+	return nil
+}
+
+// evaluateCELCondition compiles the expression and returns an error if it does
+// not evaluate to true for the given message.
+func evaluateCELCondition(expr string, message any) error {
+	env, err := cel.NewEnv(
+		cel.Variable("message", cel.DynType),
+		cel.CrossTypeNumericComparisons(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return fmt.Errorf("failed to compile expression: %w", issues.Err())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return fmt.Errorf("failed to create program: %w", err)
+	}
+
+	out, _, err := prg.Eval(map[string]any{"message": message})
+	if err != nil {
+		return fmt.Errorf("failed to evaluate expression: %w", err)
+	}
+	satisfied, ok := out.Value().(bool)
+	if !ok {
+		return errors.New("expression did not evaluate to a boolean")
+	}
+	if !satisfied {
+		return errors.New("condition evaluated to false")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleSet_EvaluateDomainConditions(t *testing.T) {
+	ruleSet := &RuleSet{
+		DomainRules: []Rule{
+			{Name: "positiveAmount", Kind: RuleKindCondition, Mode: RuleModeWrite, Type: RuleTypeCEL, Expr: "message.amount > 0"},
+			{Name: "hasCurrency", Type: RuleTypeCEL, Expr: "has(message.currency)"},
+			{Name: "readOnly", Mode: RuleModeRead, Type: RuleTypeCEL, Expr: "false"},
+			{Name: "disabled", Mode: RuleModeWrite, Type: RuleTypeCEL, Expr: "false", Disabled: true},
+			{Name: "ignored", Mode: RuleModeWrite, Type: RuleTypeCEL, Expr: "false", OnFailure: "NONE"},
+			{Name: "fieldRule", Mode: RuleModeWrite, Type: RuleTypeCELField, Expr: "false"},
+			{Name: "transform", Kind: RuleKindTransform, Mode: RuleModeWrite, Type: RuleTypeCEL, Expr: "message"},
+		},
+	}
+
+	t.Run("satisfied", func(t *testing.T) {
+		message := map[string]any{"amount": float64(10), "currency": "EUR"}
+		assert.NoError(t, ruleSet.EvaluateDomainConditions(RuleModeWrite, message))
+	})
+
+	t.Run("violated", func(t *testing.T) {
+		message := map[string]any{"amount": float64(-1)}
+		err := ruleSet.EvaluateDomainConditions(RuleModeWrite, message)
+
+		var violationErr *RuleViolationError
+		require.ErrorAs(t, err, &violationErr)
+		require.Len(t, violationErr.Violations, 2)
+		assert.Equal(t, "positiveAmount", violationErr.Violations[0].RuleName)
+		assert.Equal(t, "hasCurrency", violationErr.Violations[1].RuleName)
+	})
+
+	t.Run("invalid expression", func(t *testing.T) {
+		rs := &RuleSet{DomainRules: []Rule{{Name: "broken", Type: RuleTypeCEL, Expr: "message.amount >"}}}
+		assert.Error(t, rs.EvaluateDomainConditions(RuleModeWrite, map[string]any{}))
+	})
+
+	t.Run("nil rule set", func(t *testing.T) {
+		var rs *RuleSet
+		assert.NoError(t, rs.EvaluateDomainConditions(RuleModeWrite, nil))
+	})
+}
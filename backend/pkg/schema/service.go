@@ -11,6 +11,7 @@ package schema
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"strconv"
@@ -306,6 +307,26 @@ func (s *Service) GetSubjects(ctx context.Context, showSoftDeleted bool) (*Subje
 	return s.registryClient.GetSubjects(ctx, showSoftDeleted)
 }
 
+// GetSubjectsByPrefix returns a list of all deployed schemas whose subject starts with the given prefix.
+func (s *Service) GetSubjectsByPrefix(ctx context.Context, subjectPrefix string, showSoftDeleted bool) (*SubjectsResponse, error) {
+	return s.registryClient.GetSubjectsByPrefix(ctx, subjectPrefix, showSoftDeleted)
+}
+
+// GetContexts returns the names of all schema registry contexts.
+func (s *Service) GetContexts(ctx context.Context) ([]string, error) {
+	return s.registryClient.GetContexts(ctx)
+}
+
+// GetSchemas returns all registered schema versions across all subjects. Unlike the
+// underlying client, an error is returned if any of the schemas could not be retrieved.
+func (s *Service) GetSchemas(ctx context.Context, showSoftDeleted bool) ([]*SchemaVersionedResponse, error) {
+	schemas, errs := s.registryClient.GetSchemas(ctx, showSoftDeleted)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return schemas, nil
+}
+
 // GetSchemaTypes returns supported types (AVRO, PROTOBUF, JSON)
 func (s *Service) GetSchemaTypes(ctx context.Context) ([]string, error) {
 	return s.registryClient.GetSchemaTypes(ctx)
@@ -326,6 +347,26 @@ func (s *Service) GetMode(ctx context.Context) (*ModeResponse, error) {
 	return s.registryClient.GetMode(ctx)
 }
 
+// PutMode sets the mode for Schema Registry at a global level.
+func (s *Service) PutMode(ctx context.Context, mode Mode, force bool) (*ModeResponse, error) {
+	return s.registryClient.PutMode(ctx, mode, force)
+}
+
+// GetSubjectMode returns the mode for the given subject, falling back to the global mode.
+func (s *Service) GetSubjectMode(ctx context.Context, subject string) (*ModeResponse, error) {
+	return s.registryClient.GetSubjectMode(ctx, subject)
+}
+
+// PutSubjectMode sets the mode for the given subject.
+func (s *Service) PutSubjectMode(ctx context.Context, subject string, mode Mode, force bool) (*ModeResponse, error) {
+	return s.registryClient.PutSubjectMode(ctx, subject, mode, force)
+}
+
+// DeleteSubjectMode deletes the subject-specific mode.
+func (s *Service) DeleteSubjectMode(ctx context.Context, subject string) (*ModeResponse, error) {
+	return s.registryClient.DeleteSubjectMode(ctx, subject)
+}
+
 // GetConfig gets global compatibility level.
 func (s *Service) GetConfig(ctx context.Context) (*ConfigResponse, error) {
 	return s.registryClient.GetConfig(ctx)
@@ -464,13 +505,20 @@ func (s *Service) ValidateJSONSchema(ctx context.Context, name string, sch Schem
 // ValidateProtobufSchema validates a given protobuf schema by trying to parse it as a descriptor
 // along with all its references.
 func (s *Service) ValidateProtobufSchema(ctx context.Context, name string, sch Schema) error {
+	_, err := s.compileProtobufSchema(ctx, name, sch)
+	return err
+}
+
+// compileProtobufSchema parses the given protobuf schema along with all its references
+// and returns the file descriptor of the schema.
+func (s *Service) compileProtobufSchema(ctx context.Context, name string, sch Schema) (*desc.FileDescriptor, error) {
 	schemasByPath := make(map[string]string)
 	schemasByPath[name] = sch.Schema
 
 	for _, ref := range sch.References {
 		schemaRefRes, err := s.GetSchemaBySubjectAndVersion(ctx, ref.Subject, strconv.Itoa(ref.Version))
 		if err != nil {
-			return fmt.Errorf("failed to retrieve reference %q: %w", ref.Subject, err)
+			return nil, fmt.Errorf("failed to retrieve reference %q: %w", ref.Subject, err)
 		}
 		schemasByPath[ref.Name] = schemaRefRes.Schema
 	}
@@ -481,7 +529,7 @@ func (s *Service) ValidateProtobufSchema(ctx context.Context, name string, sch S
 	// These are added in the embed package, and here we add them to the map for parsing.
 	commonProtoMap, err := embed.CommonProtoFileMap()
 	if err != nil {
-		return fmt.Errorf("failed to load common protobuf types: %w", err)
+		return nil, fmt.Errorf("failed to load common protobuf types: %w", err)
 	}
 
 	for commonPath, commonSchema := range commonProtoMap {
@@ -497,9 +545,12 @@ func (s *Service) ValidateProtobufSchema(ctx context.Context, name string, sch S
 		IncludeSourceCodeInfo: true,
 	}
 
-	_, err = parser.ParseFiles(name)
+	descriptors, err := parser.ParseFiles(name)
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return descriptors[0], nil
 }
 
 // GetSchemaBySubjectAndVersion retrieves a schema from the schema registry
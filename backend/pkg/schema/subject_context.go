@@ -0,0 +1,62 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"strings"
+)
+
+// DefaultContext is the name of the schema registry context that is used for
+// all subjects which are not qualified with a context.
+const DefaultContext = "."
+
+// NormalizeContextName returns the context name with a leading dot, which is
+// how the schema registry presents context names. An empty name is treated as
+// the default context.
+func NormalizeContextName(schemaContext string) string {
+	if schemaContext == "" || schemaContext == DefaultContext {
+		return DefaultContext
+	}
+	if !strings.HasPrefix(schemaContext, ".") {
+		return "." + schemaContext
+	}
+	return schemaContext
+}
+
+// ContextSubjectPrefix returns the subject prefix (e.g. ":.staging:") that all
+// subjects within the given context start with. Subjects of the default context
+// are not qualified, hence an empty string is returned for the default context.
+func ContextSubjectPrefix(schemaContext string) string {
+	schemaContext = NormalizeContextName(schemaContext)
+	if schemaContext == DefaultContext {
+		return ""
+	}
+	return ":" + schemaContext + ":"
+}
+
+// QualifySubject returns the context-qualified subject name, e.g. ":.staging:orders-value".
+func QualifySubject(schemaContext, subject string) string {
+	return ContextSubjectPrefix(schemaContext) + subject
+}
+
+// ParseQualifiedSubject splits a context-qualified subject name such as
+// ":.staging:orders-value" into its context (".staging") and the unqualified
+// subject name ("orders-value"). Subjects without a context qualifier belong
+// to the default context.
+func ParseQualifiedSubject(subject string) (schemaContext string, name string) {
+	if !strings.HasPrefix(subject, ":.") {
+		return DefaultContext, subject
+	}
+	end := strings.Index(subject[1:], ":")
+	if end < 0 {
+		return DefaultContext, subject
+	}
+	return NormalizeContextName(subject[1 : end+1]), subject[end+2:]
+}
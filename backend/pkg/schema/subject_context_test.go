@@ -0,0 +1,43 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQualifiedSubject(t *testing.T) {
+	tests := []struct {
+		subject         string
+		expectedContext string
+		expectedName    string
+	}{
+		{subject: "orders-value", expectedContext: ".", expectedName: "orders-value"},
+		{subject: ":.staging:orders-value", expectedContext: ".staging", expectedName: "orders-value"},
+		{subject: ":.:orders-value", expectedContext: ".", expectedName: "orders-value"},
+		{subject: ":.staging:ns:orders", expectedContext: ".staging", expectedName: "ns:orders"},
+		{subject: ":orders", expectedContext: ".", expectedName: ":orders"},
+	}
+
+	for _, tt := range tests {
+		schemaContext, name := ParseQualifiedSubject(tt.subject)
+		assert.Equal(t, tt.expectedContext, schemaContext, tt.subject)
+		assert.Equal(t, tt.expectedName, name, tt.subject)
+	}
+}
+
+func TestQualifySubject(t *testing.T) {
+	assert.Equal(t, "orders-value", QualifySubject("", "orders-value"))
+	assert.Equal(t, "orders-value", QualifySubject(DefaultContext, "orders-value"))
+	assert.Equal(t, ":.staging:orders-value", QualifySubject("staging", "orders-value"))
+	assert.Equal(t, ":.staging:orders-value", QualifySubject(".staging", "orders-value"))
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redpanda-data/console/backend/pkg/schema"
+)
+
+// enforceDomainRules evaluates the CEL domain rules of the schema that has been
+// used to serialize the given payload. This ensures that records published via
+// Console cannot violate the data contract of the schema.
+func (s *Service) enforceDomainRules(ctx context.Context, input RecordPayloadInput) error {
+	if s.schemaSvc == nil {
+		return nil
+	}
+
+	switch input.Encoding {
+	case PayloadEncodingAvro, PayloadEncodingJSONSchema, PayloadEncodingProtobufSchema:
+	default:
+		return nil
+	}
+
+	so := serdeCfg{}
+	for _, o := range input.Options {
+		o.apply(&so)
+	}
+	if so.schemaID == 0 {
+		return nil
+	}
+
+	schemaRes, err := s.schemaSvc.GetSchemaByID(ctx, so.schemaID)
+	if err != nil {
+		return fmt.Errorf("failed to get rule set of schema %d: %w", so.schemaID, err)
+	}
+	if schemaRes.RuleSet == nil || len(schemaRes.RuleSet.DomainRules) == 0 {
+		return nil
+	}
+
+	message, err := messageFromPayload(input.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate data contract rules: %w", err)
+	}
+
+	return schemaRes.RuleSet.EvaluateDomainConditions(schema.RuleModeWrite, message)
+}
+
+// messageFromPayload converts the publish payload into its generic JSON
+// representation, which is what CEL expressions are evaluated against.
+func messageFromPayload(payload any) (any, error) {
+	var b []byte
+	switch v := payload.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode payload: %w", err)
+		}
+		b = encoded
+	}
+
+	var message any
+	if err := json.Unmarshal(b, &message); err != nil {
+		return nil, fmt.Errorf("payload is not valid JSON: %w", err)
+	}
+	return message, nil
+}
@@ -25,6 +25,10 @@ import (
 // a record.
 type Service struct {
 	SerDes []Serde
+
+	// schemaSvc is used to look up the data contract rules of the schema that
+	// is used for serializing records. It is nil if the schema registry is disabled.
+	schemaSvc *schema.Service
 }
 
 // NewService creates the new serde service.
@@ -45,6 +49,7 @@ func NewService(schemaService *schema.Service, protoSvc *proto.Service, msgPackS
 			UintSerde{},
 			BinarySerde{},
 		},
+		schemaSvc: schemaService,
 	}
 }
 
@@ -196,6 +201,9 @@ func (s *Service) SerializeRecord(ctx context.Context, input SerializeInput) (*S
 
 		found = true
 		bytes, err = serde.SerializeObject(ctx, input.Key.Payload, PayloadTypeKey, input.Key.Options...)
+		if err == nil {
+			err = s.enforceDomainRules(ctx, input.Key)
+		}
 		if err != nil {
 			keyTS = append(keyTS, TroubleshootingReport{
 				SerdeName: string(serde.Name()),
@@ -234,6 +242,9 @@ func (s *Service) SerializeRecord(ctx context.Context, input SerializeInput) (*S
 
 		found = true
 		bytes, err = serde.SerializeObject(ctx, input.Value.Payload, PayloadTypeValue, input.Value.Options...)
+		if err == nil {
+			err = s.enforceDomainRules(ctx, input.Value)
+		}
 		if err != nil {
 			valueTS = append(valueTS, TroubleshootingReport{
 				SerdeName: string(serde.Name()),
@@ -0,0 +1,460 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// MockConnector is a connector that is served by MockKafkaConnect.
+type MockConnector struct {
+	Name string
+	// Type is either "source" or "sink".
+	Type    string
+	State   string
+	Config  map[string]string
+	Offsets []MockConnectorOffset
+	// Restarts is the number of times the connector has been restarted.
+	Restarts int
+}
+
+// MockConnectorOffset is a single offset of a connector.
+type MockConnectorOffset struct {
+	Partition map[string]any `json:"partition"`
+	Offset    map[string]any `json:"offset"`
+}
+
+// MockKafkaConnect is an in-memory Kafka connect REST API for unit tests. It
+// serves creating, validating and listing connectors along with their config and
+// status, stopping, resuming and restarting connectors, the offsets and the
+// logger endpoints.
+type MockKafkaConnect struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	connectors map[string]*MockConnector
+	// pluginConfigTypes holds the config types by plugin class name and config key
+	// that are returned when validating connector configs.
+	pluginConfigTypes map[string]map[string]string
+	// pluginVersions holds the installed plugin versions by plugin class name.
+	pluginVersions map[string]string
+	// pluginRequiredConfigs holds the config keys by plugin class name that are
+	// reported as missing when validating a config without them.
+	pluginRequiredConfigs map[string][]string
+	// loggers holds the logger levels by logger name.
+	loggers map[string]string
+}
+
+type mockConnectError struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// NewMockKafkaConnect starts a new mock Kafka connect server that is closed
+// when the test finishes.
+func NewMockKafkaConnect(t testing.TB) *MockKafkaConnect {
+	t.Helper()
+
+	m := &MockKafkaConnect{
+		connectors:        make(map[string]*MockConnector),
+		pluginConfigTypes: make(map[string]map[string]string),
+		pluginVersions:    make(map[string]string),
+
+		pluginRequiredConfigs: make(map[string][]string),
+		loggers:               map[string]string{"root": "INFO"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /", func(w http.ResponseWriter, _ *http.Request) {
+		writeMockConnectJSON(w, http.StatusOK, map[string]string{"version": "3.7.0", "commit": "mock", "kafka_cluster_id": "mock"})
+	})
+	mux.HandleFunc("GET /connectors", m.handleListConnectors)
+	mux.HandleFunc("POST /connectors", m.handleCreateConnector)
+	mux.HandleFunc("PUT /connectors/{connector}/config", m.handlePutConnectorConfig)
+	mux.HandleFunc("GET /connector-plugins", m.handleListPlugins)
+	mux.HandleFunc("PUT /connector-plugins/{className}/config/validate", m.handleValidateConfig)
+	mux.HandleFunc("GET /connectors/{connector}", m.withConnector(m.handleGetConnector))
+	mux.HandleFunc("GET /connectors/{connector}/config", m.withConnector(func(w http.ResponseWriter, _ *http.Request, c *MockConnector) {
+		writeMockConnectJSON(w, http.StatusOK, c.Config)
+	}))
+	mux.HandleFunc("GET /connectors/{connector}/status", m.withConnector(func(w http.ResponseWriter, _ *http.Request, c *MockConnector) {
+		writeMockConnectJSON(w, http.StatusOK, mockConnectorStatus(c))
+	}))
+	mux.HandleFunc("DELETE /connectors/{connector}", m.withConnector(func(w http.ResponseWriter, _ *http.Request, c *MockConnector) {
+		delete(m.connectors, c.Name)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	mux.HandleFunc("PUT /connectors/{connector}/stop", m.withConnector(m.setState("STOPPED")))
+	mux.HandleFunc("PUT /connectors/{connector}/pause", m.withConnector(m.setState("PAUSED")))
+	mux.HandleFunc("PUT /connectors/{connector}/resume", m.withConnector(m.setState("RUNNING")))
+	mux.HandleFunc("POST /connectors/{connector}/restart", m.withConnector(func(w http.ResponseWriter, _ *http.Request, c *MockConnector) {
+		c.Restarts++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	mux.HandleFunc("GET /connectors/{connector}/offsets", m.withConnector(func(w http.ResponseWriter, _ *http.Request, c *MockConnector) {
+		offsets := c.Offsets
+		if offsets == nil {
+			offsets = make([]MockConnectorOffset, 0)
+		}
+		writeMockConnectJSON(w, http.StatusOK, map[string]any{"offsets": offsets})
+	}))
+	mux.HandleFunc("PATCH /connectors/{connector}/offsets", m.withConnector(m.handleAlterOffsets))
+	mux.HandleFunc("DELETE /connectors/{connector}/offsets", m.withConnector(m.handleResetOffsets))
+	mux.HandleFunc("GET /admin/loggers", m.handleListLoggers)
+	mux.HandleFunc("GET /admin/loggers/{logger}", m.handleGetLogger)
+	mux.HandleFunc("PUT /admin/loggers/{logger}", m.handlePutLogger)
+
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Server.Close)
+
+	return m
+}
+
+// AddConnector adds or replaces a connector.
+func (m *MockKafkaConnect) AddConnector(c MockConnector) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c.Config == nil {
+		c.Config = make(map[string]string)
+	}
+	c.Config["name"] = c.Name
+	m.connectors[c.Name] = &c
+}
+
+// SetPluginConfigTypes sets the types of the configs of a connector plugin, e.g.
+// PASSWORD, that are returned when validating a connector config.
+func (m *MockKafkaConnect) SetPluginConfigTypes(className string, types map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pluginConfigTypes[className] = types
+}
+
+// SetPluginVersion installs a connector plugin with the given version.
+func (m *MockKafkaConnect) SetPluginVersion(className, version string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pluginVersions[className] = version
+}
+
+// SetPluginRequiredConfigs sets the configs of a connector plugin that must be
+// set for a connector config to be valid.
+func (m *MockKafkaConnect) SetPluginRequiredConfigs(className string, keys ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pluginRequiredConfigs[className] = keys
+}
+
+// SetLoggerLevel sets the level of a logger.
+func (m *MockKafkaConnect) SetLoggerLevel(name, level string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.loggers[name] = level
+}
+
+// LoggerLevel returns the level of a logger.
+func (m *MockKafkaConnect) LoggerLevel(name string) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	level, exists := m.loggers[name]
+	return level, exists
+}
+
+// Connector returns a copy of the connector with the given name.
+func (m *MockKafkaConnect) Connector(name string) (MockConnector, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, exists := m.connectors[name]
+	if !exists {
+		return MockConnector{}, false
+	}
+	return *c, true
+}
+
+func writeMockConnectJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeMockConnectError(w http.ResponseWriter, status int, message string) {
+	writeMockConnectJSON(w, status, mockConnectError{ErrorCode: status, Message: message})
+}
+
+func (m *MockKafkaConnect) withConnector(handler func(http.ResponseWriter, *http.Request, *MockConnector)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		name := r.PathValue("connector")
+		c, exists := m.connectors[name]
+		if !exists {
+			writeMockConnectError(w, http.StatusNotFound, fmt.Sprintf("Connector %s not found", name))
+			return
+		}
+		handler(w, r, c)
+	}
+}
+
+func mockConnectorStatus(c *MockConnector) map[string]any {
+	return map[string]any{
+		"name":      c.Name,
+		"type":      c.Type,
+		"connector": map[string]any{"state": c.State, "worker_id": "mock:8083"},
+		"tasks":     []any{},
+	}
+}
+
+func mockConnectorInfo(c *MockConnector) map[string]any {
+	return map[string]any{
+		"name":   c.Name,
+		"type":   c.Type,
+		"config": c.Config,
+		"tasks":  []any{},
+	}
+}
+
+func (m *MockKafkaConnect) handleListConnectors(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, expand := r.URL.Query()["expand"]; !expand {
+		names := make([]string, 0, len(m.connectors))
+		for name := range m.connectors {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		writeMockConnectJSON(w, http.StatusOK, names)
+		return
+	}
+
+	expanded := make(map[string]any, len(m.connectors))
+	for name, c := range m.connectors {
+		expanded[name] = map[string]any{
+			"info":   mockConnectorInfo(c),
+			"status": mockConnectorStatus(c),
+		}
+	}
+	writeMockConnectJSON(w, http.StatusOK, expanded)
+}
+
+func (m *MockKafkaConnect) handleCreateConnector(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var req struct {
+		Name   string            `json:"name"`
+		Config map[string]string `json:"config"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeMockConnectError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, exists := m.connectors[req.Name]; exists {
+		writeMockConnectError(w, http.StatusConflict, fmt.Sprintf("Connector %s already exists", req.Name))
+		return
+	}
+	c := m.newConnector(req.Name, req.Config)
+	writeMockConnectJSON(w, http.StatusCreated, mockConnectorInfo(c))
+}
+
+func (m *MockKafkaConnect) handlePutConnectorConfig(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var config map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+		writeMockConnectError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	name := r.PathValue("connector")
+	c, exists := m.connectors[name]
+	if !exists {
+		c = m.newConnector(name, config)
+		writeMockConnectJSON(w, http.StatusCreated, mockConnectorInfo(c))
+		return
+	}
+	config["name"] = name
+	c.Config = config
+	writeMockConnectJSON(w, http.StatusOK, mockConnectorInfo(c))
+}
+
+// newConnector adds a running source connector. The caller must hold the lock.
+func (m *MockKafkaConnect) newConnector(name string, config map[string]string) *MockConnector {
+	if config == nil {
+		config = make(map[string]string)
+	}
+	config["name"] = name
+	c := &MockConnector{Name: name, Type: "source", State: "RUNNING", Config: config}
+	m.connectors[name] = c
+	return c
+}
+
+func (m *MockKafkaConnect) handleListPlugins(w http.ResponseWriter, _ *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	plugins := make([]map[string]string, 0, len(m.pluginVersions))
+	for className, version := range m.pluginVersions {
+		plugins = append(plugins, map[string]string{"class": className, "type": "source", "version": version})
+	}
+	writeMockConnectJSON(w, http.StatusOK, plugins)
+}
+
+func (m *MockKafkaConnect) handleValidateConfig(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var config map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+		writeMockConnectError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	className := r.PathValue("className")
+	_, hasTypes := m.pluginConfigTypes[className]
+	_, hasVersion := m.pluginVersions[className]
+	if !hasTypes && !hasVersion {
+		writeMockConnectError(w, http.StatusInternalServerError, "Failed to find any class that implements Connector and which name matches "+className)
+		return
+	}
+
+	configs := make([]map[string]any, 0)
+	errorCount := 0
+	for key, configType := range m.pluginConfigTypes[className] {
+		errs := []string{}
+		if slices.Contains(m.pluginRequiredConfigs[className], key) && config[key] == nil {
+			errs = append(errs, "Missing required configuration \""+key+"\" which has no default value.")
+			errorCount++
+		}
+		configs = append(configs, map[string]any{
+			"definition": map[string]any{"name": key, "type": configType, "importance": "HIGH"},
+			"value":      map[string]any{"name": key, "value": config[key], "errors": errs, "visible": true},
+		})
+	}
+	writeMockConnectJSON(w, http.StatusOK, map[string]any{
+		"name":        className,
+		"error_count": errorCount,
+		"groups":      []string{},
+		"configs":     configs,
+	})
+}
+
+func (*MockKafkaConnect) handleGetConnector(w http.ResponseWriter, _ *http.Request, c *MockConnector) {
+	writeMockConnectJSON(w, http.StatusOK, mockConnectorInfo(c))
+}
+
+func (*MockKafkaConnect) setState(state string) func(http.ResponseWriter, *http.Request, *MockConnector) {
+	return func(w http.ResponseWriter, _ *http.Request, c *MockConnector) {
+		c.State = state
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func (*MockKafkaConnect) handleAlterOffsets(w http.ResponseWriter, r *http.Request, c *MockConnector) {
+	if c.State != "STOPPED" {
+		writeMockConnectError(w, http.StatusBadRequest, "Connectors must be in the STOPPED state before their offsets can be modified.")
+		return
+	}
+
+	var req struct {
+		Offsets []MockConnectorOffset `json:"offsets"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeMockConnectError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for _, altered := range req.Offsets {
+		key := mockPartitionKey(altered.Partition)
+		updated := make([]MockConnectorOffset, 0, len(c.Offsets)+1)
+		for _, existing := range c.Offsets {
+			if mockPartitionKey(existing.Partition) != key {
+				updated = append(updated, existing)
+			}
+		}
+		// A null offset resets the offset of the partition.
+		if altered.Offset != nil {
+			updated = append(updated, altered)
+		}
+		c.Offsets = updated
+	}
+
+	writeMockConnectJSON(w, http.StatusOK, map[string]string{"message": "The offsets for this connector have been altered successfully"})
+}
+
+func (*MockKafkaConnect) handleResetOffsets(w http.ResponseWriter, _ *http.Request, c *MockConnector) {
+	if c.State != "STOPPED" {
+		writeMockConnectError(w, http.StatusBadRequest, "Connectors must be in the STOPPED state before their offsets can be modified.")
+		return
+	}
+	c.Offsets = nil
+	writeMockConnectJSON(w, http.StatusOK, map[string]string{"message": "The offsets for this connector have been reset successfully"})
+}
+
+// mockPartitionKey returns a comparable key for a partition. Numbers are decoded
+// as float64, so that partitions are equal regardless of their original type.
+func mockPartitionKey(partition map[string]any) string {
+	encoded, _ := json.Marshal(partition)
+	var normalized map[string]any
+	_ = json.Unmarshal(encoded, &normalized)
+	// json.Marshal sorts map keys, so that the result is deterministic.
+	key, _ := json.Marshal(normalized)
+	return string(key)
+}
+
+func (m *MockKafkaConnect) handleListLoggers(w http.ResponseWriter, _ *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	loggers := make(map[string]any, len(m.loggers))
+	for name, level := range m.loggers {
+		loggers[name] = map[string]any{"level": level, "last_modified": nil}
+	}
+	writeMockConnectJSON(w, http.StatusOK, loggers)
+}
+
+func (m *MockKafkaConnect) handleGetLogger(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	name := r.PathValue("logger")
+	level, exists := m.loggers[name]
+	if !exists {
+		writeMockConnectError(w, http.StatusNotFound, fmt.Sprintf("Logger %s not found.", name))
+		return
+	}
+	writeMockConnectJSON(w, http.StatusOK, map[string]any{"level": level, "last_modified": nil})
+}
+
+func (m *MockKafkaConnect) handlePutLogger(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Level string `json:"level"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Level == "" {
+		writeMockConnectError(w, http.StatusBadRequest, "The request body must contain a level")
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.loggers[r.PathValue("logger")] = req.Level
+	if r.URL.Query().Get("scope") == "cluster" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeMockConnectJSON(w, http.StatusOK, []string{r.PathValue("logger")})
+}
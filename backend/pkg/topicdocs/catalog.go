@@ -0,0 +1,74 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package topicdocs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+//go:generate mockgen -destination=./mocks/catalog.go -package=mocks . CatalogClient
+
+// CatalogClient fetches topic documentation from an external service such as a
+// data catalog.
+type CatalogClient interface {
+	// GetTopicDocumentation returns the markdown for the given topic. If the
+	// catalog has no documentation for the topic, nil is returned.
+	GetTopicDocumentation(ctx context.Context, topicName string) ([]byte, error)
+}
+
+// HTTPCatalogClient is a CatalogClient that requests the documentation of each
+// topic from a URL that contains the topic name.
+type HTTPCatalogClient struct {
+	client      *resty.Client
+	urlTemplate string
+}
+
+// NewHTTPCatalogClient creates a new CatalogClient for the given HTTP source config.
+func NewHTTPCatalogClient(cfg config.TopicDocumentationHTTP) *HTTPCatalogClient {
+	client := resty.New().
+		SetHeader("User-Agent", "Redpanda Console").
+		SetHeader("Accept", "text/markdown, text/plain;q=0.9, */*;q=0.8").
+		SetHeaders(cfg.Headers).
+		SetTimeout(cfg.Timeout)
+	if cfg.BearerToken != "" {
+		client.SetAuthToken(cfg.BearerToken)
+	}
+
+	return &HTTPCatalogClient{
+		client:      client,
+		urlTemplate: cfg.URL,
+	}
+}
+
+// GetTopicDocumentation requests the markdown for the given topic.
+func (c *HTTPCatalogClient) GetTopicDocumentation(ctx context.Context, topicName string) ([]byte, error) {
+	reqURL := strings.ReplaceAll(c.urlTemplate, "{topic}", url.PathEscape(topicName))
+	res, err := c.client.R().SetContext(ctx).Get(reqURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request topic documentation: %w", err)
+	}
+
+	switch {
+	case res.StatusCode() == http.StatusNotFound:
+		return nil, nil
+	case res.IsError():
+		return nil, fmt.Errorf("failed to request topic documentation, status %d: %s", res.StatusCode(), res.String())
+	}
+
+	return res.Body(), nil
+}
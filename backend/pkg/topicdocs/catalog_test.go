@@ -0,0 +1,60 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package topicdocs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestHTTPCatalogClient_GetTopicDocumentation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("X-Tenant") != "acme" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.EscapedPath() {
+		case "/topics/orders%2Fv1/docs":
+			_, _ = w.Write([]byte("# Orders"))
+		case "/topics/broken/docs":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewHTTPCatalogClient(config.TopicDocumentationHTTP{
+		Enabled:     true,
+		URL:         srv.URL + "/topics/{topic}/docs",
+		Headers:     map[string]string{"X-Tenant": "acme"},
+		BearerToken: "secret",
+		Timeout:     5 * time.Second,
+	})
+
+	markdown, err := client.GetTopicDocumentation(context.Background(), "orders/v1")
+	require.NoError(t, err)
+	assert.Equal(t, "# Orders", string(markdown))
+
+	markdown, err = client.GetTopicDocumentation(context.Background(), "unknown")
+	require.NoError(t, err)
+	assert.Nil(t, markdown)
+
+	_, err = client.GetTopicDocumentation(context.Background(), "broken")
+	assert.Error(t, err)
+}
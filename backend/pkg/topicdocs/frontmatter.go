@@ -0,0 +1,88 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package topicdocs contains helpers for loading and parsing topic documentation.
+package topicdocs
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// frontMatterDelimiter opens and closes the YAML front-matter at the beginning of
+// a markdown document.
+var frontMatterDelimiter = []byte("---")
+
+// Metadata is the structured information of a topic that is declared in the YAML
+// front-matter of its documentation.
+type Metadata struct {
+	Owner             string `yaml:"owner" json:"owner,omitempty"`
+	Team              string `yaml:"team" json:"team,omitempty"`
+	SLA               string `yaml:"sla" json:"sla,omitempty"`
+	PIIClassification string `yaml:"piiClassification" json:"piiClassification,omitempty"`
+	RunbookURL        string `yaml:"runbookUrl" json:"runbookUrl,omitempty"`
+
+	// Properties contains all other front-matter keys.
+	Properties map[string]any `yaml:",inline" json:"properties,omitempty"`
+}
+
+// ParseFrontMatter splits the markdown into the metadata declared in its YAML
+// front-matter and the remaining markdown. If the markdown has no front-matter,
+// nil metadata and the unmodified markdown are returned.
+func ParseFrontMatter(markdown []byte) (*Metadata, []byte, error) {
+	content := bytes.TrimPrefix(markdown, []byte("\ufeff"))
+	firstLine, rest, found := bytes.Cut(content, []byte("\n"))
+	if !found || !bytes.Equal(bytes.TrimSpace(firstLine), frontMatterDelimiter) {
+		return nil, markdown, nil
+	}
+
+	// Find the closing delimiter, which must be on its own line
+	var frontMatter []byte
+	remaining := rest
+	for {
+		line, next, hasNext := bytes.Cut(remaining, []byte("\n"))
+		if bytes.Equal(bytes.TrimSpace(line), frontMatterDelimiter) {
+			frontMatter = rest[:len(rest)-len(remaining)]
+			remaining = next
+			break
+		}
+		if !hasNext {
+			return nil, markdown, fmt.Errorf("front-matter is not closed with %q", frontMatterDelimiter)
+		}
+		remaining = next
+	}
+
+	var metadata Metadata
+	if err := yaml.Unmarshal(frontMatter, &metadata); err != nil {
+		return nil, markdown, fmt.Errorf("failed to parse front-matter: %w", err)
+	}
+
+	return &metadata, bytes.TrimLeft(remaining, "\r\n"), nil
+}
+
+// KeepFrontMatter prepends the front-matter of the existing document to the
+// markdown, unless the markdown declares its own front-matter. Documentation is
+// returned without its front-matter, hence saving an edited document must not
+// drop the metadata. The front-matter is kept as is, including comments and the
+// order of its keys.
+func KeepFrontMatter(existing, markdown []byte) []byte {
+	if metadata, _, _ := ParseFrontMatter(markdown); metadata != nil {
+		return markdown
+	}
+	metadata, body, err := ParseFrontMatter(existing)
+	if err != nil || metadata == nil {
+		return markdown
+	}
+
+	// The body is a suffix of the existing document
+	frontMatter := existing[:len(existing)-len(body)]
+	return append(bytes.Clone(frontMatter), markdown...)
+}
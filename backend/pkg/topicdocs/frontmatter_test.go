@@ -0,0 +1,83 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package topicdocs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFrontMatter(t *testing.T) {
+	t.Run("with front-matter", func(t *testing.T) {
+		markdown := []byte(`---
+owner: jane@example.com
+team: payments
+sla: 99.9%
+piiClassification: confidential
+runbookUrl: https://runbooks.example.com/orders
+retention: 7d
+---
+
+# Orders
+`)
+		metadata, body, err := ParseFrontMatter(markdown)
+		require.NoError(t, err)
+		require.NotNil(t, metadata)
+		assert.Equal(t, "jane@example.com", metadata.Owner)
+		assert.Equal(t, "payments", metadata.Team)
+		assert.Equal(t, "99.9%", metadata.SLA)
+		assert.Equal(t, "confidential", metadata.PIIClassification)
+		assert.Equal(t, "https://runbooks.example.com/orders", metadata.RunbookURL)
+		assert.Equal(t, map[string]any{"retention": "7d"}, metadata.Properties)
+		assert.Equal(t, "# Orders\n", string(body))
+	})
+
+	t.Run("without front-matter", func(t *testing.T) {
+		markdown := []byte("# Orders\n---\n")
+		metadata, body, err := ParseFrontMatter(markdown)
+		require.NoError(t, err)
+		assert.Nil(t, metadata)
+		assert.Equal(t, markdown, body)
+	})
+
+	t.Run("unclosed front-matter", func(t *testing.T) {
+		markdown := []byte("---\nowner: jane\n# Orders\n")
+		metadata, body, err := ParseFrontMatter(markdown)
+		assert.Error(t, err)
+		assert.Nil(t, metadata)
+		assert.Equal(t, markdown, body)
+	})
+
+	t.Run("invalid yaml", func(t *testing.T) {
+		markdown := []byte("---\nowner: [jane\n---\n# Orders\n")
+		_, body, err := ParseFrontMatter(markdown)
+		assert.Error(t, err)
+		assert.Equal(t, markdown, body)
+	})
+}
+
+func TestKeepFrontMatter(t *testing.T) {
+	existing := []byte("---\n# maintained by the payments team\nowner: jane@example.com\nteam: payments\n---\n\n# Orders\n")
+
+	// The front-matter of the existing document is kept as is.
+	markdown := KeepFrontMatter(existing, []byte("# Orders\n\nAll orders.\n"))
+	assert.Equal(t, "---\n# maintained by the payments team\nowner: jane@example.com\nteam: payments\n---\n\n# Orders\n\nAll orders.\n", string(markdown))
+	assert.Equal(t, "---\n# maintained by the payments team\nowner: jane@example.com\nteam: payments\n---\n\n# Orders\n", string(existing), "existing document must not be modified")
+
+	// Markdown with its own front-matter replaces the existing one.
+	edited := []byte("---\nowner: john@example.com\n---\n# Orders\n")
+	assert.Equal(t, edited, KeepFrontMatter(existing, edited))
+
+	// Documents without front-matter are not modified.
+	assert.Equal(t, []byte("# Orders\n"), KeepFrontMatter([]byte("# Old\n"), []byte("# Orders\n")))
+	assert.Equal(t, []byte("# Orders\n"), KeepFrontMatter(nil, []byte("# Orders\n")))
+}
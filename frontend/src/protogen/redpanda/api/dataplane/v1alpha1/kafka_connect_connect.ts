@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateConnectorRequest, CreateConnectorResponse, DeleteConnectorRequest, GetConnectClusterRequest, GetConnectClusterResponse, GetConnectorConfigRequest, GetConnectorConfigResponse, GetConnectorRequest, GetConnectorResponse, GetConnectorStatusRequest, GetConnectorStatusResponse, ListConnectClustersRequest, ListConnectClustersResponse, ListConnectorsRequest, ListConnectorsResponse, ListConnectorTopicsRequest, ListConnectorTopicsResponse, PauseConnectorRequest, ResetConnectorTopicsRequest, RestartConnectorRequest, ResumeConnectorRequest, StopConnectorRequest, UpsertConnectorRequest, UpsertConnectorResponse } from "./kafka_connect_pb";
+import { AlterConnectorOffsetsRequest, AlterConnectorOffsetsResponse, CreateConnectorRequest, CreateConnectorResponse, DeleteConnectorRequest, DiffConnectorHistoryRequest, DiffConnectorHistoryResponse, GetConnectClusterRequest, GetConnectClusterResponse, GetConnectGitOpsStatusRequest, GetConnectGitOpsStatusResponse, GetConnectLoggerRequest, GetConnectLoggerResponse, GetConnectorConfigRequest, GetConnectorConfigResponse, GetConnectorRequest, GetConnectorResponse, GetConnectorStatusRequest, GetConnectorStatusResponse, ListConnectClustersRequest, ListConnectClustersResponse, ListConnectLoggersRequest, ListConnectLoggersResponse, ListConnectorHistoryRequest, ListConnectorHistoryResponse, ListConnectorOffsetsRequest, ListConnectorOffsetsResponse, ListConnectorsRequest, ListConnectorsResponse, ListConnectorTopicsRequest, ListConnectorTopicsResponse, PauseConnectorRequest, ResetConnectorOffsetsRequest, ResetConnectorOffsetsResponse, ResetConnectorTopicsRequest, RestartConnectorRequest, ResumeConnectorRequest, RollbackConnectorRequest, RollbackConnectorResponse, SetConnectLoggerLevelRequest, SetConnectLoggerLevelResponse, StopConnectorRequest, UpsertConnectorRequest, UpsertConnectorResponse } from "./kafka_connect_pb";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -200,6 +200,120 @@ export const KafkaConnectService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * ListConnectLoggers lists the loggers of the connect workers and their levels
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectLoggers
+     */
+    listConnectLoggers: {
+      name: "ListConnectLoggers",
+      I: ListConnectLoggersRequest,
+      O: ListConnectLoggersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetConnectLogger gets the level of a single logger of the connect workers
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.GetConnectLogger
+     */
+    getConnectLogger: {
+      name: "GetConnectLogger",
+      I: GetConnectLoggerRequest,
+      O: GetConnectLoggerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetConnectLoggerLevel sets the level of a logger on all workers of the
+     * connect cluster, the level is reverted after the given TTL
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.SetConnectLoggerLevel
+     */
+    setConnectLoggerLevel: {
+      name: "SetConnectLoggerLevel",
+      I: SetConnectLoggerLevelRequest,
+      O: SetConnectLoggerLevelResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListConnectorHistory lists the recorded config changes of a connector
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectorHistory
+     */
+    listConnectorHistory: {
+      name: "ListConnectorHistory",
+      I: ListConnectorHistoryRequest,
+      O: ListConnectorHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DiffConnectorHistory compares two recorded versions of a connector config
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.DiffConnectorHistory
+     */
+    diffConnectorHistory: {
+      name: "DiffConnectorHistory",
+      I: DiffConnectorHistoryRequest,
+      O: DiffConnectorHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RollbackConnector applies the config of a recorded version of a connector
+     * again and restarts the connector and its tasks
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.RollbackConnector
+     */
+    rollbackConnector: {
+      name: "RollbackConnector",
+      I: RollbackConnectorRequest,
+      O: RollbackConnectorResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetConnectGitOpsStatus returns the drift between the connectors declared in
+     * the GitOps repository and the connectors in all connect clusters
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.GetConnectGitOpsStatus
+     */
+    getConnectGitOpsStatus: {
+      name: "GetConnectGitOpsStatus",
+      I: GetConnectGitOpsStatusRequest,
+      O: GetConnectGitOpsStatusResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListConnectorOffsets lists the offsets of a connector, requires Kafka
+     * Connect 3.6 or newer
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectorOffsets
+     */
+    listConnectorOffsets: {
+      name: "ListConnectorOffsets",
+      I: ListConnectorOffsetsRequest,
+      O: ListConnectorOffsetsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AlterConnectorOffsets alters the offsets of a stopped connector
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.AlterConnectorOffsets
+     */
+    alterConnectorOffsets: {
+      name: "AlterConnectorOffsets",
+      I: AlterConnectorOffsetsRequest,
+      O: AlterConnectorOffsetsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ResetConnectorOffsets resets all offsets of a stopped connector
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.ResetConnectorOffsets
+     */
+    resetConnectorOffsets: {
+      name: "ResetConnectorOffsets",
+      I: ResetConnectorOffsetsRequest,
+      O: ResetConnectorOffsetsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
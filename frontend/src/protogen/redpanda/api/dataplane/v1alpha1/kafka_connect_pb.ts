@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Duration, Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
 
 /**
  * State of a connector or one of its tasks, as described in the [Kafka Connect documentation](https://kafka.apache.org/documentation.html#connect_administration). Takes into account not just the state of the connector instance itself, but also the tasks within the connector.
@@ -97,6 +97,207 @@ proto3.util.setEnumType(ConnectorHolisticState, "redpanda.api.dataplane.v1alpha1
   { no: 9, name: "CONNECTOR_HOLISTIC_STATE_UNKNOWN" },
 ]);
 
+/**
+ * Kind of change that has been recorded in the config history of a connector.
+ *
+ * @generated from enum redpanda.api.dataplane.v1alpha1.ConnectorHistoryOperation
+ */
+export enum ConnectorHistoryOperation {
+  /**
+   * @generated from enum value: CONNECTOR_HISTORY_OPERATION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The connector has been created.
+   *
+   * @generated from enum value: CONNECTOR_HISTORY_OPERATION_CREATE = 1;
+   */
+  CREATE = 1,
+
+  /**
+   * The connector config has been overwritten.
+   *
+   * @generated from enum value: CONNECTOR_HISTORY_OPERATION_UPDATE = 2;
+   */
+  UPDATE = 2,
+
+  /**
+   * The connector has been deleted.
+   *
+   * @generated from enum value: CONNECTOR_HISTORY_OPERATION_DELETE = 3;
+   */
+  DELETE = 3,
+
+  /**
+   * The connector config has been rolled back to a previous version.
+   *
+   * @generated from enum value: CONNECTOR_HISTORY_OPERATION_ROLLBACK = 4;
+   */
+  ROLLBACK = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ConnectorHistoryOperation)
+proto3.util.setEnumType(ConnectorHistoryOperation, "redpanda.api.dataplane.v1alpha1.ConnectorHistoryOperation", [
+  { no: 0, name: "CONNECTOR_HISTORY_OPERATION_UNSPECIFIED" },
+  { no: 1, name: "CONNECTOR_HISTORY_OPERATION_CREATE" },
+  { no: 2, name: "CONNECTOR_HISTORY_OPERATION_UPDATE" },
+  { no: 3, name: "CONNECTOR_HISTORY_OPERATION_DELETE" },
+  { no: 4, name: "CONNECTOR_HISTORY_OPERATION_ROLLBACK" },
+]);
+
+/**
+ * How a single config key differs between two versions of a connector config.
+ *
+ * @generated from enum redpanda.api.dataplane.v1alpha1.ConnectorConfigChangeType
+ */
+export enum ConnectorConfigChangeType {
+  /**
+   * @generated from enum value: CONNECTOR_CONFIG_CHANGE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The key only exists in the newer config.
+   *
+   * @generated from enum value: CONNECTOR_CONFIG_CHANGE_TYPE_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * The key only exists in the older config.
+   *
+   * @generated from enum value: CONNECTOR_CONFIG_CHANGE_TYPE_REMOVED = 2;
+   */
+  REMOVED = 2,
+
+  /**
+   * The value of the key differs.
+   *
+   * @generated from enum value: CONNECTOR_CONFIG_CHANGE_TYPE_CHANGED = 3;
+   */
+  CHANGED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ConnectorConfigChangeType)
+proto3.util.setEnumType(ConnectorConfigChangeType, "redpanda.api.dataplane.v1alpha1.ConnectorConfigChangeType", [
+  { no: 0, name: "CONNECTOR_CONFIG_CHANGE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "CONNECTOR_CONFIG_CHANGE_TYPE_ADDED" },
+  { no: 2, name: "CONNECTOR_CONFIG_CHANGE_TYPE_REMOVED" },
+  { no: 3, name: "CONNECTOR_CONFIG_CHANGE_TYPE_CHANGED" },
+]);
+
+/**
+ * How a connector in a Kafka connect cluster relates to the connectors that
+ * are declared in the GitOps repository.
+ *
+ * @generated from enum redpanda.api.dataplane.v1alpha1.ConnectGitOpsConnectorState
+ */
+export enum ConnectGitOpsConnectorState {
+  /**
+   * @generated from enum value: CONNECT_GIT_OPS_CONNECTOR_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The connector is declared and its config matches.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_CONNECTOR_STATE_IN_SYNC = 1;
+   */
+  IN_SYNC = 1,
+
+  /**
+   * The connector is declared, but doesn't exist.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_CONNECTOR_STATE_MISSING = 2;
+   */
+  MISSING = 2,
+
+  /**
+   * The connector is declared, but its config differs.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_CONNECTOR_STATE_DRIFTED = 3;
+   */
+  DRIFTED = 3,
+
+  /**
+   * The connector is declared and exists, but is owned by someone else.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_CONNECTOR_STATE_CONFLICT = 4;
+   */
+  CONFLICT = 4,
+
+  /**
+   * The connector is owned by this Console, but no longer declared.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_CONNECTOR_STATE_ORPHANED = 5;
+   */
+  ORPHANED = 5,
+
+  /**
+   * The connector is neither declared nor owned by this Console.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_CONNECTOR_STATE_UNMANAGED = 6;
+   */
+  UNMANAGED = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ConnectGitOpsConnectorState)
+proto3.util.setEnumType(ConnectGitOpsConnectorState, "redpanda.api.dataplane.v1alpha1.ConnectGitOpsConnectorState", [
+  { no: 0, name: "CONNECT_GIT_OPS_CONNECTOR_STATE_UNSPECIFIED" },
+  { no: 1, name: "CONNECT_GIT_OPS_CONNECTOR_STATE_IN_SYNC" },
+  { no: 2, name: "CONNECT_GIT_OPS_CONNECTOR_STATE_MISSING" },
+  { no: 3, name: "CONNECT_GIT_OPS_CONNECTOR_STATE_DRIFTED" },
+  { no: 4, name: "CONNECT_GIT_OPS_CONNECTOR_STATE_CONFLICT" },
+  { no: 5, name: "CONNECT_GIT_OPS_CONNECTOR_STATE_ORPHANED" },
+  { no: 6, name: "CONNECT_GIT_OPS_CONNECTOR_STATE_UNMANAGED" },
+]);
+
+/**
+ * Change that is applied to a connector to reconcile it.
+ *
+ * @generated from enum redpanda.api.dataplane.v1alpha1.ConnectGitOpsAction
+ */
+export enum ConnectGitOpsAction {
+  /**
+   * @generated from enum value: CONNECT_GIT_OPS_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The connector is left untouched.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_ACTION_NONE = 1;
+   */
+  NONE = 1,
+
+  /**
+   * The connector is created.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_ACTION_CREATE = 2;
+   */
+  CREATE = 2,
+
+  /**
+   * The connector config is overwritten.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_ACTION_UPDATE = 3;
+   */
+  UPDATE = 3,
+
+  /**
+   * The connector is deleted.
+   *
+   * @generated from enum value: CONNECT_GIT_OPS_ACTION_DELETE = 4;
+   */
+  DELETE = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ConnectGitOpsAction)
+proto3.util.setEnumType(ConnectGitOpsAction, "redpanda.api.dataplane.v1alpha1.ConnectGitOpsAction", [
+  { no: 0, name: "CONNECT_GIT_OPS_ACTION_UNSPECIFIED" },
+  { no: 1, name: "CONNECT_GIT_OPS_ACTION_NONE" },
+  { no: 2, name: "CONNECT_GIT_OPS_ACTION_CREATE" },
+  { no: 3, name: "CONNECT_GIT_OPS_ACTION_UPDATE" },
+  { no: 4, name: "CONNECT_GIT_OPS_ACTION_DELETE" },
+]);
+
 /**
  * @generated from message redpanda.api.dataplane.v1alpha1.ConnectorPlugin
  */
@@ -1760,3 +1961,1492 @@ export class ResetConnectorTopicsRequest extends Message<ResetConnectorTopicsReq
   }
 }
 
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectLogger
+ */
+export class ConnectLogger extends Message<ConnectLogger> {
+  /**
+   * Name of the logger, usually a class or package name. The root logger is
+   * named `root`.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Level of the logger, one of OFF, FATAL, ERROR, WARN, INFO, DEBUG or TRACE.
+   *
+   * @generated from field: string level = 2;
+   */
+  level = "";
+
+  /**
+   * Time the level has last been changed. Not set if the level has not been
+   * changed since the worker started.
+   *
+   * @generated from field: google.protobuf.Timestamp last_modified = 3;
+   */
+  lastModified?: Timestamp;
+
+  /**
+   * Time the level is reverted to revert_level. Only set if the level has been
+   * changed through SetConnectLoggerLevel and the revert is pending.
+   *
+   * @generated from field: google.protobuf.Timestamp revert_time = 4;
+   */
+  revertTime?: Timestamp;
+
+  /**
+   * Level the logger is reverted to at revert_time.
+   *
+   * @generated from field: string revert_level = 5;
+   */
+  revertLevel = "";
+
+  constructor(data?: PartialMessage<ConnectLogger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectLogger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "level", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "last_modified", kind: "message", T: Timestamp },
+    { no: 4, name: "revert_time", kind: "message", T: Timestamp },
+    { no: 5, name: "revert_level", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectLogger {
+    return new ConnectLogger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectLogger {
+    return new ConnectLogger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectLogger {
+    return new ConnectLogger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectLogger | PlainMessage<ConnectLogger> | undefined, b: ConnectLogger | PlainMessage<ConnectLogger> | undefined): boolean {
+    return proto3.util.equals(ConnectLogger, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectLoggersRequest
+ */
+export class ListConnectLoggersRequest extends Message<ListConnectLoggersRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  constructor(data?: PartialMessage<ListConnectLoggersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectLoggersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectLoggersRequest {
+    return new ListConnectLoggersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectLoggersRequest {
+    return new ListConnectLoggersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectLoggersRequest {
+    return new ListConnectLoggersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectLoggersRequest | PlainMessage<ListConnectLoggersRequest> | undefined, b: ListConnectLoggersRequest | PlainMessage<ListConnectLoggersRequest> | undefined): boolean {
+    return proto3.util.equals(ListConnectLoggersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectLoggersResponse
+ */
+export class ListConnectLoggersResponse extends Message<ListConnectLoggersResponse> {
+  /**
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectLogger loggers = 1;
+   */
+  loggers: ConnectLogger[] = [];
+
+  constructor(data?: PartialMessage<ListConnectLoggersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectLoggersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "loggers", kind: "message", T: ConnectLogger, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectLoggersResponse {
+    return new ListConnectLoggersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectLoggersResponse {
+    return new ListConnectLoggersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectLoggersResponse {
+    return new ListConnectLoggersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectLoggersResponse | PlainMessage<ListConnectLoggersResponse> | undefined, b: ListConnectLoggersResponse | PlainMessage<ListConnectLoggersResponse> | undefined): boolean {
+    return proto3.util.equals(ListConnectLoggersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.GetConnectLoggerRequest
+ */
+export class GetConnectLoggerRequest extends Message<GetConnectLoggerRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of the logger, usually a class or package name. The root logger is
+   * named `root`.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<GetConnectLoggerRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.GetConnectLoggerRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectLoggerRequest {
+    return new GetConnectLoggerRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectLoggerRequest {
+    return new GetConnectLoggerRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectLoggerRequest {
+    return new GetConnectLoggerRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectLoggerRequest | PlainMessage<GetConnectLoggerRequest> | undefined, b: GetConnectLoggerRequest | PlainMessage<GetConnectLoggerRequest> | undefined): boolean {
+    return proto3.util.equals(GetConnectLoggerRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.GetConnectLoggerResponse
+ */
+export class GetConnectLoggerResponse extends Message<GetConnectLoggerResponse> {
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectLogger logger = 1;
+   */
+  logger?: ConnectLogger;
+
+  constructor(data?: PartialMessage<GetConnectLoggerResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.GetConnectLoggerResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "logger", kind: "message", T: ConnectLogger },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectLoggerResponse {
+    return new GetConnectLoggerResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectLoggerResponse {
+    return new GetConnectLoggerResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectLoggerResponse {
+    return new GetConnectLoggerResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectLoggerResponse | PlainMessage<GetConnectLoggerResponse> | undefined, b: GetConnectLoggerResponse | PlainMessage<GetConnectLoggerResponse> | undefined): boolean {
+    return proto3.util.equals(GetConnectLoggerResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.SetConnectLoggerLevelRequest
+ */
+export class SetConnectLoggerLevelRequest extends Message<SetConnectLoggerLevelRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of the logger, usually a class or package name. The root logger is
+   * named `root`.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Level to set on all workers of the connect cluster.
+   *
+   * @generated from field: string level = 3;
+   */
+  level = "";
+
+  /**
+   * Time after which the level is reverted to the level before the change.
+   * Defaults to 30 minutes, at most 24 hours.
+   *
+   * @generated from field: google.protobuf.Duration ttl = 4;
+   */
+  ttl?: Duration;
+
+  constructor(data?: PartialMessage<SetConnectLoggerLevelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.SetConnectLoggerLevelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "level", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "ttl", kind: "message", T: Duration },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConnectLoggerLevelRequest {
+    return new SetConnectLoggerLevelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConnectLoggerLevelRequest {
+    return new SetConnectLoggerLevelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConnectLoggerLevelRequest {
+    return new SetConnectLoggerLevelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetConnectLoggerLevelRequest | PlainMessage<SetConnectLoggerLevelRequest> | undefined, b: SetConnectLoggerLevelRequest | PlainMessage<SetConnectLoggerLevelRequest> | undefined): boolean {
+    return proto3.util.equals(SetConnectLoggerLevelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.SetConnectLoggerLevelResponse
+ */
+export class SetConnectLoggerLevelResponse extends Message<SetConnectLoggerLevelResponse> {
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectLogger logger = 1;
+   */
+  logger?: ConnectLogger;
+
+  constructor(data?: PartialMessage<SetConnectLoggerLevelResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.SetConnectLoggerLevelResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "logger", kind: "message", T: ConnectLogger },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConnectLoggerLevelResponse {
+    return new SetConnectLoggerLevelResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConnectLoggerLevelResponse {
+    return new SetConnectLoggerLevelResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConnectLoggerLevelResponse {
+    return new SetConnectLoggerLevelResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetConnectLoggerLevelResponse | PlainMessage<SetConnectLoggerLevelResponse> | undefined, b: SetConnectLoggerLevelResponse | PlainMessage<SetConnectLoggerLevelResponse> | undefined): boolean {
+    return proto3.util.equals(SetConnectLoggerLevelResponse, a, b);
+  }
+}
+
+/**
+ * A single recorded change of a connector config. Values of sensitive config
+ * keys are redacted.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectorHistoryEntry
+ */
+export class ConnectorHistoryEntry extends Message<ConnectorHistoryEntry> {
+  /**
+   * Version of the connector config, starting at 1.
+   *
+   * @generated from field: int32 version = 1;
+   */
+  version = 0;
+
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectorHistoryOperation operation = 2;
+   */
+  operation = ConnectorHistoryOperation.UNSPECIFIED;
+
+  /**
+   * Requester who made the change.
+   *
+   * @generated from field: string actor = 3;
+   */
+  actor = "";
+
+  /**
+   * Time the change has been made.
+   *
+   * @generated from field: google.protobuf.Timestamp time = 4;
+   */
+  time?: Timestamp;
+
+  /**
+   * Connector config before the change. Empty for created connectors.
+   *
+   * @generated from field: map<string, string> config_before = 5;
+   */
+  configBefore: { [key: string]: string } = {};
+
+  /**
+   * Connector config after the change. Empty for deleted connectors.
+   *
+   * @generated from field: map<string, string> config_after = 6;
+   */
+  configAfter: { [key: string]: string } = {};
+
+  /**
+   * Version the connector config has been rolled back to. Only set for
+   * rollbacks.
+   *
+   * @generated from field: int32 rolled_back_to_version = 7;
+   */
+  rolledBackToVersion = 0;
+
+  constructor(data?: PartialMessage<ConnectorHistoryEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectorHistoryEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "operation", kind: "enum", T: proto3.getEnumType(ConnectorHistoryOperation) },
+    { no: 3, name: "actor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "time", kind: "message", T: Timestamp },
+    { no: 5, name: "config_before", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "config_after", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 7, name: "rolled_back_to_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectorHistoryEntry {
+    return new ConnectorHistoryEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectorHistoryEntry {
+    return new ConnectorHistoryEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectorHistoryEntry {
+    return new ConnectorHistoryEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectorHistoryEntry | PlainMessage<ConnectorHistoryEntry> | undefined, b: ConnectorHistoryEntry | PlainMessage<ConnectorHistoryEntry> | undefined): boolean {
+    return proto3.util.equals(ConnectorHistoryEntry, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectorHistoryRequest
+ */
+export class ListConnectorHistoryRequest extends Message<ListConnectorHistoryRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<ListConnectorHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectorHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectorHistoryRequest {
+    return new ListConnectorHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectorHistoryRequest {
+    return new ListConnectorHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectorHistoryRequest {
+    return new ListConnectorHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectorHistoryRequest | PlainMessage<ListConnectorHistoryRequest> | undefined, b: ListConnectorHistoryRequest | PlainMessage<ListConnectorHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(ListConnectorHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectorHistoryResponse
+ */
+export class ListConnectorHistoryResponse extends Message<ListConnectorHistoryResponse> {
+  /**
+   * Recorded changes ordered by version.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectorHistoryEntry entries = 1;
+   */
+  entries: ConnectorHistoryEntry[] = [];
+
+  constructor(data?: PartialMessage<ListConnectorHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectorHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: ConnectorHistoryEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectorHistoryResponse {
+    return new ListConnectorHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectorHistoryResponse {
+    return new ListConnectorHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectorHistoryResponse {
+    return new ListConnectorHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectorHistoryResponse | PlainMessage<ListConnectorHistoryResponse> | undefined, b: ListConnectorHistoryResponse | PlainMessage<ListConnectorHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(ListConnectorHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.DiffConnectorHistoryRequest
+ */
+export class DiffConnectorHistoryRequest extends Message<DiffConnectorHistoryRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Version to compare from. If not set, the diff shows the change that has
+   * been made by to_version.
+   *
+   * @generated from field: int32 from_version = 3;
+   */
+  fromVersion = 0;
+
+  /**
+   * Version to compare to.
+   *
+   * @generated from field: int32 to_version = 4;
+   */
+  toVersion = 0;
+
+  constructor(data?: PartialMessage<DiffConnectorHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.DiffConnectorHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "from_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "to_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffConnectorHistoryRequest {
+    return new DiffConnectorHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffConnectorHistoryRequest {
+    return new DiffConnectorHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffConnectorHistoryRequest {
+    return new DiffConnectorHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffConnectorHistoryRequest | PlainMessage<DiffConnectorHistoryRequest> | undefined, b: DiffConnectorHistoryRequest | PlainMessage<DiffConnectorHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(DiffConnectorHistoryRequest, a, b);
+  }
+}
+
+/**
+ * The difference of a single config key between two versions.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectorConfigChange
+ */
+export class ConnectorConfigChange extends Message<ConnectorConfigChange> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectorConfigChangeType type = 2;
+   */
+  type = ConnectorConfigChangeType.UNSPECIFIED;
+
+  /**
+   * Value in the older config. Empty for added keys.
+   *
+   * @generated from field: string old_value = 3;
+   */
+  oldValue = "";
+
+  /**
+   * Value in the newer config. Empty for removed keys.
+   *
+   * @generated from field: string new_value = 4;
+   */
+  newValue = "";
+
+  constructor(data?: PartialMessage<ConnectorConfigChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectorConfigChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(ConnectorConfigChangeType) },
+    { no: 3, name: "old_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "new_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectorConfigChange {
+    return new ConnectorConfigChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectorConfigChange {
+    return new ConnectorConfigChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectorConfigChange {
+    return new ConnectorConfigChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectorConfigChange | PlainMessage<ConnectorConfigChange> | undefined, b: ConnectorConfigChange | PlainMessage<ConnectorConfigChange> | undefined): boolean {
+    return proto3.util.equals(ConnectorConfigChange, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.DiffConnectorHistoryResponse
+ */
+export class DiffConnectorHistoryResponse extends Message<DiffConnectorHistoryResponse> {
+  /**
+   * @generated from field: int32 from_version = 1;
+   */
+  fromVersion = 0;
+
+  /**
+   * @generated from field: int32 to_version = 2;
+   */
+  toVersion = 0;
+
+  /**
+   * Changes sorted by key.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectorConfigChange changes = 3;
+   */
+  changes: ConnectorConfigChange[] = [];
+
+  constructor(data?: PartialMessage<DiffConnectorHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.DiffConnectorHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "to_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "changes", kind: "message", T: ConnectorConfigChange, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffConnectorHistoryResponse {
+    return new DiffConnectorHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffConnectorHistoryResponse {
+    return new DiffConnectorHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffConnectorHistoryResponse {
+    return new DiffConnectorHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffConnectorHistoryResponse | PlainMessage<DiffConnectorHistoryResponse> | undefined, b: DiffConnectorHistoryResponse | PlainMessage<DiffConnectorHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(DiffConnectorHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.RollbackConnectorRequest
+ */
+export class RollbackConnectorRequest extends Message<RollbackConnectorRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Version to roll back to.
+   *
+   * @generated from field: int32 version = 3;
+   */
+  version = 0;
+
+  /**
+   * Values of config keys that have been redacted in the history. Redacted
+   * values that are not given are taken from the current connector config.
+   *
+   * @generated from field: map<string, string> secret_values = 4;
+   */
+  secretValues: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<RollbackConnectorRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.RollbackConnectorRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "secret_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackConnectorRequest {
+    return new RollbackConnectorRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackConnectorRequest {
+    return new RollbackConnectorRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackConnectorRequest {
+    return new RollbackConnectorRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackConnectorRequest | PlainMessage<RollbackConnectorRequest> | undefined, b: RollbackConnectorRequest | PlainMessage<RollbackConnectorRequest> | undefined): boolean {
+    return proto3.util.equals(RollbackConnectorRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.RollbackConnectorResponse
+ */
+export class RollbackConnectorResponse extends Message<RollbackConnectorResponse> {
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectorSpec connector = 1;
+   */
+  connector?: ConnectorSpec;
+
+  constructor(data?: PartialMessage<RollbackConnectorResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.RollbackConnectorResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connector", kind: "message", T: ConnectorSpec },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackConnectorResponse {
+    return new RollbackConnectorResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackConnectorResponse {
+    return new RollbackConnectorResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackConnectorResponse {
+    return new RollbackConnectorResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackConnectorResponse | PlainMessage<RollbackConnectorResponse> | undefined, b: RollbackConnectorResponse | PlainMessage<RollbackConnectorResponse> | undefined): boolean {
+    return proto3.util.equals(RollbackConnectorResponse, a, b);
+  }
+}
+
+/**
+ * State of a single connector and the action that is required to reconcile it.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectGitOpsPlanItem
+ */
+export class ConnectGitOpsPlanItem extends Message<ConnectGitOpsPlanItem> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * @generated from field: string connector_name = 2;
+   */
+  connectorName = "";
+
+  /**
+   * Owner of the connector, as stored in its ownership label.
+   *
+   * @generated from field: string owner = 3;
+   */
+  owner = "";
+
+  /**
+   * Path of the definition file that declares the connector.
+   *
+   * @generated from field: string source = 4;
+   */
+  source = "";
+
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectGitOpsConnectorState state = 5;
+   */
+  state = ConnectGitOpsConnectorState.UNSPECIFIED;
+
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectGitOpsAction action = 6;
+   */
+  action = ConnectGitOpsAction.UNSPECIFIED;
+
+  /**
+   * Config changes that are applied. Sensitive values are redacted.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectorConfigChange changes = 7;
+   */
+  changes: ConnectorConfigChange[] = [];
+
+  /**
+   * Error that occurred while applying the action.
+   *
+   * @generated from field: string error = 8;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ConnectGitOpsPlanItem>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectGitOpsPlanItem";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "connector_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "state", kind: "enum", T: proto3.getEnumType(ConnectGitOpsConnectorState) },
+    { no: 6, name: "action", kind: "enum", T: proto3.getEnumType(ConnectGitOpsAction) },
+    { no: 7, name: "changes", kind: "message", T: ConnectorConfigChange, repeated: true },
+    { no: 8, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectGitOpsPlanItem {
+    return new ConnectGitOpsPlanItem().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectGitOpsPlanItem {
+    return new ConnectGitOpsPlanItem().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectGitOpsPlanItem {
+    return new ConnectGitOpsPlanItem().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectGitOpsPlanItem | PlainMessage<ConnectGitOpsPlanItem> | undefined, b: ConnectGitOpsPlanItem | PlainMessage<ConnectGitOpsPlanItem> | undefined): boolean {
+    return proto3.util.equals(ConnectGitOpsPlanItem, a, b);
+  }
+}
+
+/**
+ * Result of comparing the declared connectors with the connectors in all
+ * Kafka connect clusters.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectGitOpsPlan
+ */
+export class ConnectGitOpsPlan extends Message<ConnectGitOpsPlan> {
+  /**
+   * Whether the actions have been planned without applying them.
+   *
+   * @generated from field: bool dry_run = 1;
+   */
+  dryRun = false;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 2;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * Connectors of clusters that the requester is allowed to view.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectGitOpsPlanItem items = 3;
+   */
+  items: ConnectGitOpsPlanItem[] = [];
+
+  /**
+   * Problems that prevented parts of the plan from being computed, e.g.
+   * invalid definition files or unreachable clusters.
+   *
+   * @generated from field: repeated string errors = 4;
+   */
+  errors: string[] = [];
+
+  constructor(data?: PartialMessage<ConnectGitOpsPlan>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectGitOpsPlan";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "create_time", kind: "message", T: Timestamp },
+    { no: 3, name: "items", kind: "message", T: ConnectGitOpsPlanItem, repeated: true },
+    { no: 4, name: "errors", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectGitOpsPlan {
+    return new ConnectGitOpsPlan().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectGitOpsPlan {
+    return new ConnectGitOpsPlan().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectGitOpsPlan {
+    return new ConnectGitOpsPlan().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectGitOpsPlan | PlainMessage<ConnectGitOpsPlan> | undefined, b: ConnectGitOpsPlan | PlainMessage<ConnectGitOpsPlan> | undefined): boolean {
+    return proto3.util.equals(ConnectGitOpsPlan, a, b);
+  }
+}
+
+/**
+ * Sync state of the GitOps repository.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectGitOpsRepository
+ */
+export class ConnectGitOpsRepository extends Message<ConnectGitOpsRepository> {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * Branch or tag that is synced.
+   *
+   * @generated from field: string reference = 2;
+   */
+  reference = "";
+
+  /**
+   * @generated from field: string base_directory = 3;
+   */
+  baseDirectory = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_sync_time = 4;
+   */
+  lastSyncTime?: Timestamp;
+
+  /**
+   * Error of the last sync attempt.
+   *
+   * @generated from field: string last_error = 5;
+   */
+  lastError = "";
+
+  /**
+   * Number of synced definition files.
+   *
+   * @generated from field: int32 files = 6;
+   */
+  files = 0;
+
+  constructor(data?: PartialMessage<ConnectGitOpsRepository>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectGitOpsRepository";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "base_directory", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "last_sync_time", kind: "message", T: Timestamp },
+    { no: 5, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "files", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectGitOpsRepository {
+    return new ConnectGitOpsRepository().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectGitOpsRepository {
+    return new ConnectGitOpsRepository().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectGitOpsRepository {
+    return new ConnectGitOpsRepository().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectGitOpsRepository | PlainMessage<ConnectGitOpsRepository> | undefined, b: ConnectGitOpsRepository | PlainMessage<ConnectGitOpsRepository> | undefined): boolean {
+    return proto3.util.equals(ConnectGitOpsRepository, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.GetConnectGitOpsStatusRequest
+ */
+export class GetConnectGitOpsStatusRequest extends Message<GetConnectGitOpsStatusRequest> {
+  constructor(data?: PartialMessage<GetConnectGitOpsStatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.GetConnectGitOpsStatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectGitOpsStatusRequest {
+    return new GetConnectGitOpsStatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectGitOpsStatusRequest {
+    return new GetConnectGitOpsStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectGitOpsStatusRequest {
+    return new GetConnectGitOpsStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectGitOpsStatusRequest | PlainMessage<GetConnectGitOpsStatusRequest> | undefined, b: GetConnectGitOpsStatusRequest | PlainMessage<GetConnectGitOpsStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetConnectGitOpsStatusRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.GetConnectGitOpsStatusResponse
+ */
+export class GetConnectGitOpsStatusResponse extends Message<GetConnectGitOpsStatusResponse> {
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectGitOpsRepository repository = 1;
+   */
+  repository?: ConnectGitOpsRepository;
+
+  /**
+   * Current drift between the declared and the actual connectors.
+   *
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectGitOpsPlan drift = 2;
+   */
+  drift?: ConnectGitOpsPlan;
+
+  /**
+   * Result of the most recent reconciliation. Not set if no reconciliation
+   * has run yet.
+   *
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectGitOpsPlan last_reconciliation = 3;
+   */
+  lastReconciliation?: ConnectGitOpsPlan;
+
+  constructor(data?: PartialMessage<GetConnectGitOpsStatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.GetConnectGitOpsStatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repository", kind: "message", T: ConnectGitOpsRepository },
+    { no: 2, name: "drift", kind: "message", T: ConnectGitOpsPlan },
+    { no: 3, name: "last_reconciliation", kind: "message", T: ConnectGitOpsPlan },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectGitOpsStatusResponse {
+    return new GetConnectGitOpsStatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectGitOpsStatusResponse {
+    return new GetConnectGitOpsStatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectGitOpsStatusResponse {
+    return new GetConnectGitOpsStatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectGitOpsStatusResponse | PlainMessage<GetConnectGitOpsStatusResponse> | undefined, b: GetConnectGitOpsStatusResponse | PlainMessage<GetConnectGitOpsStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetConnectGitOpsStatusResponse, a, b);
+  }
+}
+
+/**
+ * Offset of a connector as returned and accepted by the offsets endpoints of
+ * Kafka Connect. The structure of partition and offset is defined by the
+ * connector.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectorOffset
+ */
+export class ConnectorOffset extends Message<ConnectorOffset> {
+  /**
+   * Source partition of a source connector, or kafka_topic and
+   * kafka_partition for sink connectors.
+   *
+   * @generated from field: google.protobuf.Struct partition = 1;
+   */
+  partition?: Struct;
+
+  /**
+   * Offset of the partition, e.g. kafka_offset for sink connectors. Not set to
+   * reset the offset of the partition.
+   *
+   * @generated from field: google.protobuf.Struct offset = 2;
+   */
+  offset?: Struct;
+
+  constructor(data?: PartialMessage<ConnectorOffset>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectorOffset";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "partition", kind: "message", T: Struct },
+    { no: 2, name: "offset", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectorOffset {
+    return new ConnectorOffset().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectorOffset {
+    return new ConnectorOffset().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectorOffset {
+    return new ConnectorOffset().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectorOffset | PlainMessage<ConnectorOffset> | undefined, b: ConnectorOffset | PlainMessage<ConnectorOffset> | undefined): boolean {
+    return proto3.util.equals(ConnectorOffset, a, b);
+  }
+}
+
+/**
+ * Offset of a sink connector as consumer group offset.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.SinkConnectorOffset
+ */
+export class SinkConnectorOffset extends Message<SinkConnectorOffset> {
+  /**
+   * @generated from field: string topic = 1;
+   */
+  topic = "";
+
+  /**
+   * @generated from field: int32 partition = 2;
+   */
+  partition = 0;
+
+  /**
+   * Next offset to consume. Not set to reset the offset of the partition.
+   *
+   * @generated from field: optional int64 offset = 3;
+   */
+  offset?: bigint;
+
+  constructor(data?: PartialMessage<SinkConnectorOffset>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.SinkConnectorOffset";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "topic", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "partition", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "offset", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SinkConnectorOffset {
+    return new SinkConnectorOffset().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SinkConnectorOffset {
+    return new SinkConnectorOffset().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SinkConnectorOffset {
+    return new SinkConnectorOffset().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SinkConnectorOffset | PlainMessage<SinkConnectorOffset> | undefined, b: SinkConnectorOffset | PlainMessage<SinkConnectorOffset> | undefined): boolean {
+    return proto3.util.equals(SinkConnectorOffset, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectorOffsetsRequest
+ */
+export class ListConnectorOffsetsRequest extends Message<ListConnectorOffsetsRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<ListConnectorOffsetsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectorOffsetsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectorOffsetsRequest {
+    return new ListConnectorOffsetsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectorOffsetsRequest {
+    return new ListConnectorOffsetsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectorOffsetsRequest {
+    return new ListConnectorOffsetsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectorOffsetsRequest | PlainMessage<ListConnectorOffsetsRequest> | undefined, b: ListConnectorOffsetsRequest | PlainMessage<ListConnectorOffsetsRequest> | undefined): boolean {
+    return proto3.util.equals(ListConnectorOffsetsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectorOffsetsResponse
+ */
+export class ListConnectorOffsetsResponse extends Message<ListConnectorOffsetsResponse> {
+  /**
+   * Type of the connector, either `source` or `sink`.
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectorOffset offsets = 2;
+   */
+  offsets: ConnectorOffset[] = [];
+
+  /**
+   * Consumer group of a sink connector.
+   *
+   * @generated from field: string consumer_group_id = 3;
+   */
+  consumerGroupId = "";
+
+  /**
+   * Offsets of a sink connector as consumer group offsets.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.SinkConnectorOffset sink_offsets = 4;
+   */
+  sinkOffsets: SinkConnectorOffset[] = [];
+
+  constructor(data?: PartialMessage<ListConnectorOffsetsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectorOffsetsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "offsets", kind: "message", T: ConnectorOffset, repeated: true },
+    { no: 3, name: "consumer_group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "sink_offsets", kind: "message", T: SinkConnectorOffset, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectorOffsetsResponse {
+    return new ListConnectorOffsetsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectorOffsetsResponse {
+    return new ListConnectorOffsetsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectorOffsetsResponse {
+    return new ListConnectorOffsetsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectorOffsetsResponse | PlainMessage<ListConnectorOffsetsResponse> | undefined, b: ListConnectorOffsetsResponse | PlainMessage<ListConnectorOffsetsResponse> | undefined): boolean {
+    return proto3.util.equals(ListConnectorOffsetsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.AlterConnectorOffsetsRequest
+ */
+export class AlterConnectorOffsetsRequest extends Message<AlterConnectorOffsetsRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Offsets in the format of the connector. Either offsets or sink_offsets
+   * must be set.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectorOffset offsets = 3;
+   */
+  offsets: ConnectorOffset[] = [];
+
+  /**
+   * Consumer group offsets of a sink connector. Either offsets or
+   * sink_offsets must be set.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.SinkConnectorOffset sink_offsets = 4;
+   */
+  sinkOffsets: SinkConnectorOffset[] = [];
+
+  constructor(data?: PartialMessage<AlterConnectorOffsetsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.AlterConnectorOffsetsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "offsets", kind: "message", T: ConnectorOffset, repeated: true },
+    { no: 4, name: "sink_offsets", kind: "message", T: SinkConnectorOffset, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlterConnectorOffsetsRequest {
+    return new AlterConnectorOffsetsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlterConnectorOffsetsRequest {
+    return new AlterConnectorOffsetsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlterConnectorOffsetsRequest {
+    return new AlterConnectorOffsetsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlterConnectorOffsetsRequest | PlainMessage<AlterConnectorOffsetsRequest> | undefined, b: AlterConnectorOffsetsRequest | PlainMessage<AlterConnectorOffsetsRequest> | undefined): boolean {
+    return proto3.util.equals(AlterConnectorOffsetsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.AlterConnectorOffsetsResponse
+ */
+export class AlterConnectorOffsetsResponse extends Message<AlterConnectorOffsetsResponse> {
+  /**
+   * Message returned by Kafka Connect.
+   *
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<AlterConnectorOffsetsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.AlterConnectorOffsetsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlterConnectorOffsetsResponse {
+    return new AlterConnectorOffsetsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlterConnectorOffsetsResponse {
+    return new AlterConnectorOffsetsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlterConnectorOffsetsResponse {
+    return new AlterConnectorOffsetsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlterConnectorOffsetsResponse | PlainMessage<AlterConnectorOffsetsResponse> | undefined, b: AlterConnectorOffsetsResponse | PlainMessage<AlterConnectorOffsetsResponse> | undefined): boolean {
+    return proto3.util.equals(AlterConnectorOffsetsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ResetConnectorOffsetsRequest
+ */
+export class ResetConnectorOffsetsRequest extends Message<ResetConnectorOffsetsRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<ResetConnectorOffsetsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ResetConnectorOffsetsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetConnectorOffsetsRequest {
+    return new ResetConnectorOffsetsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetConnectorOffsetsRequest {
+    return new ResetConnectorOffsetsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetConnectorOffsetsRequest {
+    return new ResetConnectorOffsetsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResetConnectorOffsetsRequest | PlainMessage<ResetConnectorOffsetsRequest> | undefined, b: ResetConnectorOffsetsRequest | PlainMessage<ResetConnectorOffsetsRequest> | undefined): boolean {
+    return proto3.util.equals(ResetConnectorOffsetsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ResetConnectorOffsetsResponse
+ */
+export class ResetConnectorOffsetsResponse extends Message<ResetConnectorOffsetsResponse> {
+  /**
+   * Message returned by Kafka Connect.
+   *
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<ResetConnectorOffsetsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ResetConnectorOffsetsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetConnectorOffsetsResponse {
+    return new ResetConnectorOffsetsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetConnectorOffsetsResponse {
+    return new ResetConnectorOffsetsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetConnectorOffsetsResponse {
+    return new ResetConnectorOffsetsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResetConnectorOffsetsResponse | PlainMessage<ResetConnectorOffsetsResponse> | undefined, b: ResetConnectorOffsetsResponse | PlainMessage<ResetConnectorOffsetsResponse> | undefined): boolean {
+    return proto3.util.equals(ResetConnectorOffsetsResponse, a, b);
+  }
+}
+